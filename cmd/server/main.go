@@ -1,26 +1,87 @@
 package main
 
 import (
+	"flag"
 	"log"
 	"net"
+	"os"
+	"path/filepath"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
 	"github.com/ZaneH/defuse.party-go/internal/actors"
+	appPorts "github.com/ZaneH/defuse.party-go/internal/application/ports"
 	appServices "github.com/ZaneH/defuse.party-go/internal/application/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
 	"github.com/ZaneH/defuse.party-go/internal/infrastructure/adapters"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/challenge"
 	grpcServer "github.com/ZaneH/defuse.party-go/internal/infrastructure/grpc"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/leaderboard"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/missionpack"
 	pb "github.com/ZaneH/defuse.party-go/pkg/proto"
+	"github.com/google/uuid"
+)
+
+var (
+	// command-line options:
+	// directory of YAML/JSON mission packs to load at startup
+	missionPackDir = flag.String("mission-packs", "", "directory containing mission packs")
+	// JSON file leaderboards are saved to. Leaderboards are kept in memory when empty.
+	leaderboardFile = flag.String("leaderboard-file", "", "file to persist leaderboards to")
+	// JSON file challenge attempts are saved to. Defaults to a file next to the leaderboard
+	// file, and attempts are kept in memory when neither is set.
+	challengeAttemptsFile = flag.String("challenge-attempts-file", "", "file to persist challenge attempts to")
+	// secret daily and weekly challenge seeds are derived from. Falls back to the
+	// CHALLENGE_SECRET environment variable.
+	challengeSecret = flag.String("challenge-secret", os.Getenv("CHALLENGE_SECRET"), "secret challenge seeds are derived from")
 )
 
 func main() {
+	flag.Parse()
+
+	missions := valueobject.NewMissionCatalog()
+	if *missionPackDir != "" {
+		if err := missionpack.LoadDir(*missionPackDir, missions); err != nil {
+			log.Fatalf("failed to load mission packs: %v", err)
+		}
+	}
+
+	var leaderboardStore appPorts.LeaderboardStore = leaderboard.NewMemoryStore()
+	if *leaderboardFile != "" {
+		fileStore, err := leaderboard.NewFileStore(*leaderboardFile)
+		if err != nil {
+			log.Fatalf("failed to open leaderboard file: %v", err)
+		}
+		leaderboardStore = fileStore
+	}
+
 	actorSystem := actors.NewActorSystem()
 	actorSystemAdapter := adapters.NewActorSystemAdapter(actorSystem)
 	bombService := appServices.NewBombService(actorSystemAdapter)
 
-	gameService := appServices.NewGameService(actorSystem, bombService)
-	grpcGameServiceServer := grpcServer.NewGameServiceAdapter(gameService)
+	gameService := appServices.NewGameService(actorSystem, bombService, missions, leaderboardStore)
+	if *challengeSecret == "" {
+		log.Println("no challenge secret set, challenges will change when the server restarts")
+		*challengeSecret = uuid.NewString()
+	}
+
+	if *challengeAttemptsFile == "" && *leaderboardFile != "" {
+		*challengeAttemptsFile = filepath.Join(filepath.Dir(*leaderboardFile), "challenge_attempts.json")
+	}
+	var attemptStore appPorts.ChallengeAttemptStore = challenge.NewMemoryAttemptStore()
+	if *challengeAttemptsFile != "" {
+		fileStore, err := challenge.NewFileAttemptStore(*challengeAttemptsFile)
+		if err != nil {
+			log.Fatalf("failed to open challenge attempts file: %v", err)
+		}
+		attemptStore = fileStore
+	}
+	challengeService := appServices.NewChallengeService(gameService, []byte(*challengeSecret), attemptStore)
+
+	matchService := appServices.NewMatchService(gameService)
+
+	grpcGameServiceServer := grpcServer.NewGameServiceAdapter(gameService, challengeService, matchService)
 
 	lis, err := net.Listen("tcp", "0.0.0.0:50051")
 	if err != nil {
@@ -1,6 +1,8 @@
 package actors
 
 import (
+	"time"
+
 	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
 	"github.com/google/uuid"
 )
@@ -37,6 +39,44 @@ func (a *BaseActor) Send(message Message) {
 	}
 }
 
+// Like Send, but gives up once the deadline passes. Reports whether the message was queued.
+func (a *BaseActor) sendBefore(message Message, deadline <-chan time.Time) bool {
+	select {
+	case a.mailbox <- message:
+		return true
+	case <-a.done:
+		return false
+	case <-deadline:
+		return false
+	}
+}
+
+// Like Send, but only queues the message if the mailbox has room for it right now. Reports
+// whether it did.
+func (a *BaseActor) trySend(message Message) bool {
+	select {
+	case a.mailbox <- message:
+		return true
+	default:
+		return false
+	}
+}
+
+// Actors whose sends can be given up on. Every actor built on BaseActor is one.
+type deadlineSender interface {
+	sendBefore(message Message, deadline <-chan time.Time) bool
+}
+
+// Queues the message unless the deadline passes first. Actors that can't give up on a send
+// are sent to as usual.
+func sendBefore(actor Actor, message Message, deadline <-chan time.Time) bool {
+	if sender, ok := actor.(deadlineSender); ok {
+		return sender.sendBefore(message, deadline)
+	}
+	actor.Send(message)
+	return true
+}
+
 func (a *BaseActor) Stop() {
 	close(a.done)
 }
@@ -2,21 +2,32 @@ package actors
 
 import (
 	"errors"
+	"log"
 	"sync"
+	"time"
 
 	"github.com/ZaneH/defuse.party-go/internal/domain/ports"
 	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
 	"github.com/google/uuid"
 )
 
+// How long a finished match and its sessions are kept, so that teams can still read the
+// final scoreboard and their game reports
+const DefaultMatchRetention = 10 * time.Minute
+
 type ActorSystem struct {
 	sessions map[uuid.UUID]*GameSessionActor
+	matches  map[uuid.UUID]*MatchActor
 	mu       sync.RWMutex
+	// Never changes once the system is in use
+	matchRetention time.Duration
 }
 
 func NewActorSystem() *ActorSystem {
 	return &ActorSystem{
-		sessions: make(map[uuid.UUID]*GameSessionActor),
+		sessions:       make(map[uuid.UUID]*GameSessionActor),
+		matches:        make(map[uuid.UUID]*MatchActor),
+		matchRetention: DefaultMatchRetention,
 	}
 }
 
@@ -57,3 +68,77 @@ func (s *ActorSystem) StopGameSession(sessionID uuid.UUID) error {
 
 	return nil
 }
+
+// Starts a match between the given teams, one session per team in the same order. The
+// match and its sessions are stopped once the match has been finished for the match
+// retention.
+func (s *ActorSystem) CreateMatch(teams []string, sessions []*GameSessionActor) (*MatchActor, error) {
+	if len(teams) != len(sessions) {
+		return nil, errors.New("every team needs exactly one session")
+	}
+
+	matchActor, matchID := NewMatchActor(teams, sessions)
+	matchActor.Start()
+
+	s.mu.Lock()
+	s.matches[matchID] = matchActor
+	s.mu.Unlock()
+
+	go s.stopWhenFinished(matchActor)
+
+	return matchActor, nil
+}
+
+func (s *ActorSystem) stopWhenFinished(matchActor *MatchActor) {
+	select {
+	case <-matchActor.finished:
+	case <-matchActor.Done():
+		return
+	}
+
+	timer := time.NewTimer(s.matchRetention)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-matchActor.Done():
+		return
+	}
+
+	if err := s.StopMatch(matchActor.GetMatchID()); err != nil {
+		log.Printf("error stopping match %v: %v", matchActor.GetMatchID(), err)
+	}
+}
+
+// Stops a match along with every team's session.
+func (s *ActorSystem) StopMatch(matchID uuid.UUID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	match, exists := s.matches[matchID]
+	if !exists {
+		return errors.New("match not found")
+	}
+
+	match.Stop()
+	delete(s.matches, matchID)
+	for _, session := range match.sessions {
+		if _, exists := s.sessions[session.GetSessionID()]; exists {
+			session.Stop()
+			delete(s.sessions, session.GetSessionID())
+		}
+	}
+
+	return nil
+}
+
+func (s *ActorSystem) GetMatch(matchID uuid.UUID) (*MatchActor, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	match, exists := s.matches[matchID]
+	if !exists {
+		return nil, errors.New("match not found")
+	}
+
+	return match, nil
+}
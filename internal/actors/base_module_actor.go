@@ -2,6 +2,7 @@ package actors
 
 import (
 	"errors"
+	"time"
 
 	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
 	"github.com/google/uuid"
@@ -12,6 +13,8 @@ type BaseModuleActor struct {
 	module     entities.Module
 	moduleID   uuid.UUID
 	handleFunc func(msg Message)
+	// Set when the actor runs on its bomb's goroutine instead of its own
+	scheduler *moduleScheduler
 }
 
 func NewBaseModuleActor(module entities.Module, bufferSize int) BaseModuleActor {
@@ -31,25 +34,79 @@ func (a *BaseModuleActor) GetModule() entities.Module {
 	return a.module
 }
 
+// Starts the actor's own goroutine, unless it runs on a bomb's scheduler.
 func (a *BaseModuleActor) Start() {
+	if a.scheduler != nil {
+		return
+	}
 	go a.processMessages()
 }
 
+func (a *BaseModuleActor) Send(message Message) {
+	if a.scheduler != nil {
+		a.scheduler.schedule(a, message)
+		return
+	}
+	a.BaseActor.Send(message)
+}
+
+func (a *BaseModuleActor) sendBefore(message Message, deadline <-chan time.Time) bool {
+	if a.scheduler != nil {
+		return a.scheduler.bomb.sendBefore(scheduledModuleMessage{actor: a, msg: message}, deadline)
+	}
+	return a.BaseActor.sendBefore(message, deadline)
+}
+
 func (a *BaseModuleActor) processMessages() {
 	for {
 		select {
 		case msg := <-a.Mailbox():
-			if a.handleFunc != nil {
-				a.handleFunc(msg)
-			} else {
-				a.handleMessage(msg)
-			}
+			a.dispatch(msg)
 		case <-a.Done():
 			return
 		}
 	}
 }
 
+// Handles a single message on the calling goroutine.
+func (a *BaseModuleActor) dispatch(msg Message) {
+	if cmdMsg, ok := msg.(ModuleCommandMessage); ok {
+		// Nobody is waiting for commands that were given up on, so they're never applied
+		if !cmdMsg.claim.apply() {
+			cmdMsg.ResponseChannel <- ErrorResponse{Err: ErrRequestTimeout}
+			return
+		}
+
+		// Solved modules turn input away before it reaches their rules
+		if a.isSolved() {
+			cmdMsg.ResponseChannel <- ErrorResponse{Err: ErrModuleSolved}
+			return
+		}
+	}
+
+	if a.handleFunc != nil {
+		a.handleFunc(msg)
+	} else {
+		a.handleMessage(msg)
+	}
+}
+
+// Moves the actor onto a bomb's scheduler. Must be called before the actor is started.
+func (a *BaseModuleActor) runOn(scheduler *moduleScheduler) {
+	a.scheduler = scheduler
+	// Messages go through the bomb's mailbox from now on
+	a.mailbox = nil
+}
+
+func (a *BaseModuleActor) isScheduledOn(scheduler *moduleScheduler) bool {
+	return a.scheduler != nil && a.scheduler == scheduler
+}
+
+func (a *BaseModuleActor) isSolved() bool {
+	state := a.module.GetModuleState()
+	return state != nil && state.IsSolved()
+}
+
 func (a *BaseModuleActor) handleMessage(msg Message) {
 	if reqMsg, ok := msg.(RequestMessage); ok {
 		reqMsg.GetResponseChannel() <- ErrorResponse{
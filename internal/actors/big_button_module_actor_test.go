@@ -200,3 +200,59 @@ func TestBigButtonModuleActor_YellowButton(t *testing.T) {
 
 	log.Printf("Final state: %v", buttonModuleActor.GetModule())
 }
+
+func TestBigButtonModuleActor_ReleaseUsesAcceleratedClock(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	config := valueobject.NewDefaultBombConfig()
+	config.Timer = 6 * time.Minute
+	bomb := entities.NewBomb(rng, config)
+
+	// 15s at normal speed, then 15s at double speed: the clock shows 05:15 instead of 05:30
+	now := time.Unix(time.Now().Unix(), 0)
+	bomb.Clock.Start(now.Add(-30 * time.Second))
+	bomb.Clock.SetRate(2.0, now.Add(-15*time.Second))
+
+	buttonModule := entities.NewBigButtonModule(rng)
+	buttonModule.SetBomb(bomb)
+
+	releaseDigit := 1
+	testState := entities.NewButtonState(rng)
+	testState.ButtonColor = valueobject.Yellow
+	testState.Label = "Hold"
+	testState.ReleaseDigit = &releaseDigit
+	buttonModule.SetState(testState)
+
+	buttonModuleActor := actors.NewBigButtonModuleActor(buttonModule)
+	buttonModuleActor.Start() // Start the actor to process messages
+	defer buttonModuleActor.Stop()
+
+	// Act
+	cmd := &command.BigButtonInputCommand{
+		BaseModuleInputCommand: command.BaseModuleInputCommand{
+			SessionID: uuid.New(),
+			BombID:    bomb.ID,
+			ModuleID:  buttonModule.ModuleID,
+		},
+		PressType:        valueobject.PressTypeRelease,
+		ReleaseTimestamp: now.Unix(),
+	}
+
+	respChan := make(chan actors.Response, 1)
+
+	buttonModuleActor.Send(actors.ModuleCommandMessage{
+		Command:         cmd,
+		ResponseChannel: respChan,
+	})
+
+	// Assert
+	var resp actors.Response
+	select {
+	case resp = <-respChan:
+	case <-time.After(1 * time.Second):
+		t.Fatalf("timeout waiting for response")
+	}
+
+	assert.Nil(t, resp.Error(), "Expected release on 05:15 to match digit 1")
+	assert.True(t, buttonModule.GetModuleState().IsSolved(), "Module should be solved")
+}
@@ -3,15 +3,39 @@ package actors
 import (
 	"errors"
 	"log"
+	"time"
 
+	"github.com/ZaneH/defuse.party-go/internal/application/command"
 	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
 	"github.com/google/uuid"
 )
 
+// Owns a bomb and the actors for its modules. Strikes, arming and the bomb's clock are only
+// changed from this actor's goroutine; everything else reads the bomb through
+// GetBombSnapshotMessage. Module actors run on this goroutine too, through the bomb's
+// scheduler.
 type BombActor struct {
 	BaseActor
 	bomb         *entities.Bomb
 	moduleActors map[uuid.UUID]ModuleActor
+	scheduler    *moduleScheduler
+	// Whether module actors run on the bomb's goroutine instead of their own. Benchmarks
+	// turn this off to compare against one goroutine per module.
+	scheduleModules bool
+	// How long a module actor on its own goroutine has to take a command. Set before the
+	// actor is started.
+	requestTimeout time.Duration
+	// Fires when the bomb's clock or one of its needy countdowns is due to run out, so they
+	// run out even if nobody touches the bomb. Nil while the bomb isn't armed.
+	countdownTimer *time.Timer
+}
+
+// Sent by the bomb's countdown timer when its clock or a needy countdown is due to run out
+type countdownEndedMessage struct{}
+
+func (m countdownEndedMessage) MessageType() string {
+	return "CountdownEnded"
 }
 
 func NewBombActor(bomb *entities.Bomb) *BombActor {
@@ -19,7 +43,11 @@ func NewBombActor(bomb *entities.Bomb) *BombActor {
 		BaseActor:    NewBaseActor(100),
 		bomb:         bomb,
 		moduleActors: make(map[uuid.UUID]ModuleActor),
+		// Set before the actor is started and only read by Start
+		scheduleModules: true,
+		requestTimeout:  DefaultRequestTimeout,
 	}
+	actor.scheduler = &moduleScheduler{bomb: actor}
 
 	return actor
 }
@@ -32,9 +60,12 @@ func (b *BombActor) Start() {
 			continue
 		}
 
+		if scheduled, ok := moduleActor.(schedulableModuleActor); ok && b.scheduleModules {
+			scheduled.runOn(b.scheduler)
+		}
+
 		b.moduleActors[moduleID] = moduleActor
 		moduleActor.Start()
-		b.bomb.StartTimer()
 	}
 
 	go b.processMessages()
@@ -44,20 +75,20 @@ func (b *BombActor) GetBombID() uuid.UUID {
 	return b.bomb.ID
 }
 
+// Returns the live bomb. It's owned by the actor's goroutine, so this is only safe to use
+// before the actor is started or when no messages are in flight, such as when setting up
+// tests.
 func (b *BombActor) GetBomb() *entities.Bomb {
 	return b.bomb
 }
 
-func (b *BombActor) GetModuleActors() map[uuid.UUID]ModuleActor {
-	return b.moduleActors
-}
-
 func (b *BombActor) processMessages() {
 	for {
 		select {
 		case msg := <-b.Mailbox():
 			b.handleMessage(msg)
 		case <-b.Done():
+			b.stopCountdownTimer()
 			for _, moduleActor := range b.moduleActors {
 				moduleActor.Stop()
 			}
@@ -70,6 +101,33 @@ func (b *BombActor) handleMessage(msg Message) {
 	switch m := msg.(type) {
 	case ModuleCommandMessage:
 		b.handleModuleCommand(m)
+	case ArmBombMessage:
+		b.bomb.StartTimer(m.At)
+		if m.Paused {
+			b.bomb.Clock.Pause(m.At)
+		}
+		b.scheduleCountdownTimer()
+		m.ResponseChannel <- SuccessResponse{}
+	case SetBombPausedMessage:
+		if m.Paused {
+			b.bomb.Clock.Pause(m.At)
+		} else {
+			b.bomb.Clock.Resume(m.At)
+		}
+		b.scheduleCountdownTimer()
+		m.ResponseChannel <- SuccessResponse{}
+	case countdownEndedMessage:
+		b.bomb.TimeOutNeedyModules(time.Now())
+		b.scheduleCountdownTimer()
+	case RevealEdgeworkMessage:
+		b.bomb.RevealEdgework()
+		m.ResponseChannel <- SuccessResponse{}
+	case GetBombSnapshotMessage:
+		now := time.Now()
+		b.stopClockIfFinished(now)
+		m.ResponseChannel <- SuccessResponse{Data: b.bomb.Snapshot(now)}
+	case scheduledModuleMessage:
+		m.actor.dispatch(m.msg)
 	default:
 		if reqMsg, ok := msg.(RequestMessage); ok {
 			reqMsg.GetResponseChannel() <- ErrorResponse{
@@ -81,17 +139,137 @@ func (b *BombActor) handleMessage(msg Message) {
 	}
 }
 
+// Passes the command to its module and applies the outcome to the bomb. The module actor
+// either runs on this goroutine or only while this actor waits for its reply, so modules
+// can read the bomb safely. A module on its own goroutine that doesn't take the command in
+// time is answered for with ErrRequestTimeout, and never applies it.
 func (b *BombActor) handleModuleCommand(msg ModuleCommandMessage) {
-	cmd := msg.Command
-	moduleID := cmd.GetModuleID()
+	switch b.bomb.GetState() {
+	case valueobject.BombStateWaiting:
+		msg.ResponseChannel <- ErrorResponse{Err: ErrBombNotArmed}
+		return
+	case valueobject.BombStateDefused, valueobject.BombStateExploded:
+		msg.ResponseChannel <- ErrorResponse{Err: ErrBombNotActive}
+		return
+	case valueobject.BombStatePaused:
+		msg.ResponseChannel <- ErrorResponse{Err: ErrBombPaused}
+		return
+	}
 
-	moduleActor, exists := b.moduleActors[moduleID]
+	moduleActor, exists := b.moduleActors[msg.Command.GetModuleID()]
 	if !exists {
-		msg.GetResponseChannel() <- ErrorResponse{
-			Err: errors.New("module not found in bomb"),
+		msg.ResponseChannel <- ErrorResponse{Err: ErrModuleNotFound}
+		return
+	}
+
+	claim := msg.claim
+	if claim == nil {
+		claim = &commandClaim{}
+	}
+	deadline := time.NewTimer(b.requestTimeout)
+	defer deadline.Stop()
+
+	proxyChannel := make(chan Response, 1)
+	delivered := b.deliverBefore(moduleActor, ModuleCommandMessage{
+		Command:         msg.Command,
+		ResponseChannel: proxyChannel,
+		claim:           claim,
+	}, deadline.C)
+	if !delivered {
+		msg.ResponseChannel <- ErrorResponse{Err: ErrRequestTimeout}
+		return
+	}
+
+	var response Response
+	select {
+	case response = <-proxyChannel:
+	case <-deadline.C:
+		if claim.abandon() {
+			msg.ResponseChannel <- ErrorResponse{Err: ErrRequestTimeout}
+			return
+		}
+		// The module has started on the command, so its reply is on the way
+		response = <-proxyChannel
+	}
+
+	if successResp, ok := response.(SuccessResponse); ok {
+		if result, ok := successResp.Data.(command.ModuleInputCommandResult); ok {
+			rule := moduleActor.GetModule().GetLastRule()
+			if result.HasStrike() {
+				result.SetStrikeCounted(b.bomb.RecordStrike(msg.Command.GetModuleID()))
+
+				// Practice bombs explain which rule the strike was given for
+				if b.bomb.Practice {
+					result.SetRule(rule)
+				}
+			}
+
+			if result.IsSolved() {
+				b.bomb.RecordSolve(msg.Command.GetModuleID())
+			}
+
+			if msg.explainRule {
+				response = SuccessResponse{Data: explainedResult{result: result, rule: rule}}
+			}
 		}
+	}
+
+	// Strikes and time mode change when the countdowns run out
+	b.scheduleCountdownTimer()
+
+	msg.ResponseChannel <- response
+}
+
+// Freezes the clock on a bomb that has been defused or has exploded. Returns whether the
+// bomb is finished.
+func (b *BombActor) stopClockIfFinished(now time.Time) bool {
+	if !b.bomb.GetState().IsFinished() {
+		return false
+	}
+
+	b.bomb.Clock.Stop(now)
+	b.stopCountdownTimer()
+	return true
+}
+
+// Sets the countdown timer for whichever runs out first at the clock's current rate: the
+// clock or a needy countdown. Only armed bombs get one.
+func (b *BombActor) scheduleCountdownTimer() {
+	b.stopCountdownTimer()
+	now := time.Now()
+	if b.stopClockIfFinished(now) || b.bomb.GetState() != valueobject.BombStateArmed {
 		return
 	}
 
-	moduleActor.Send(msg)
+	next, found := b.bomb.NextNeedyTimeOut(now)
+	// Zen bombs keep counting once their time is up, so only a clock with time left counts
+	if timeLeft := b.bomb.Clock.TimeLeftAt(now); timeLeft > 0 && (!found || timeLeft < next) {
+		next, found = timeLeft, true
+	}
+	if !found {
+		return
+	}
+
+	wait := time.Duration(float64(next) / b.bomb.Clock.Rate())
+	b.countdownTimer = time.AfterFunc(wait, func() {
+		b.Send(countdownEndedMessage{})
+	})
+}
+
+func (b *BombActor) stopCountdownTimer() {
+	if b.countdownTimer != nil {
+		b.countdownTimer.Stop()
+		b.countdownTimer = nil
+	}
+}
+
+// Hands a message to one of the bomb's module actors, unless its mailbox stays full past the
+// deadline. Actors on the bomb's scheduler handle it right away on this goroutine.
+func (b *BombActor) deliverBefore(moduleActor ModuleActor, msg Message, deadline <-chan time.Time) bool {
+	if scheduled, ok := moduleActor.(schedulableModuleActor); ok && scheduled.isScheduledOn(b.scheduler) {
+		scheduled.dispatch(msg)
+		return true
+	}
+
+	return sendBefore(moduleActor, msg, deadline)
 }
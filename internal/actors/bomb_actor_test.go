@@ -0,0 +1,191 @@
+package actors_test
+
+import (
+	"io"
+	"log"
+	"os"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/actors"
+	"github.com/ZaneH/defuse.party-go/internal/application/command"
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBombActor_ScheduledModuleTakesDirectSends(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	bomb, wiresModule := newSingleWireBomb(rng)
+	bombActor := actors.NewBombActor(bomb)
+	bombActor.Start()
+	defer bombActor.Stop()
+
+	moduleActor := bombActor.GetModuleActor(wiresModule.GetModuleID())
+
+	// Act: send to the module actor itself rather than through the bomb
+	resp := sendAndWait(t, moduleActor, func(respChan chan actors.Response) actors.Message {
+		return actors.ModuleCommandMessage{
+			Command: &command.WiresInputCommand{
+				BaseModuleInputCommand: command.BaseModuleInputCommand{
+					BombID:   bomb.ID,
+					ModuleID: wiresModule.GetModuleID(),
+				},
+				WirePosition: 2,
+			},
+			ResponseChannel: respChan,
+		}
+	})
+	snapshot := sendAndWait(t, bombActor, func(respChan chan actors.Response) actors.Message {
+		return actors.GetBombSnapshotMessage{ResponseChannel: respChan}
+	})
+
+	// Assert
+	if assert.True(t, resp.IsSuccess(), "Scheduled module should still answer sends") {
+		assert.True(t, resp.(actors.SuccessResponse).Data.(command.ModuleInputCommandResult).IsSolved())
+	}
+	assert.True(t, snapshot.IsSuccess())
+}
+
+func TestBombActor_ModuleSchedulingIsSetPerBomb(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	scheduledBomb, scheduledModule := newSingleWireBomb(rng)
+	ownBomb, ownModule := newSingleWireBomb(rng)
+	scheduled := actors.NewBombActor(scheduledBomb)
+	own := actors.NewBombActor(ownBomb)
+	own.SetModuleScheduling(false)
+
+	// Act
+	scheduled.Start()
+	defer scheduled.Stop()
+	own.Start()
+	defer own.Stop()
+
+	// Assert
+	assert.True(t, scheduled.RunsModuleOnBomb(scheduledModule.GetModuleID()))
+	assert.False(t, own.RunsModuleOnBomb(ownModule.GetModuleID()), "Turning scheduling off for one bomb shouldn't change the other")
+}
+
+func TestBombActor_ClockStopsWhenTimeRunsOut(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	bomb, _ := newSingleWireBomb(rng)
+	bomb.Clock = entities.NewBombClock(50 * time.Millisecond)
+	bombActor := actors.NewBombActor(bomb)
+	bombActor.Start()
+	defer bombActor.Stop()
+
+	// Act: nothing is sent to the bomb between arming it and long after it runs out
+	sendAndWait(t, bombActor, func(respChan chan actors.Response) actors.Message {
+		return actors.ArmBombMessage{At: time.Now(), ResponseChannel: respChan}
+	})
+	time.Sleep(300 * time.Millisecond)
+	resp := sendAndWait(t, bombActor, func(respChan chan actors.Response) actors.Message {
+		return actors.GetBombSnapshotMessage{ResponseChannel: respChan}
+	})
+
+	// Assert
+	if !assert.True(t, resp.IsSuccess()) {
+		return
+	}
+	snapshot := resp.(actors.SuccessResponse).Data.(entities.BombSnapshot)
+	assert.Equal(t, valueobject.BombStateExploded, snapshot.State)
+	if assert.NotNil(t, snapshot.StoppedAt, "The clock should stop when the bomb explodes") {
+		assert.Less(t, snapshot.StoppedAt.Sub(*snapshot.StartedAt), 200*time.Millisecond, "The clock should stop when it runs out, not when it's next looked at")
+	}
+}
+
+// A command every module turns away, so input reaches a module without changing the bomb
+type benchmarkCommand struct {
+	command.BaseModuleInputCommand
+}
+
+// Compares goroutines, memory and input latency for 1,000 sessions with a level 10 bomb each,
+// with one goroutine per module and with modules on their bomb's scheduler.
+func BenchmarkSessions_ModuleScheduling(b *testing.B) {
+	const numSessions = 1000
+
+	for _, tc := range []struct {
+		name      string
+		scheduled bool
+	}{
+		{name: "goroutine-per-module", scheduled: false},
+		{name: "per-bomb-scheduler", scheduled: true},
+	} {
+		b.Run(tc.name, func(b *testing.B) {
+			// Every rejected input is logged, which would swamp the latency being measured
+			log.SetOutput(io.Discard)
+			defer log.SetOutput(os.Stderr)
+
+			var before, after runtime.MemStats
+			runtime.GC()
+			runtime.ReadMemStats(&before)
+			goroutinesBefore := runtime.NumGoroutine()
+
+			rng := services.NewSeededRNGFromString("bench")
+			factory := services.NewBombFactory(services.NewModuleFactory(rng))
+			config := valueobject.NewEasyGameSessionConfig("bench")
+
+			sessions := make([]*actors.GameSessionActor, 0, numSessions)
+			commands := make([]*benchmarkCommand, 0, numSessions)
+			for range numSessions {
+				sessionActor, sessionID := actors.NewGameSessionActor(rng, config)
+				sessionActor.SetModuleScheduling(tc.scheduled)
+				sessionActor.Start()
+
+				bomb := factory.CreateBomb(rng, valueobject.BombConfigFromLevel(10))
+				respChan := make(chan actors.Response, 1)
+				sessionActor.Send(actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan})
+				<-respChan
+				sessionActor.Send(actors.StartGameMessage{ResponseChannel: respChan})
+				<-respChan
+
+				var moduleID uuid.UUID
+				for id := range bomb.Modules {
+					moduleID = id
+					break
+				}
+
+				sessions = append(sessions, sessionActor)
+				commands = append(commands, &benchmarkCommand{
+					BaseModuleInputCommand: command.BaseModuleInputCommand{
+						SessionID: sessionID,
+						BombID:    bomb.ID,
+						ModuleID:  moduleID,
+					},
+				})
+			}
+			defer func() {
+				for _, sessionActor := range sessions {
+					sessionActor.Stop()
+				}
+			}()
+
+			runtime.GC()
+			runtime.ReadMemStats(&after)
+			goroutines := runtime.NumGoroutine() - goroutinesBefore
+			// Goroutine stacks aren't part of the heap, so count both
+			memory := int64(after.HeapInuse+after.StackInuse) - int64(before.HeapInuse+before.StackInuse)
+
+			var next atomic.Int64
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				respChan := make(chan actors.Response, 1)
+				for pb.Next() {
+					i := int(next.Add(1)) % numSessions
+					sessions[i].Send(actors.ModuleCommandMessage{Command: commands[i], ResponseChannel: respChan})
+					<-respChan
+				}
+			})
+
+			b.ReportMetric(float64(goroutines)/numSessions, "goroutines/session")
+			b.ReportMetric(float64(memory)/numSessions, "mem-B/session")
+		})
+	}
+}
@@ -10,4 +10,12 @@ var (
 	ErrInvalidModuleType    ActorError = fmt.Errorf("invalid module type")
 	ErrInvalidModuleCommand ActorError = fmt.Errorf("invalid module command")
 	ErrUnhandledMessageType ActorError = fmt.Errorf("unhandled message type")
+	ErrBombNotFound         ActorError = fmt.Errorf("bomb not found in session")
+	ErrBombNotArmed         ActorError = fmt.Errorf("bomb is not armed yet")
+	ErrBombNotActive        ActorError = fmt.Errorf("bomb is no longer active")
+	ErrBombPaused           ActorError = fmt.Errorf("bomb is paused")
+	ErrModuleNotFound       ActorError = fmt.Errorf("module not found in bomb")
+	ErrModuleSolved         ActorError = fmt.Errorf("module is already solved")
+	ErrRequestTimeout       ActorError = fmt.Errorf("timed out waiting for the bomb")
+	ErrPauseChanging        ActorError = fmt.Errorf("game is already being paused or resumed")
 )
@@ -0,0 +1,48 @@
+package actors
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Must be called before the session is started.
+func (g *GameSessionActor) SetRequestTimeout(timeout time.Duration) {
+	g.requestTimeout = timeout
+}
+
+var RequestWithin = requestWithin
+
+// Must be called before any matches are created.
+func (s *ActorSystem) SetMatchRetention(retention time.Duration) {
+	s.matchRetention = retention
+}
+
+// Swaps in a different actor for one of the bomb's modules. Must be called before any
+// commands are sent to the bomb.
+func (b *BombActor) ReplaceModuleActor(moduleID uuid.UUID, actor ModuleActor) {
+	b.moduleActors[moduleID] = actor
+}
+
+func (b *BombActor) GetModuleActor(moduleID uuid.UUID) ModuleActor {
+	return b.moduleActors[moduleID]
+}
+
+// Turns running module actors on the bomb's goroutine on or off. Must be called before the
+// actor is started.
+func (b *BombActor) SetModuleScheduling(enabled bool) {
+	b.scheduleModules = enabled
+}
+
+// Reports whether the module's actor runs on the bomb's goroutine. Must be called after the
+// actor is started.
+func (b *BombActor) RunsModuleOnBomb(moduleID uuid.UUID) bool {
+	scheduled, ok := b.moduleActors[moduleID].(schedulableModuleActor)
+	return ok && scheduled.isScheduledOn(b.scheduler)
+}
+
+// Turns running module actors on their bomb's goroutine on or off for the session's bombs.
+// Must be called before any bombs are added.
+func (g *GameSessionActor) SetModuleScheduling(enabled bool) {
+	g.scheduleModules = enabled
+}
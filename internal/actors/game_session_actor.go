@@ -4,19 +4,132 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/ZaneH/defuse.party-go/internal/application/command"
 	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
 	"github.com/ZaneH/defuse.party-go/internal/domain/ports"
+	"github.com/ZaneH/defuse.party-go/internal/domain/services"
 	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
 	"github.com/google/uuid"
 )
 
+// Copy of a session and its bombs, taken inside the session's actor so it can be read from
+// any goroutine.
+type SessionSnapshot struct {
+	SessionID uuid.UUID
+	State     valueobject.SessionState
+	BombMode  valueobject.BombMode
+	// In the order the bombs were added
+	Bombs []entities.BombSnapshot
+	Lobby valueobject.Lobby
+	// Whether the game is paused, and whether it can be
+	Paused        bool
+	PauseDisabled bool
+}
+
+// Returns the snapshot of the bomb with the given ID.
+func (s SessionSnapshot) GetBomb(bombID uuid.UUID) (entities.BombSnapshot, bool) {
+	for _, bomb := range s.Bombs {
+		if bomb.ID == bombID {
+			return bomb, true
+		}
+	}
+	return entities.BombSnapshot{}, false
+}
+
+// How long the session waits on a bomb before answering with ErrRequestTimeout. Kept below
+// the services' own timeout so callers see why a request failed.
+const DefaultRequestTimeout = 3 * time.Second
+
+// Routes commands to the session's bombs. The session never waits on a module command inside
+// its own loop: replies are collected by a goroutine per request and handed back as
+// messages, so a slow module only holds up its own bomb.
 type GameSessionActor struct {
 	BaseActor
 	session    *entities.GameSession
-	bombActors map[uuid.UUID]BombActor
+	bombActors map[uuid.UUID]*BombActor
+	// Bomb IDs in the order they were added. Sequential sessions arm bombs in this order.
+	bombOrder []uuid.UUID
+	armed     map[uuid.UUID]bool
+	bombMode  valueobject.BombMode
+	// Rules every bomb added to the session is played with
+	modifiers []valueobject.Modifier
+	// Never changes once the actor has started, so request goroutines can read it
+	requestTimeout time.Duration
+	// Passed on to every bomb actor the session starts
+	scheduleModules bool
+	// Set while the bombs are being paused or resumed, until every bomb has answered
+	pauseChanging bool
+
+	// Face of every module on the session's bombs, by module ID
+	moduleFaces map[uuid.UUID]int
+	// Input each module is handling, by module ID
+	inputsInFlight map[uuid.UUID]*moduleInputs
+
+	// Spectators watching the session, by ID
+	spectators      map[uint64]*spectator
+	nextSpectatorID uint64
+	eventSequence   uint64
+	events          *eventLog
+	// Closed once the last update published so far is in the event log
+	published chan struct{}
+}
+
+// Input a module is handling for one player. Input from anyone else is turned away until
+// it's done, so two defusers acting on the same module at once always resolve the same
+// way: whoever the session heard from first goes through.
+type moduleInputs struct {
+	playerID string
+	count    int
+}
+
+// A bomb's reply to a module command, handed back to the session's loop
+type moduleReplyMessage struct {
+	request    ModuleCommandMessage
+	response   Response
+	receivedAt time.Time
+	// Rule that decided the input, asked for while the session has spectators
+	rule *valueobject.RuleExplanation
+	// Whether the command finished defusing the bomb
+	bombDefused bool
+}
+
+func (m moduleReplyMessage) MessageType() string {
+	return "ModuleReply"
+}
+
+// Snapshots for a game report, handed back to the session's loop to be combined with the
+// session's module stats
+type gameReportSnapshotMessage struct {
+	request  GetGameReportMessage
+	snapshot SessionSnapshot
+}
+
+func (m gameReportSnapshotMessage) MessageType() string {
+	return "GameReportSnapshot"
+}
+
+// The bombs' answers to a pause or resume, handed back to the session's loop
+type bombsPausedMessage struct {
+	paused          bool
+	at              time.Time
+	err             error
+	responseChannel chan Response
+}
+
+func (m bombsPausedMessage) MessageType() string {
+	return "BombsPaused"
+}
+
+// Sent once a failed pause or resume has been undone on every bomb
+type pauseChangeUndoneMessage struct{}
+
+func (m pauseChangeUndoneMessage) MessageType() string {
+	return "PauseChangeUndone"
 }
 
 func NewGameSessionActor(rng ports.RandomGenerator, config valueobject.GameSessionConfig) (actor *GameSessionActor, sessionID uuid.UUID) {
@@ -24,18 +137,73 @@ func NewGameSessionActor(rng ports.RandomGenerator, config valueobject.GameSessi
 
 	session := entities.NewGameSession(sessionID)
 	session.SetRandomGenerator(rng)
+	session.Difficulty = config.Difficulty
+	if !config.SeedHidden {
+		session.Seed = config.Seed()
+	}
+	session.Leaderboard = config.LeaderboardKey()
+	session.PauseDisabled = config.PauseDisabled
+	session.SpectatorDelay = config.SpectatorDelay
+	session.StartedByMatch = config.StartedByMatch
 
 	actor = &GameSessionActor{
-		BaseActor:  NewBaseActor(100),
-		bombActors: make(map[uuid.UUID]BombActor),
-		session:    session,
+		BaseActor:       NewBaseActor(100),
+		bombActors:      make(map[uuid.UUID]*BombActor),
+		armed:           make(map[uuid.UUID]bool),
+		session:         session,
+		bombMode:        config.BombMode,
+		modifiers:       slices.Clone(config.Modifiers),
+		requestTimeout:  DefaultRequestTimeout,
+		scheduleModules: true,
+		moduleFaces:     make(map[uuid.UUID]int),
+		inputsInFlight:  make(map[uuid.UUID]*moduleInputs),
+		spectators:      make(map[uint64]*spectator),
+		events:          newEventLog(config.SpectatorDelay + eventLogGrace),
+		published:       make(chan struct{}),
 	}
+	close(actor.published)
 
 	return actor, sessionID
 }
 
-func (g *GameSessionActor) GetBombActors() map[uuid.UUID]BombActor {
-	return g.bombActors
+// Returns the session's bomb actors in the order they were added. Like BombActor.GetBomb,
+// this is only safe when no messages are in flight; read a running session through
+// GetBombsMessage instead.
+func (g *GameSessionActor) GetOrderedBombActors() []*BombActor {
+	ordered := make([]*BombActor, 0, len(g.bombOrder))
+	for _, bombID := range g.bombOrder {
+		ordered = append(ordered, g.bombActors[bombID])
+	}
+	return ordered
+}
+
+func (g *GameSessionActor) GetBombMode() valueobject.BombMode {
+	return g.bombMode
+}
+
+// Returns the outcome of the session as a whole. The session fails as soon as any bomb
+// explodes and completes once every bomb has been defused.
+func sessionState(bombs []entities.BombSnapshot) valueobject.SessionState {
+	if len(bombs) == 0 {
+		return valueobject.SessionStateInProgress
+	}
+
+	allDefused := true
+	for _, bomb := range bombs {
+		switch bomb.State {
+		case valueobject.BombStateExploded:
+			return valueobject.SessionStateFailed
+		case valueobject.BombStateDefused:
+		default:
+			allDefused = false
+		}
+	}
+
+	if allDefused {
+		return valueobject.SessionStateCompleted
+	}
+
+	return valueobject.SessionStateInProgress
 }
 
 func (g *GameSessionActor) Start() {
@@ -79,6 +247,38 @@ func (g *GameSessionActor) handleMessage(msg Message) {
 		g.handleAddBombCommand(m)
 	case GetBombsMessage:
 		g.handleGetBombsCommand(m)
+	case GetGameReportMessage:
+		g.handleGetGameReportCommand(m)
+	case moduleReplyMessage:
+		g.handleModuleReply(m)
+	case gameReportSnapshotMessage:
+		g.handleGameReportSnapshot(m)
+	case JoinLobbyMessage:
+		g.handleJoinLobby(m)
+	case SetPlayerReadyMessage:
+		g.handleSetPlayerReady(m)
+	case StartGameMessage:
+		g.handleStartGame(m)
+	case AssignFacesMessage:
+		g.handleAssignFaces(m)
+	case RevealEdgeworkMessage:
+		g.handleRevealEdgework(m)
+	case PauseGameMessage:
+		g.handlePauseGame(m)
+	case ResumeGameMessage:
+		g.handleResumeGame(m)
+	case bombsPausedMessage:
+		g.handleBombsPaused(m)
+	case pauseChangeUndoneMessage:
+		g.pauseChanging = false
+	case CheckPlayingMessage:
+		g.handleCheckPlaying(m)
+	case WatchSessionMessage:
+		g.handleWatchSession(m)
+	case ObserveSessionMessage:
+		g.handleObserveSession(m)
+	case unwatchSessionMessage:
+		g.handleUnwatchSession(m)
 	default:
 		log.Printf("received unhandled message type: %T", msg)
 		if m, ok := msg.(RequestMessage); ok {
@@ -91,62 +291,569 @@ func (g *GameSessionActor) handleMessage(msg Message) {
 
 func (g *GameSessionActor) handleAddBombCommand(msg AddBombMessage) {
 	bomb := msg.Bomb
+	bomb.SetModifiers(g.modifiers)
+
+	for face, bombFace := range bomb.Faces {
+		if len(bombFace.ModulesByPosition) == 0 {
+			continue
+		}
+		for _, moduleID := range bombFace.ModulesByPosition {
+			g.moduleFaces[moduleID] = face
+		}
+		g.session.AddBombFaces(face)
+	}
 
 	bombActor := NewBombActor(bomb)
+	bombActor.scheduleModules = g.scheduleModules
+	bombActor.requestTimeout = g.requestTimeout
 	bombActor.Start() // TODO: Consider finding a better place to start the actor
-	g.bombActors[bomb.ID] = *bombActor
+	g.bombActors[bomb.ID] = bombActor
+	g.bombOrder = append(g.bombOrder, bomb.ID)
+
+	g.armBombs(time.Now())
 
 	msg.ResponseChannel <- &SuccessResponse{Data: bomb.ID}
 }
 
-func (g *GameSessionActor) handleGetBombsCommand(msg GetBombsMessage) {
-	msg.ResponseChannel <- &SuccessResponse{Data: &g.bombActors}
+func (g *GameSessionActor) handleJoinLobby(msg JoinLobbyMessage) {
+	if err := g.session.Join(msg.PlayerID, msg.Role); err != nil {
+		msg.ResponseChannel <- ErrorResponse{Err: err}
+		return
+	}
+
+	player, _ := g.session.Player(msg.PlayerID)
+	g.publish(valueobject.SessionEvent{Type: valueobject.SessionEventPlayerJoined, At: time.Now(), Player: player})
+
+	msg.ResponseChannel <- SuccessResponse{Data: g.session.Lobby()}
 }
 
-func (g *GameSessionActor) handleModuleCommand(msg ModuleCommandMessage) {
-	cmd := msg.Command
-	bombID := cmd.GetBombID()
+func (g *GameSessionActor) handleSetPlayerReady(msg SetPlayerReadyMessage) {
+	if err := g.session.SetReady(msg.PlayerID, msg.Ready); err != nil {
+		msg.ResponseChannel <- ErrorResponse{Err: err}
+		return
+	}
+
+	player, _ := g.session.Player(msg.PlayerID)
+	g.publish(valueobject.SessionEvent{Type: valueobject.SessionEventPlayerReady, At: time.Now(), Player: player})
+
+	if g.session.AllReady() && !g.session.StartedByMatch {
+		g.startGame(time.Now())
+	}
+
+	msg.ResponseChannel <- SuccessResponse{Data: g.session.Lobby()}
+}
+
+func (g *GameSessionActor) handleCheckPlaying(msg CheckPlayingMessage) {
+	if err := g.session.CheckPlaying(msg.PlayerID); err != nil {
+		msg.ResponseChannel <- ErrorResponse{Err: err}
+		return
+	}
+	msg.ResponseChannel <- SuccessResponse{}
+}
+
+func (g *GameSessionActor) handleStartGame(msg StartGameMessage) {
+	if g.session.StartedByMatch && !msg.byMatch {
+		msg.ResponseChannel <- ErrorResponse{Err: valueobject.ErrStartedByMatch}
+		return
+	}
 
-	bombActor, exists := g.bombActors[bombID]
+	at := msg.at
+	if at.IsZero() {
+		at = time.Now()
+	}
+	if err := g.startGame(at); err != nil {
+		msg.ResponseChannel <- ErrorResponse{Err: err}
+		return
+	}
+
+	msg.ResponseChannel <- SuccessResponse{Data: g.session.Lobby()}
+}
+
+func (g *GameSessionActor) startGame(at time.Time) error {
+	if err := g.session.Start(at); err != nil {
+		return err
+	}
+
+	g.armBombs(at)
+	g.publish(valueobject.SessionEvent{Type: valueobject.SessionEventGameStarted, At: at})
+	return nil
+}
+
+func (g *GameSessionActor) handleAssignFaces(msg AssignFacesMessage) {
+	if err := g.session.AssignFaces(msg.CallerID, msg.PlayerID, msg.Faces); err != nil {
+		msg.ResponseChannel <- ErrorResponse{Err: err}
+		return
+	}
+
+	player, _ := g.session.Player(msg.PlayerID)
+	g.publish(valueobject.SessionEvent{Type: valueobject.SessionEventFacesAssigned, At: time.Now(), Player: player})
+
+	msg.ResponseChannel <- SuccessResponse{Data: g.session.Lobby()}
+}
+
+func (g *GameSessionActor) handleRevealEdgework(msg RevealEdgeworkMessage) {
+	bombActor, exists := g.bombActors[msg.BombID]
 	if !exists {
-		msg.ResponseChannel <- ErrorResponse{
-			Err: errors.New("bomb not found in session"),
+		msg.ResponseChannel <- ErrorResponse{Err: ErrBombNotFound}
+		return
+	}
+
+	if !g.session.IsStarted() {
+		msg.ResponseChannel <- ErrorResponse{Err: valueobject.ErrGameNotStarted}
+		return
+	}
+
+	resp := g.request(bombActor, func(respChan chan Response) Message {
+		return RevealEdgeworkMessage{BombID: msg.BombID, ResponseChannel: respChan}
+	})
+	if !resp.IsSuccess() {
+		msg.ResponseChannel <- resp
+		return
+	}
+
+	g.publish(valueobject.SessionEvent{Type: valueobject.SessionEventEdgeworkRevealed, At: time.Now(), BombID: msg.BombID})
+	msg.ResponseChannel <- SuccessResponse{}
+}
+
+// Holds every armed bomb's clock. Bombs armed while the game is paused start out paused.
+// The bombs are paused off the loop and the caller is answered once they all have been.
+func (g *GameSessionActor) handlePauseGame(msg PauseGameMessage) {
+	if g.pauseChanging {
+		msg.ResponseChannel <- ErrorResponse{Err: ErrPauseChanging}
+		return
+	}
+	if err := g.session.Pause(); err != nil {
+		msg.ResponseChannel <- ErrorResponse{Err: err}
+		return
+	}
+
+	g.setBombsPaused(true, msg.ResponseChannel)
+}
+
+func (g *GameSessionActor) handleResumeGame(msg ResumeGameMessage) {
+	if g.pauseChanging {
+		msg.ResponseChannel <- ErrorResponse{Err: ErrPauseChanging}
+		return
+	}
+	if err := g.session.Resume(); err != nil {
+		msg.ResponseChannel <- ErrorResponse{Err: err}
+		return
+	}
+
+	g.setBombsPaused(false, msg.ResponseChannel)
+}
+
+// Pauses or resumes every armed bomb at the same moment so that their clocks stay in step,
+// then hands the outcome back to the loop. No other pause or resume is taken until it's in.
+func (g *GameSessionActor) setBombsPaused(paused bool, respChan chan Response) {
+	g.pauseChanging = true
+	at := time.Now()
+	bombActors := g.armedBombActors()
+
+	go func() {
+		err := g.requestBombsPaused(bombActors, paused, at)
+		g.Send(bombsPausedMessage{paused: paused, at: at, err: err, responseChannel: respChan})
+	}()
+}
+
+// Sends the pause or resume to every bomb at once and waits for all of them. Runs outside
+// the session's loop.
+func (g *GameSessionActor) requestBombsPaused(bombActors []*BombActor, paused bool, at time.Time) error {
+	errs := make([]error, len(bombActors))
+	var wg sync.WaitGroup
+	for i, bombActor := range bombActors {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp := g.request(bombActor, func(respChan chan Response) Message {
+				return SetBombPausedMessage{Paused: paused, At: at, ResponseChannel: respChan}
+			})
+			if !resp.IsSuccess() {
+				errs[i] = fmt.Errorf("bomb %v: %w", bombActor.GetBombID(), resp.Error())
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// Announces a pause or resume every bomb took. If any bomb didn't, the session goes back
+// to how it was and the caller gets the error straight away, while the change is undone on
+// every armed bomb in the background. The undo is made at the moment of the change, after
+// it in each bomb's mailbox, so bombs that did change end up as if they never had.
+func (g *GameSessionActor) handleBombsPaused(msg bombsPausedMessage) {
+	if msg.err == nil {
+		g.pauseChanging = false
+		eventType := valueobject.SessionEventGameResumed
+		if msg.paused {
+			eventType = valueobject.SessionEventGamePaused
 		}
+		g.publish(valueobject.SessionEvent{Type: eventType, At: msg.at})
+		msg.responseChannel <- SuccessResponse{}
 		return
 	}
 
-	moduleID := cmd.GetModuleID()
-	moduleActor, exists := bombActor.moduleActors[moduleID]
-	if !exists {
-		msg.ResponseChannel <- ErrorResponse{
-			Err: errors.New("module actor not found"),
+	if msg.paused {
+		g.session.Resume()
+	} else {
+		g.session.Pause()
+	}
+	msg.responseChannel <- ErrorResponse{Err: msg.err}
+
+	bombActors := g.armedBombActors()
+	go func() {
+		if err := g.requestBombsPaused(bombActors, !msg.paused, msg.at); err != nil {
+			log.Printf("error undoing pause change: %v", err)
+		}
+		g.Send(pauseChangeUndoneMessage{})
+	}()
+}
+
+// Bombs armed since a pause change started are included: they were armed to match the
+// session, so they're undone along with the rest.
+func (g *GameSessionActor) armedBombActors() []*BombActor {
+	var bombActors []*BombActor
+	for _, bombID := range g.bombOrder {
+		if g.armed[bombID] {
+			bombActors = append(bombActors, g.bombActors[bombID])
 		}
+	}
+	return bombActors
+}
+
+// Arms the bombs that should be live once the game has started: every bomb in a parallel
+// session, or only the first in a sequential one.
+func (g *GameSessionActor) armBombs(at time.Time) {
+	if !g.session.IsStarted() {
+		return
+	}
+
+	for i, bombID := range g.bombOrder {
+		if g.bombMode == valueobject.BombModeSequential && i > 0 {
+			return
+		}
+		if !g.armed[bombID] {
+			g.armBomb(g.bombActors[bombID], at)
+		}
+	}
+}
+
+func (g *GameSessionActor) handleGetBombsCommand(msg GetBombsMessage) {
+	bombActors := g.GetOrderedBombActors()
+	session := g.describeSession()
+
+	go func() {
+		snapshot, err := g.takeSnapshot(bombActors, session)
+		if err != nil {
+			msg.ResponseChannel <- ErrorResponse{Err: err}
+			return
+		}
+		msg.ResponseChannel <- SuccessResponse{Data: snapshot}
+	}()
+}
+
+// Collects the bombs' snapshots off the loop, then builds the report back inside it so
+// module stats aren't read while they're being recorded.
+func (g *GameSessionActor) handleGetGameReportCommand(msg GetGameReportMessage) {
+	bombActors := g.GetOrderedBombActors()
+	session := g.describeSession()
+
+	go func() {
+		snapshot, err := g.takeSnapshot(bombActors, session)
+		if err != nil {
+			msg.ResponseChannel <- ErrorResponse{Err: err}
+			return
+		}
+		g.Send(gameReportSnapshotMessage{request: msg, snapshot: snapshot})
+	}()
+}
+
+func (g *GameSessionActor) handleGameReportSnapshot(msg gameReportSnapshotMessage) {
+	report := services.BuildGameReport(g.session, msg.snapshot.Bombs, msg.snapshot.State)
+	msg.request.ResponseChannel <- SuccessResponse{Data: report}
+}
+
+func (g *GameSessionActor) handleModuleCommand(msg ModuleCommandMessage) {
+	bombActor, exists := g.bombActors[msg.Command.GetBombID()]
+	if !exists {
+		msg.ResponseChannel <- ErrorResponse{Err: ErrBombNotFound}
 		return
 	}
 
-	proxyChannel := make(chan Response, 1)
+	if !g.session.IsStarted() {
+		msg.ResponseChannel <- ErrorResponse{Err: valueobject.ErrGameNotStarted}
+		return
+	}
 
-	proxyMsg := ModuleCommandMessage{
-		Command:         msg.Command,
-		ResponseChannel: proxyChannel,
+	if g.session.IsPaused() {
+		msg.ResponseChannel <- ErrorResponse{Err: valueobject.ErrGamePaused}
+		return
 	}
 
-	moduleActor.Send(proxyMsg)
+	moduleID, playerID := msg.Command.GetModuleID(), strings.TrimSpace(msg.Command.GetPlayerID())
+	var err error
+	if face, ok := g.moduleFaces[moduleID]; ok {
+		err = g.session.CheckInput(playerID, face)
+	} else {
+		err = g.session.CheckDefuser(playerID)
+	}
+	if err != nil {
+		msg.ResponseChannel <- ErrorResponse{Err: err}
+		return
+	}
 
-	response := <-proxyChannel
+	inputs, busy := g.inputsInFlight[moduleID]
+	if busy && inputs.playerID != playerID {
+		msg.ResponseChannel <- ErrorResponse{Err: valueobject.ErrInputConflict}
+		return
+	}
+	if !busy {
+		inputs = &moduleInputs{playerID: playerID}
+		g.inputsInFlight[moduleID] = inputs
+	}
+	inputs.count++
+
+	watched := len(g.spectators) > 0
+
+	go func() {
+		response := g.requestModuleCommand(bombActor, msg.Command, watched)
+		reply := moduleReplyMessage{
+			request:    msg,
+			response:   response,
+			receivedAt: time.Now(),
+		}
+
+		// The rule is only for spectators, so it's taken off before the player sees the result
+		if successResp, ok := response.(SuccessResponse); ok {
+			if explained, ok := successResp.Data.(explainedResult); ok {
+				reply.response = SuccessResponse{Data: explained.result}
+				reply.rule = explained.rule
+			}
+		}
+
+		// Only sequential sessions need to know when a bomb is done
+		if g.bombMode == valueobject.BombModeSequential && response.IsSuccess() {
+			if snapshot, err := g.requestBombSnapshot(bombActor); err == nil {
+				reply.bombDefused = snapshot.State == valueobject.BombStateDefused
+			}
+		}
+
+		g.Send(reply)
+	}()
+}
+
+func (g *GameSessionActor) handleModuleReply(msg moduleReplyMessage) {
+	cmd := msg.request.Command
+	response := msg.response
+	playerID := strings.TrimSpace(cmd.GetPlayerID())
+
+	if inputs, ok := g.inputsInFlight[cmd.GetModuleID()]; ok {
+		inputs.count--
+		if inputs.count == 0 {
+			delete(g.inputsInFlight, cmd.GetModuleID())
+		}
+	}
 
 	if successResp, ok := response.(SuccessResponse); ok {
 		if result, ok := successResp.Data.(command.ModuleInputCommandResult); ok {
-			if result.HasStrike() {
-				bombActor.GetBomb().AddStrike()
-			}
+			g.session.RecordModuleInput(cmd.GetModuleID(), playerID, msg.receivedAt, result.IsStrikeCounted(), result.IsSolved())
+			player, _ := g.session.Player(playerID)
+			g.publish(valueobject.SessionEvent{
+				Type:     valueobject.SessionEventModuleInput,
+				At:       msg.receivedAt,
+				Player:   player,
+				BombID:   cmd.GetBombID(),
+				ModuleID: cmd.GetModuleID(),
+				Strike:   result.HasStrike(),
+				Solved:   result.IsSolved(),
+				Rule:     msg.rule,
+			})
 		} else {
 			log.Printf("unhandled response type: %T", successResp.Data)
 		}
 	} else {
+		if reachedModule(response.Error()) {
+			g.session.RecordModuleInput(cmd.GetModuleID(), playerID, msg.receivedAt, false, false)
+		}
 		log.Printf("unexpected error response type: %T", response)
 		log.Printf("error: %v", response)
 	}
 
-	msg.ResponseChannel <- response
+	if msg.bombDefused {
+		g.armNextBomb()
+	}
+
+	msg.request.ResponseChannel <- response
+}
+
+// Returns false for input the bomb or a solved module turned away, which never reached the
+// module's rules, and for timed out commands, whose outcome isn't known.
+func reachedModule(err error) bool {
+	for _, turnedAway := range []error{ErrBombNotArmed, ErrBombNotActive, ErrBombPaused, ErrModuleNotFound, ErrModuleSolved, ErrRequestTimeout} {
+		if errors.Is(err, turnedAway) {
+			return false
+		}
+	}
+	return true
+}
+
+// In a sequential session, defusing a bomb arms the next one.
+func (g *GameSessionActor) armNextBomb() {
+	for _, bombID := range g.bombOrder {
+		if !g.armed[bombID] {
+			g.armBomb(g.bombActors[bombID], time.Now())
+			return
+		}
+	}
+}
+
+// Copies everything a snapshot reports about the session itself. Must be called from the
+// session's loop.
+func (g *GameSessionActor) describeSession() SessionSnapshot {
+	return SessionSnapshot{
+		SessionID:     g.session.SessionID,
+		BombMode:      g.bombMode,
+		Lobby:         g.session.Lobby(),
+		Paused:        g.session.IsPaused(),
+		PauseDisabled: g.session.PauseDisabled,
+	}
+}
+
+// Adds a snapshot of each bomb to the session described by describeSession. Runs outside
+// the session's loop, so it doesn't touch the session.
+func (g *GameSessionActor) takeSnapshot(bombActors []*BombActor, snapshot SessionSnapshot) (SessionSnapshot, error) {
+	return g.collectSnapshots(g.askForSnapshots(bombActors), snapshot)
+}
+
+// A bomb's snapshot, asked for but not yet answered
+type pendingBombSnapshot struct {
+	bombActor *BombActor
+	// Nil if the bomb's mailbox was full, in which case it's asked again when collected
+	response chan Response
+}
+
+// Asks each bomb for a snapshot without waiting on any of them. Called from the session's
+// loop, each bomb answers as it was straight after whatever the loop already sent it.
+func (g *GameSessionActor) askForSnapshots(bombActors []*BombActor) []pendingBombSnapshot {
+	pending := make([]pendingBombSnapshot, 0, len(bombActors))
+	for _, bombActor := range bombActors {
+		respChan := make(chan Response, 1)
+		if !bombActor.trySend(GetBombSnapshotMessage{ResponseChannel: respChan}) {
+			respChan = nil
+		}
+		pending = append(pending, pendingBombSnapshot{bombActor: bombActor, response: respChan})
+	}
+	return pending
+}
+
+// Waits for the snapshots asked for by askForSnapshots and adds them to the session
+// described by describeSession. Each bomb gets the session's request deadline to answer.
+func (g *GameSessionActor) collectSnapshots(pending []pendingBombSnapshot, snapshot SessionSnapshot) (SessionSnapshot, error) {
+	snapshot.Bombs = make([]entities.BombSnapshot, 0, len(pending))
+
+	for _, p := range pending {
+		bomb, err := g.collectSnapshot(p)
+		if err != nil {
+			return SessionSnapshot{}, err
+		}
+		snapshot.Bombs = append(snapshot.Bombs, bomb)
+	}
+	snapshot.State = sessionState(snapshot.Bombs)
+	if !snapshot.Lobby.Started {
+		snapshot.State = valueobject.SessionStateLobby
+	}
+
+	return snapshot, nil
+}
+
+func (g *GameSessionActor) collectSnapshot(p pendingBombSnapshot) (entities.BombSnapshot, error) {
+	if p.response == nil {
+		return g.requestBombSnapshot(p.bombActor)
+	}
+
+	deadline := time.NewTimer(g.requestTimeout)
+	defer deadline.Stop()
+	select {
+	case resp := <-p.response:
+		if !resp.IsSuccess() {
+			return entities.BombSnapshot{}, resp.Error()
+		}
+		return resp.(SuccessResponse).Data.(entities.BombSnapshot), nil
+	case <-deadline.C:
+		return entities.BombSnapshot{}, ErrRequestTimeout
+	}
+}
+
+func (g *GameSessionActor) requestBombSnapshot(bombActor *BombActor) (entities.BombSnapshot, error) {
+	resp := g.request(bombActor, func(respChan chan Response) Message {
+		return GetBombSnapshotMessage{ResponseChannel: respChan}
+	})
+	if !resp.IsSuccess() {
+		return entities.BombSnapshot{}, resp.Error()
+	}
+	return resp.(SuccessResponse).Data.(entities.BombSnapshot), nil
+}
+
+// Waits for the bomb to start its clock so that anything sent to it afterwards sees it
+// armed. Bombs being armed aren't taking input yet, so this doesn't wait on a module.
+func (g *GameSessionActor) armBomb(bombActor *BombActor, at time.Time) {
+	resp := g.request(bombActor, func(respChan chan Response) Message {
+		return ArmBombMessage{At: at, Paused: g.session.IsPaused(), ResponseChannel: respChan}
+	})
+	if !resp.IsSuccess() {
+		log.Printf("error arming bomb %v: %v", bombActor.GetBombID(), resp.Error())
+		return
+	}
+	g.armed[bombActor.GetBombID()] = true
+}
+
+// Sends a request to a bomb and waits for its reply until the session's request deadline.
+func (g *GameSessionActor) request(actor Actor, newMsg func(chan Response) Message) Response {
+	return requestWithin(actor, g.requestTimeout, newMsg)
+}
+
+// Sends a module command to a bomb and waits for its reply until the session's request
+// deadline. Unlike request, a command the module has started on is waited for past the
+// deadline, so a player told their input timed out knows it wasn't applied.
+func (g *GameSessionActor) requestModuleCommand(bombActor *BombActor, cmd command.ModuleInputCommand, explainRule bool) Response {
+	claim := &commandClaim{}
+	respChan := make(chan Response, 1)
+	deadline := time.NewTimer(g.requestTimeout)
+	defer deadline.Stop()
+
+	msg := ModuleCommandMessage{Command: cmd, ResponseChannel: respChan, explainRule: explainRule, claim: claim}
+	if !sendBefore(bombActor, msg, deadline.C) {
+		return ErrorResponse{Err: ErrRequestTimeout}
+	}
+
+	select {
+	case resp := <-respChan:
+		return resp
+	case <-deadline.C:
+		if claim.abandon() {
+			return ErrorResponse{Err: ErrRequestTimeout}
+		}
+		return <-respChan
+	}
+}
+
+// Sends a request to an actor and waits for its reply until the timeout. An actor with a
+// full mailbox counts against the timeout instead of blocking the caller, and a reply that
+// comes after the timeout is dropped.
+func requestWithin(actor Actor, timeout time.Duration, newMsg func(chan Response) Message) Response {
+	respChan := make(chan Response, 1)
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	if !sendBefore(actor, newMsg(respChan), deadline.C) {
+		return ErrorResponse{Err: ErrRequestTimeout}
+	}
+
+	select {
+	case resp := <-respChan:
+		return resp
+	case <-deadline.C:
+		return ErrorResponse{Err: ErrRequestTimeout}
+	}
 }
@@ -0,0 +1,1056 @@
+package actors_test
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/actors"
+	"github.com/ZaneH/defuse.party-go/internal/application/command"
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// Creates a bomb with a single wires module that is solved by cutting the second wire
+func newSingleWireBomb(rng *services.SeededRNG) (*entities.Bomb, *entities.WiresModule) {
+	bomb := entities.NewBomb(rng, valueobject.NewDefaultBombConfig())
+	return bomb, addWiresModule(rng, bomb, valueobject.ModulePosition{})
+}
+
+// Adds a wires module that strikes for the first wire and is solved by the second
+func addWiresModule(rng *services.SeededRNG, bomb *entities.Bomb, position valueobject.ModulePosition) *entities.WiresModule {
+	wiresModule := entities.NewWiresModule(rng)
+	wiresModule.SetBomb(bomb)
+	wiresModule.SetState(entities.WiresState{
+		Wires: []valueobject.Wire{
+			{WireColor: valueobject.Yellow, Position: 1},
+			{WireColor: valueobject.Blue, Position: 2},
+			{WireColor: valueobject.Black, Position: 3},
+		},
+	})
+	bomb.AddModule(wiresModule, position)
+
+	return wiresModule
+}
+
+func sendAndWait(t *testing.T, actor actors.Actor, msg func(chan actors.Response) actors.Message) actors.Response {
+	respChan := make(chan actors.Response, 1)
+	actor.Send(msg(respChan))
+
+	select {
+	case resp := <-respChan:
+		return resp
+	case <-time.After(1 * time.Second):
+		t.Fatalf("timeout waiting for response")
+		return nil
+	}
+}
+
+func awaitResponse(t *testing.T, respChan chan actors.Response) actors.Response {
+	select {
+	case resp := <-respChan:
+		return resp
+	case <-time.After(1 * time.Second):
+		t.Fatalf("timeout waiting for response")
+		return nil
+	}
+}
+
+func getSnapshot(t *testing.T, sessionActor *actors.GameSessionActor) actors.SessionSnapshot {
+	resp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.GetBombsMessage{ResponseChannel: respChan}
+	})
+	return resp.(actors.SuccessResponse).Data.(actors.SessionSnapshot)
+}
+
+// Ends the session's lobby so that its bombs are armed
+func startGame(t *testing.T, sessionActor *actors.GameSessionActor) {
+	resp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.StartGameMessage{ResponseChannel: respChan}
+	})
+	assert.True(t, resp.IsSuccess(), "Expected the game to start")
+}
+
+func TestGameSessionActor_SequentialBombsArmInOrder(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	config := valueobject.NewEasyGameSessionConfig("test")
+	config.BombMode = valueobject.BombModeSequential
+
+	sessionActor, sessionID := actors.NewGameSessionActor(rng, config)
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	firstBomb, firstWires := newSingleWireBomb(rng)
+	secondBomb, _ := newSingleWireBomb(rng)
+
+	for _, bomb := range []*entities.Bomb{firstBomb, secondBomb} {
+		resp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+			return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+		})
+		assert.True(t, resp.IsSuccess(), "Expected bomb to be added")
+	}
+	startGame(t, sessionActor)
+
+	assert.Equal(t, valueobject.BombStateArmed, firstBomb.GetState(), "First bomb should be armed")
+	assert.Equal(t, valueobject.BombStateWaiting, secondBomb.GetState(), "Second bomb should wait")
+
+	// Act
+	resp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.ModuleCommandMessage{
+			Command: &command.WiresInputCommand{
+				BaseModuleInputCommand: command.BaseModuleInputCommand{
+					SessionID: sessionID,
+					BombID:    firstBomb.ID,
+					ModuleID:  firstWires.GetModuleID(),
+				},
+				WirePosition: 2,
+			},
+			ResponseChannel: respChan,
+		}
+	})
+
+	// Assert
+	assert.True(t, resp.IsSuccess(), "Expected wire cut to succeed")
+	assert.Equal(t, valueobject.BombStateDefused, firstBomb.GetState(), "First bomb should be defused")
+	assert.Equal(t, valueobject.BombStateArmed, secondBomb.GetState(), "Second bomb should arm after the first is defused")
+	assert.Equal(t, valueobject.SessionStateInProgress, getSnapshot(t, sessionActor).State)
+}
+
+func TestGameSessionActor_RejectsInputForWaitingBomb(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	config := valueobject.NewEasyGameSessionConfig("test")
+	config.BombMode = valueobject.BombModeSequential
+
+	sessionActor, sessionID := actors.NewGameSessionActor(rng, config)
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	firstBomb, _ := newSingleWireBomb(rng)
+	secondBomb, secondWires := newSingleWireBomb(rng)
+
+	for _, bomb := range []*entities.Bomb{firstBomb, secondBomb} {
+		sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+			return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+		})
+	}
+	startGame(t, sessionActor)
+
+	// Act
+	resp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.ModuleCommandMessage{
+			Command: &command.WiresInputCommand{
+				BaseModuleInputCommand: command.BaseModuleInputCommand{
+					SessionID: sessionID,
+					BombID:    secondBomb.ID,
+					ModuleID:  secondWires.GetModuleID(),
+				},
+				WirePosition: 2,
+			},
+			ResponseChannel: respChan,
+		}
+	})
+
+	// Assert
+	assert.ErrorIs(t, resp.Error(), actors.ErrBombNotArmed, "Input for a bomb that isn't armed should be rejected")
+	assert.False(t, secondWires.GetModuleState().IsSolved(), "Module should be untouched")
+}
+
+func TestGameSessionActor_PracticeStrikesExplainRule(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	config, err := valueobject.NewGameSessionConfigForPractice("test", valueobject.WiresModule)
+	assert.NoError(t, err)
+
+	sessionActor, sessionID := actors.NewGameSessionActor(rng, config)
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	bomb := entities.NewBomb(rng, config.BombConfigs[0])
+	wiresModule := entities.NewWiresModule(rng)
+	wiresModule.SetBomb(bomb)
+	wiresModule.SetState(entities.WiresState{
+		Wires: []valueobject.Wire{
+			{WireColor: valueobject.Yellow, Position: 1},
+			{WireColor: valueobject.Blue, Position: 2},
+			{WireColor: valueobject.Black, Position: 3},
+		},
+	})
+	bomb.AddModule(wiresModule, valueobject.ModulePosition{})
+
+	resp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+	})
+	assert.True(t, resp.IsSuccess(), "Expected bomb to be added")
+	startGame(t, sessionActor)
+
+	// Act: cut the wrong wire more times than a normal bomb could survive
+	var result *command.WiresInputCommandResult
+	for range bomb.MaxStrikes + 1 {
+		resp = sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+			return actors.ModuleCommandMessage{
+				Command: &command.WiresInputCommand{
+					BaseModuleInputCommand: command.BaseModuleInputCommand{
+						SessionID: sessionID,
+						BombID:    bomb.ID,
+						ModuleID:  wiresModule.GetModuleID(),
+					},
+					WirePosition: 1,
+				},
+				ResponseChannel: respChan,
+			}
+		})
+		assert.True(t, resp.IsSuccess(), "Expected input to be accepted")
+		result = resp.(actors.SuccessResponse).Data.(*command.WiresInputCommandResult)
+	}
+
+	// Assert
+	assert.True(t, result.Strike, "Expected a strike for the wrong wire")
+	if assert.NotNil(t, result.Rule, "Expected the strike to explain the rule") {
+		assert.Equal(t, "wires.3.no_red", result.Rule.RuleID)
+		assert.Equal(t, "3 wires, no red → cut second wire", result.Rule.Description)
+	}
+	assert.Equal(t, valueobject.BombStateArmed, bomb.GetState(), "Practice bombs shouldn't explode")
+}
+
+func TestGameSessionActor_StrikesOutsidePracticeHaveNoRule(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	sessionActor, sessionID := actors.NewGameSessionActor(rng, valueobject.NewEasyGameSessionConfig("test"))
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	bomb, wiresModule := newSingleWireBomb(rng)
+	sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+	})
+	startGame(t, sessionActor)
+
+	// Act
+	resp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.ModuleCommandMessage{
+			Command: &command.WiresInputCommand{
+				BaseModuleInputCommand: command.BaseModuleInputCommand{
+					SessionID: sessionID,
+					BombID:    bomb.ID,
+					ModuleID:  wiresModule.GetModuleID(),
+				},
+				WirePosition: 1,
+			},
+			ResponseChannel: respChan,
+		}
+	})
+
+	// Assert
+	result := resp.(actors.SuccessResponse).Data.(*command.WiresInputCommandResult)
+	assert.True(t, result.Strike, "Expected a strike for the wrong wire")
+	assert.Nil(t, result.Rule, "Rules should only be explained on practice bombs")
+}
+
+func TestGameSessionActor_GameReportTracksModuleTimeline(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	sessionActor, sessionID := actors.NewGameSessionActor(rng, valueobject.NewEasyGameSessionConfig("test"))
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	bomb, wiresModule := newSingleWireBomb(rng)
+	sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+	})
+	startGame(t, sessionActor)
+
+	// Act: strike once, then solve the module
+	for _, position := range []int{1, 2} {
+		sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+			return actors.ModuleCommandMessage{
+				Command: &command.WiresInputCommand{
+					BaseModuleInputCommand: command.BaseModuleInputCommand{
+						SessionID: sessionID,
+						BombID:    bomb.ID,
+						ModuleID:  wiresModule.GetModuleID(),
+					},
+					WirePosition: position,
+				},
+				ResponseChannel: respChan,
+			}
+		})
+	}
+
+	resp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.GetGameReportMessage{ResponseChannel: respChan}
+	})
+
+	// Assert
+	assert.True(t, resp.IsSuccess(), "Expected a report")
+	report := resp.(actors.SuccessResponse).Data.(valueobject.GameReport)
+	assert.Equal(t, valueobject.SessionStateCompleted, report.State)
+	assert.Len(t, report.Bombs, 1)
+
+	bombReport := report.Bombs[0]
+	assert.Equal(t, valueobject.BombStateDefused, bombReport.State)
+	assert.Equal(t, 1, bombReport.Strikes)
+	assert.Equal(t, 1, bombReport.ModulesSolved)
+
+	expectedScore := 100 + 500 + int(bombReport.TimeRemaining.Seconds()) - 50
+	assert.Equal(t, expectedScore, bombReport.Score)
+	assert.Equal(t, expectedScore, report.Score)
+
+	if assert.Len(t, bombReport.Modules, 1) {
+		moduleReport := bombReport.Modules[0]
+		assert.Equal(t, wiresModule.GetModuleID(), moduleReport.ModuleID)
+		assert.Equal(t, 1, moduleReport.Strikes)
+		if assert.NotNil(t, moduleReport.FirstInteraction) && assert.NotNil(t, moduleReport.SolvedAt) {
+			assert.GreaterOrEqual(t, *moduleReport.SolvedAt, *moduleReport.FirstInteraction)
+		}
+	}
+}
+
+// Run with -race: input and reads from many goroutines must only touch the bombs through
+// the actors.
+func TestGameSessionActor_ConcurrentInputAndSnapshots(t *testing.T) {
+	// Arrange: a practice bomb so strikes never end the game
+	rng := services.NewSeededRNGFromString("stress")
+	config, err := valueobject.NewGameSessionConfigForPractice("stress", valueobject.SimonModule)
+	assert.NoError(t, err)
+
+	sessionActor, sessionID := actors.NewGameSessionActor(rng, config)
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	bomb := services.NewBombFactory(services.NewModuleFactory(rng)).CreateBomb(rng, config.BombConfigs[0])
+	sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+	})
+	startGame(t, sessionActor)
+
+	var simonID uuid.UUID
+	for moduleID, module := range bomb.Modules {
+		if module.GetType() == valueobject.SimonModule {
+			simonID = moduleID
+		}
+	}
+
+	colors := []valueobject.Color{valueobject.Red, valueobject.Blue, valueobject.Green, valueobject.Yellow}
+
+	// Act
+	var wg sync.WaitGroup
+	for worker := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 50 {
+				if worker%2 == 0 {
+					snapshot := getSnapshot(t, sessionActor)
+					assert.Len(t, snapshot.Bombs, 1)
+					continue
+				}
+
+				sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+					return actors.ModuleCommandMessage{
+						Command: &command.SimonInputCommand{
+							BaseModuleInputCommand: command.BaseModuleInputCommand{
+								SessionID: sessionID,
+								BombID:    bomb.ID,
+								ModuleID:  simonID,
+							},
+							Color: colors[i%len(colors)],
+						},
+						ResponseChannel: respChan,
+					}
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Assert
+	snapshot := getSnapshot(t, sessionActor)
+	if assert.Len(t, snapshot.Bombs, 1) {
+		assert.Equal(t, valueobject.BombStateArmed, snapshot.Bombs[0].State, "Practice bombs shouldn't explode")
+		assert.Positive(t, snapshot.Bombs[0].StrikeCount, "Some of the presses should have been wrong")
+	}
+}
+
+// Module actor that takes messages but never answers, like a module stuck in its rules
+type wedgedModuleActor struct {
+	actors.ModuleActor
+}
+
+func (a wedgedModuleActor) Send(actors.Message) {}
+
+func wireCut(sessionID uuid.UUID, bomb *entities.Bomb, module entities.Module) func(chan actors.Response) actors.Message {
+	return func(respChan chan actors.Response) actors.Message {
+		return actors.ModuleCommandMessage{
+			Command: &command.WiresInputCommand{
+				BaseModuleInputCommand: command.BaseModuleInputCommand{
+					SessionID: sessionID,
+					BombID:    bomb.ID,
+					ModuleID:  module.GetModuleID(),
+				},
+				WirePosition: 2,
+			},
+			ResponseChannel: respChan,
+		}
+	}
+}
+
+func TestGameSessionActor_WedgedModuleOnlyBlocksItsOwnRequest(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	config := valueobject.NewEasyGameSessionConfig("test")
+	config.BombMode = valueobject.BombModeParallel
+
+	sessionActor, sessionID := actors.NewGameSessionActor(rng, config)
+	sessionActor.SetRequestTimeout(200 * time.Millisecond)
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	wedgedBomb, wedgedWires := newSingleWireBomb(rng)
+	otherBomb, otherWires := newSingleWireBomb(rng)
+	for _, bomb := range []*entities.Bomb{wedgedBomb, otherBomb} {
+		sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+			return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+		})
+	}
+	startGame(t, sessionActor)
+
+	wedgedBombActor := sessionActor.GetOrderedBombActors()[0]
+	realActor, err := actors.CreateModuleActor(wedgedBomb, wedgedWires)
+	assert.NoError(t, err)
+	realActor.Start()
+	wedgedBombActor.ReplaceModuleActor(wedgedWires.GetModuleID(), wedgedModuleActor{ModuleActor: realActor})
+
+	// Act
+	wedgedResp := make(chan actors.Response, 1)
+	sessionActor.Send(wireCut(sessionID, wedgedBomb, wedgedWires)(wedgedResp))
+
+	otherResp := sendAndWait(t, sessionActor, wireCut(sessionID, otherBomb, otherWires))
+	thirdBomb, _ := newSingleWireBomb(rng)
+	addResp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.AddBombMessage{Bomb: thirdBomb, ResponseChannel: respChan}
+	})
+
+	// Assert
+	assert.True(t, otherResp.IsSuccess(), "Other bombs should still take input")
+	assert.True(t, addResp.IsSuccess(), "Bombs should still be added")
+
+	select {
+	case resp := <-wedgedResp:
+		assert.ErrorIs(t, resp.Error(), actors.ErrRequestTimeout)
+	case <-time.After(1 * time.Second):
+		t.Fatal("Wedged module's request should time out")
+	}
+}
+
+func TestGameSessionActor_TimedOutInputIsNeverApplied(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	config := valueobject.NewEasyGameSessionConfig("test")
+
+	sessionActor, sessionID := actors.NewGameSessionActor(rng, config)
+	sessionActor.SetRequestTimeout(100 * time.Millisecond)
+	sessionActor.SetModuleScheduling(false)
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	bomb, wires := newSingleWireBomb(rng)
+	sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+	})
+	startGame(t, sessionActor)
+
+	// Not started yet, so the command waits in its mailbox past the deadline
+	lateActor, err := actors.CreateModuleActor(bomb, wires)
+	assert.NoError(t, err)
+	sessionActor.GetOrderedBombActors()[0].ReplaceModuleActor(wires.GetModuleID(), lateActor)
+
+	// Act
+	resp := sendAndWait(t, sessionActor, wireCut(sessionID, bomb, wires))
+	// Stopped along with its bomb
+	lateActor.Start()
+	// Answered once the late actor is past the command
+	sendAndWait(t, lateActor, func(respChan chan actors.Response) actors.Message {
+		return actors.GetBombSnapshotMessage{ResponseChannel: respChan}
+	})
+
+	// Assert
+	assert.ErrorIs(t, resp.Error(), actors.ErrRequestTimeout)
+	assert.False(t, wires.State.Wires[1].IsCut, "The wire shouldn't be cut after the player was told the input timed out")
+	snapshot := getSnapshot(t, sessionActor)
+	if assert.Len(t, snapshot.Bombs, 1) {
+		assert.Zero(t, snapshot.Bombs[0].ModulesSolved)
+		assert.Zero(t, snapshot.Bombs[0].StrikeCount)
+	}
+}
+
+func TestRequestWithin_FullMailboxDoesntLeaveGoroutinesBehind(t *testing.T) {
+	// Arrange: a bomb actor that isn't running, with a full mailbox
+	rng := services.NewSeededRNGFromString("test")
+	bomb, _ := newSingleWireBomb(rng)
+	stalled := actors.NewBombActor(bomb)
+	defer stalled.Stop()
+	for range 100 {
+		stalled.Send(actors.RevealEdgeworkMessage{ResponseChannel: make(chan actors.Response, 1)})
+	}
+	goroutines := runtime.NumGoroutine()
+
+	// Act
+	var responses []actors.Response
+	for range 10 {
+		responses = append(responses, actors.RequestWithin(stalled, 10*time.Millisecond, func(respChan chan actors.Response) actors.Message {
+			return actors.GetBombSnapshotMessage{ResponseChannel: respChan}
+		}))
+	}
+
+	// Assert
+	for _, resp := range responses {
+		assert.ErrorIs(t, resp.Error(), actors.ErrRequestTimeout)
+	}
+	assert.LessOrEqual(t, runtime.NumGoroutine(), goroutines, "Requests that time out shouldn't leave sends waiting on the mailbox")
+}
+
+func TestGameSessionActor_PauseHoldsClocksAndRejectsInput(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	config := valueobject.NewEasyGameSessionConfig("test")
+	config.BombMode = valueobject.BombModeParallel
+
+	sessionActor, sessionID := actors.NewGameSessionActor(rng, config)
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	bomb, knob := newKnobBomb(rng)
+	sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+	})
+	startGame(t, sessionActor)
+
+	turnKnob := func(respChan chan actors.Response) actors.Message {
+		return actors.ModuleCommandMessage{
+			Command: &command.NeedyKnobCommand{
+				BaseModuleInputCommand: command.BaseModuleInputCommand{
+					SessionID: sessionID,
+					BombID:    bomb.ID,
+					ModuleID:  knob.GetModuleID(),
+				},
+			},
+			ResponseChannel: respChan,
+		}
+	}
+
+	// Act
+	pauseResp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.PauseGameMessage{ResponseChannel: respChan}
+	})
+	pausedAt := getSnapshot(t, sessionActor)
+	time.Sleep(50 * time.Millisecond)
+	pausedLater := getSnapshot(t, sessionActor)
+
+	pausedInput := sendAndWait(t, sessionActor, turnKnob)
+	lateBomb, _ := newSingleWireBomb(rng)
+	sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.AddBombMessage{Bomb: lateBomb, ResponseChannel: respChan}
+	})
+	lateBombState := lateBomb.GetState()
+
+	resumeResp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.ResumeGameMessage{ResponseChannel: respChan}
+	})
+	resumedInput := sendAndWait(t, sessionActor, turnKnob)
+	resumed := getSnapshot(t, sessionActor)
+
+	// Assert
+	assert.True(t, pauseResp.IsSuccess(), "Expected the game to pause")
+	assert.True(t, pausedAt.Paused)
+	assert.Equal(t, valueobject.BombStatePaused, pausedAt.Bombs[0].State)
+	assert.Equal(t, pausedAt.Bombs[0].TimeLeft, pausedLater.Bombs[0].TimeLeft, "The bomb's clock should be held")
+	countdown := func(snapshot actors.SessionSnapshot) time.Duration {
+		for _, module := range snapshot.Bombs[0].Modules {
+			if module.ModuleID == knob.GetModuleID() {
+				return module.CountdownRemaining
+			}
+		}
+		return 0
+	}
+	assert.Equal(t, countdown(pausedAt), countdown(pausedLater), "Needy countdowns should be held")
+	assert.ErrorIs(t, pausedInput.Error(), valueobject.ErrGamePaused)
+	assert.Equal(t, valueobject.BombStatePaused, lateBombState, "Bombs armed while paused should start out paused")
+
+	assert.True(t, resumeResp.IsSuccess(), "Expected the game to resume")
+	assert.True(t, resumedInput.IsSuccess(), "Input should be accepted once the game resumes")
+	assert.Equal(t, valueobject.BombStateArmed, resumed.Bombs[0].State)
+	assert.Equal(t, valueobject.BombStateArmed, resumed.Bombs[1].State)
+	assert.InDelta(t, pausedAt.Bombs[0].TimeLeft, resumed.Bombs[0].TimeLeft, float64(40*time.Millisecond), "Time spent paused shouldn't come off the clock")
+}
+
+func TestGameSessionActor_PauseCanBeDisabled(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	config := valueobject.NewEasyGameSessionConfig("test")
+	config.PauseDisabled = true
+
+	sessionActor, _ := actors.NewGameSessionActor(rng, config)
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	bomb, _ := newSingleWireBomb(rng)
+	sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+	})
+	startGame(t, sessionActor)
+
+	// Act
+	resp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.PauseGameMessage{ResponseChannel: respChan}
+	})
+
+	// Assert
+	assert.ErrorIs(t, resp.Error(), valueobject.ErrPauseDisabled)
+	assert.True(t, getSnapshot(t, sessionActor).PauseDisabled)
+	assert.Equal(t, valueobject.BombStateArmed, bomb.GetState(), "The clock should keep running")
+}
+
+// Module actor that holds up whoever sends to it until it's released, like a module whose
+// bomb is stuck waiting on it
+type blockingModuleActor struct {
+	actors.ModuleActor
+	blocked chan struct{}
+	release chan struct{}
+}
+
+func (a blockingModuleActor) Send(msg actors.Message) {
+	a.blocked <- struct{}{}
+	<-a.release
+	a.ModuleActor.Send(msg)
+}
+
+func TestGameSessionActor_PauseIsUndoneWhenABombDoesntAnswer(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	config := valueobject.NewEasyGameSessionConfig("test")
+	config.BombMode = valueobject.BombModeParallel
+
+	sessionActor, sessionID := actors.NewGameSessionActor(rng, config)
+	sessionActor.SetRequestTimeout(100 * time.Millisecond)
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	answeringBomb, _ := newSingleWireBomb(rng)
+	stuckBomb, stuckWires := newSingleWireBomb(rng)
+	for _, bomb := range []*entities.Bomb{answeringBomb, stuckBomb} {
+		sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+			return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+		})
+	}
+	startedBefore := time.Now()
+	startGame(t, sessionActor)
+
+	realActor, err := actors.CreateModuleActor(stuckBomb, stuckWires)
+	assert.NoError(t, err)
+	realActor.Start()
+	blocked, release := make(chan struct{}, 1), make(chan struct{})
+	sessionActor.GetOrderedBombActors()[1].ReplaceModuleActor(stuckWires.GetModuleID(), blockingModuleActor{ModuleActor: realActor, blocked: blocked, release: release})
+	sessionActor.Send(wireCut(sessionID, stuckBomb, stuckWires)(make(chan actors.Response, 1)))
+	<-blocked
+
+	pause := func(respChan chan actors.Response) actors.Message {
+		return actors.PauseGameMessage{ResponseChannel: respChan}
+	}
+
+	// Act
+	pauseResp := sendAndWait(t, sessionActor, pause)
+	pausingResp := sendAndWait(t, sessionActor, pause)
+	close(release)
+	time.Sleep(50 * time.Millisecond)
+
+	// Assert
+	assert.ErrorIs(t, pauseResp.Error(), actors.ErrRequestTimeout)
+	assert.ErrorIs(t, pausingResp.Error(), actors.ErrPauseChanging, "The failed pause should still be being undone")
+
+	snapshot := getSnapshot(t, sessionActor)
+	assert.False(t, snapshot.Paused, "The session should go back to running")
+	for _, bomb := range snapshot.Bombs {
+		assert.Equal(t, valueobject.BombStateArmed, bomb.State, "Every bomb should go back to running")
+		assert.InDelta(t, time.Since(startedBefore), bomb.TimerDuration-bomb.TimeLeft, float64(40*time.Millisecond), "No time should have been spent paused")
+	}
+
+	assert.Eventually(t, func() bool {
+		return sendAndWait(t, sessionActor, pause).IsSuccess()
+	}, time.Second, 20*time.Millisecond, "The game should pause once the undo is done")
+}
+
+// Needy knobs take any number of turns without striking or being solved, so every input
+// reaches a module. The bomb's unsolved wires keep it from counting as defused.
+func newKnobBomb(rng *services.SeededRNG) (*entities.Bomb, *entities.NeedyKnobModule) {
+	bomb, _ := newSingleWireBomb(rng)
+	knob := entities.NewNeedyKnobModule(rng)
+	knob.SetBomb(bomb)
+	bomb.AddModule(knob, valueobject.ModulePosition{Column: 1})
+
+	return bomb, knob
+}
+
+func BenchmarkGameSessionActor_ConcurrentInput(b *testing.B) {
+	for _, numBombs := range []int{1, 4, 16} {
+		b.Run(fmt.Sprintf("bombs=%d", numBombs), func(b *testing.B) {
+			rng := services.NewSeededRNGFromString("bench")
+			config := valueobject.NewEasyGameSessionConfig("bench")
+			config.BombMode = valueobject.BombModeParallel
+
+			sessionActor, sessionID := actors.NewGameSessionActor(rng, config)
+			sessionActor.Start()
+			defer sessionActor.Stop()
+
+			commands := make([]*command.NeedyKnobCommand, 0, numBombs)
+			for range numBombs {
+				bomb, knob := newKnobBomb(rng)
+				respChan := make(chan actors.Response, 1)
+				sessionActor.Send(actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan})
+				<-respChan
+
+				commands = append(commands, &command.NeedyKnobCommand{
+					BaseModuleInputCommand: command.BaseModuleInputCommand{
+						SessionID: sessionID,
+						BombID:    bomb.ID,
+						ModuleID:  knob.GetModuleID(),
+					},
+				})
+			}
+			respChan := make(chan actors.Response, 1)
+			sessionActor.Send(actors.StartGameMessage{ResponseChannel: respChan})
+			<-respChan
+
+			var next atomic.Int64
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				cmd := commands[int(next.Add(1))%len(commands)]
+				respChan := make(chan actors.Response, 1)
+				for pb.Next() {
+					sessionActor.Send(actors.ModuleCommandMessage{Command: cmd, ResponseChannel: respChan})
+					if resp := <-respChan; !resp.IsSuccess() {
+						b.Errorf("input failed: %v", resp.Error())
+						return
+					}
+				}
+			})
+		})
+	}
+}
+
+func cutWireAs(playerID string, sessionID uuid.UUID, bomb *entities.Bomb, module *entities.WiresModule, position int) func(chan actors.Response) actors.Message {
+	return func(respChan chan actors.Response) actors.Message {
+		return actors.ModuleCommandMessage{
+			Command: &command.WiresInputCommand{
+				BaseModuleInputCommand: command.BaseModuleInputCommand{
+					SessionID: sessionID,
+					BombID:    bomb.ID,
+					ModuleID:  module.GetModuleID(),
+					PlayerID:  playerID,
+				},
+				WirePosition: position,
+			},
+			ResponseChannel: respChan,
+		}
+	}
+}
+
+func TestGameSessionActor_DefusersOnlyTouchTheirOwnFaces(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	sessionActor, sessionID := actors.NewGameSessionActor(rng, valueobject.NewEasyGameSessionConfig("test"))
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	bomb := entities.NewBomb(rng, valueobject.NewDefaultBombConfig())
+	front := addWiresModule(rng, bomb, valueobject.ModulePosition{Face: 0})
+	back := addWiresModule(rng, bomb, valueobject.ModulePosition{Face: 1})
+	bomb.Faces[2] = entities.NewBombFace()
+	sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+	})
+
+	joinLobby(t, sessionActor, "alice", valueobject.PlayerRoleDefuser)
+	joinLobby(t, sessionActor, "bob", valueobject.PlayerRoleDefuser)
+	joinLobby(t, sessionActor, "eve", valueobject.PlayerRoleExpert)
+	joinLobby(t, sessionActor, "sam", valueobject.PlayerRoleSpectator)
+
+	assignFaces := func(callerID string, playerID string, faces ...int) actors.Response {
+		return sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+			return actors.AssignFacesMessage{CallerID: callerID, PlayerID: playerID, Faces: faces, ResponseChannel: respChan}
+		})
+	}
+
+	// Act
+	missingFace := assignFaces("alice", "alice", 2)
+	expertFaces := assignFaces("eve", "eve", 0)
+	assignFaces("alice", "alice", 0)
+	assignFaces("bob", "bob", 1)
+	startGame(t, sessionActor)
+
+	otherFace := sendAndWait(t, sessionActor, cutWireAs("bob", sessionID, bomb, front, 2))
+	anonymous := sendAndWait(t, sessionActor, cutWireAs("", sessionID, bomb, front, 2))
+	expert := sendAndWait(t, sessionActor, cutWireAs("eve", sessionID, bomb, front, 2))
+	spectator := sendAndWait(t, sessionActor, cutWireAs("sam", sessionID, bomb, front, 2))
+	unknown := sendAndWait(t, sessionActor, cutWireAs("mallory", sessionID, bomb, front, 2))
+	strike := sendAndWait(t, sessionActor, cutWireAs("alice", sessionID, bomb, front, 1))
+	solved := sendAndWait(t, sessionActor, cutWireAs("bob", sessionID, bomb, back, 2))
+
+	taken := assignFaces("bob", "alice", 1)
+	unknownCaller := assignFaces("mallory", "bob", 0)
+	handOver := assignFaces("alice", "alice", 1)
+	handedBack := assignFaces("alice", "bob", 1)
+	reportResp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.GetGameReportMessage{ResponseChannel: respChan}
+	})
+
+	// Assert
+	var validationErrs valueobject.ValidationErrors
+	assert.ErrorAs(t, missingFace.Error(), &validationErrs, "Faces must hold modules on a bomb")
+	assert.ErrorIs(t, expertFaces.Error(), valueobject.ErrNotDefuser)
+
+	assert.ErrorIs(t, otherFace.Error(), valueobject.ErrFaceNotOwned)
+	assert.ErrorIs(t, anonymous.Error(), valueobject.ErrPlayerNotInLobby, "Assigned faces need a player ID")
+	assert.ErrorIs(t, expert.Error(), valueobject.ErrNotDefuser)
+	assert.ErrorIs(t, spectator.Error(), valueobject.ErrSpectatorsOnlyWatch)
+	assert.ErrorIs(t, unknown.Error(), valueobject.ErrPlayerNotInLobby)
+	assert.True(t, strike.(actors.SuccessResponse).Data.(*command.WiresInputCommandResult).Strike)
+	assert.True(t, solved.(actors.SuccessResponse).Data.(*command.WiresInputCommandResult).Solved)
+
+	assert.ErrorIs(t, taken.Error(), valueobject.ErrNotFaceAssigner, "Defusers shouldn't hand out faces to anyone else")
+	assert.ErrorIs(t, unknownCaller.Error(), valueobject.ErrPlayerNotInLobby)
+	if assert.True(t, handOver.IsSuccess()) {
+		players := handOver.(actors.SuccessResponse).Data.(valueobject.Lobby).Players
+		assert.Equal(t, []int{0, 1}, players[0].Faces)
+		assert.Empty(t, players[1].Faces, "Handing a face over should take it from its owner")
+	}
+	if assert.True(t, handedBack.IsSuccess(), "The host should be able to hand faces to other defusers") {
+		players := handedBack.(actors.SuccessResponse).Data.(valueobject.Lobby).Players
+		assert.Equal(t, []int{1}, players[1].Faces)
+	}
+
+	report := reportResp.(actors.SuccessResponse).Data.(valueobject.GameReport)
+	assert.Equal(t, []valueobject.DefuserReport{
+		{PlayerID: "alice", Strikes: 1},
+		{PlayerID: "bob", Strikes: 0},
+	}, report.Defusers)
+}
+
+func TestGameSessionActor_ConflictingInputGoesToFirstDefuser(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	sessionActor, sessionID := actors.NewGameSessionActor(rng, valueobject.NewEasyGameSessionConfig("test"))
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	bomb, wiresModule := newSingleWireBomb(rng)
+	sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+	})
+	joinLobby(t, sessionActor, "alice", valueobject.PlayerRoleDefuser)
+	joinLobby(t, sessionActor, "bob", valueobject.PlayerRoleDefuser)
+	startGame(t, sessionActor)
+
+	// The session can't hand over its reply to an unbuffered channel until it's read, so
+	// both inputs are waiting in its mailbox before it handles either
+	holdChan := make(chan actors.Response)
+	sessionActor.Send(actors.JoinLobbyMessage{PlayerID: "stream", Role: valueobject.PlayerRoleSpectator, ResponseChannel: holdChan})
+
+	aliceChan := make(chan actors.Response, 1)
+	bobChan := make(chan actors.Response, 1)
+	sessionActor.Send(cutWireAs("alice", sessionID, bomb, wiresModule, 1)(aliceChan))
+	sessionActor.Send(cutWireAs("bob", sessionID, bomb, wiresModule, 2)(bobChan))
+
+	// Act
+	awaitResponse(t, holdChan)
+	aliceResp := awaitResponse(t, aliceChan)
+	bobResp := awaitResponse(t, bobChan)
+	retry := sendAndWait(t, sessionActor, cutWireAs("bob", sessionID, bomb, wiresModule, 2))
+
+	// Assert
+	assert.True(t, aliceResp.(actors.SuccessResponse).Data.(*command.WiresInputCommandResult).Strike, "The first input should go through")
+	assert.ErrorIs(t, bobResp.Error(), valueobject.ErrInputConflict)
+	assert.True(t, retry.(actors.SuccessResponse).Data.(*command.WiresInputCommandResult).Solved, "The module should be free once the first input is done")
+}
+
+func newModifiedSession(t *testing.T, rng *services.SeededRNG, bomb *entities.Bomb, modifiers ...valueobject.Modifier) (*actors.GameSessionActor, uuid.UUID) {
+	config := valueobject.NewEasyGameSessionConfig("test")
+	config.Modifiers = modifiers
+
+	sessionActor, sessionID := actors.NewGameSessionActor(rng, config)
+	sessionActor.Start()
+	t.Cleanup(sessionActor.Stop)
+
+	sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+	})
+	startGame(t, sessionActor)
+
+	return sessionActor, sessionID
+}
+
+func TestGameSessionActor_OneStrikePerModule(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	bomb, wiresModule := newSingleWireBomb(rng)
+	otherModule := addWiresModule(rng, bomb, valueobject.ModulePosition{Column: 1})
+	sessionActor, sessionID := newModifiedSession(t, rng, bomb, valueobject.ModifierOneStrikePerModule)
+
+	// Act
+	first := sendAndWait(t, sessionActor, cutWireAs("", sessionID, bomb, wiresModule, 1))
+	second := sendAndWait(t, sessionActor, cutWireAs("", sessionID, bomb, wiresModule, 3))
+	sendAndWait(t, sessionActor, cutWireAs("", sessionID, bomb, otherModule, 1))
+	snapshot := getSnapshot(t, sessionActor).Bombs[0]
+
+	// Assert
+	assert.True(t, first.(actors.SuccessResponse).Data.(*command.WiresInputCommandResult).Strike)
+	assert.True(t, second.(actors.SuccessResponse).Data.(*command.WiresInputCommandResult).Strike, "The mistake should still be reported")
+	assert.Equal(t, 2, snapshot.StrikeCount, "Each module should only count once")
+	assert.Equal(t, []valueobject.Modifier{valueobject.ModifierOneStrikePerModule}, snapshot.Modifiers)
+}
+
+func TestGameSessionActor_ZenBombsNeverExplode(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	bomb, wiresModule := newSingleWireBomb(rng)
+	bomb.MaxStrikes = 1
+	sessionActor, sessionID := newModifiedSession(t, rng, bomb, valueobject.ModifierZen)
+
+	// Act
+	sendAndWait(t, sessionActor, cutWireAs("", sessionID, bomb, wiresModule, 1))
+	sendAndWait(t, sessionActor, cutWireAs("", sessionID, bomb, wiresModule, 3))
+	snapshot := getSnapshot(t, sessionActor).Bombs[0]
+
+	// Assert
+	assert.Equal(t, 2, snapshot.StrikeCount)
+	assert.Equal(t, valueobject.BombStateArmed, snapshot.State, "Strikes shouldn't explode a zen bomb")
+	assert.Less(t, snapshot.Timer, time.Second, "The timer should count up from zero")
+	assert.Greater(t, snapshot.TimeLeft, 4*time.Minute)
+}
+
+func TestGameSessionActor_TimeModeTradesTimeForSolvesAndStrikes(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	bomb, wiresModule := newSingleWireBomb(rng)
+	bomb.MaxStrikes = 1
+	bomb.StrikeTimerRates = nil
+	timer := bomb.Clock.Duration
+	sessionActor, sessionID := newModifiedSession(t, rng, bomb, valueobject.ModifierTimeMode)
+
+	// Act
+	sendAndWait(t, sessionActor, cutWireAs("", sessionID, bomb, wiresModule, 1))
+	struck := getSnapshot(t, sessionActor).Bombs[0]
+	sendAndWait(t, sessionActor, cutWireAs("", sessionID, bomb, wiresModule, 2))
+	solved := getSnapshot(t, sessionActor).Bombs[0]
+
+	// Assert
+	assert.Equal(t, valueobject.BombStateArmed, struck.State, "Strikes shouldn't explode the bomb in time mode")
+	assert.InDelta(t, timer-valueobject.TimeModeStrikePenalty, struck.TimeLeft, float64(time.Second))
+	assert.Equal(t, valueobject.BombStateDefused, solved.State)
+	assert.InDelta(t, timer-valueobject.TimeModeStrikePenalty+valueobject.TimeModeSolveBonus, solved.TimeLeft, float64(time.Second))
+}
+
+func getReport(t *testing.T, sessionActor *actors.GameSessionActor) valueobject.GameReport {
+	resp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.GetGameReportMessage{ResponseChannel: respChan}
+	})
+	return resp.(actors.SuccessResponse).Data.(valueobject.GameReport)
+}
+
+// Starts a session with the modifiers, a single wire bomb and a defuser named alice
+func newDefuserSession(t *testing.T, modifiers ...valueobject.Modifier) (*actors.GameSessionActor, uuid.UUID, *entities.Bomb, *entities.WiresModule) {
+	rng := services.NewSeededRNGFromString("test")
+	config := valueobject.NewEasyGameSessionConfig("test")
+	config.Modifiers = modifiers
+	sessionActor, sessionID := actors.NewGameSessionActor(rng, config)
+	sessionActor.Start()
+	t.Cleanup(sessionActor.Stop)
+
+	bomb, wiresModule := newSingleWireBomb(rng)
+	sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+	})
+	joinLobby(t, sessionActor, "alice", valueobject.PlayerRoleDefuser)
+	startGame(t, sessionActor)
+
+	return sessionActor, sessionID, bomb, wiresModule
+}
+
+func TestGameSessionActor_ReportOnlyCountsStrikesTheBombCounted(t *testing.T) {
+	// Arrange
+	sessionActor, sessionID, bomb, wiresModule := newDefuserSession(t, valueobject.ModifierOneStrikePerModule)
+
+	// Act
+	first := sendAndWait(t, sessionActor, cutWireAs("alice", sessionID, bomb, wiresModule, 1))
+	second := sendAndWait(t, sessionActor, cutWireAs("alice", sessionID, bomb, wiresModule, 3))
+	report := getReport(t, sessionActor)
+
+	// Assert
+	assert.True(t, first.(actors.SuccessResponse).Data.(*command.WiresInputCommandResult).StrikeCounted)
+	assert.False(t, second.(actors.SuccessResponse).Data.(*command.WiresInputCommandResult).StrikeCounted)
+	assert.Equal(t, 1, report.Bombs[0].Strikes)
+	assert.Equal(t, 1, report.Bombs[0].Modules[0].Strikes)
+	assert.Equal(t, []valueobject.DefuserReport{{PlayerID: "alice", Strikes: 1}}, report.Defusers, "Dropped strikes shouldn't count against the defuser")
+}
+
+func TestGameSessionActor_ReportHidesStrikesAndTimerUntilTheBombIsFinished(t *testing.T) {
+	// Arrange
+	sessionActor, sessionID, bomb, wiresModule := newDefuserSession(t, valueobject.ModifierHiddenStrikes, valueobject.ModifierHiddenTimer)
+
+	// Act
+	sendAndWait(t, sessionActor, cutWireAs("alice", sessionID, bomb, wiresModule, 1))
+	midGame := getReport(t, sessionActor)
+	sendAndWait(t, sessionActor, cutWireAs("alice", sessionID, bomb, wiresModule, 2))
+	finished := getReport(t, sessionActor)
+
+	// Assert
+	assert.Zero(t, midGame.Bombs[0].Strikes)
+	assert.Zero(t, midGame.Bombs[0].Modules[0].Strikes)
+	assert.Zero(t, midGame.Bombs[0].TimeRemaining)
+	assert.Zero(t, midGame.Defusers[0].Strikes)
+
+	assert.Equal(t, 1, finished.Bombs[0].Strikes)
+	assert.Equal(t, 1, finished.Defusers[0].Strikes)
+	assert.Greater(t, finished.Bombs[0].TimeRemaining, time.Duration(0))
+}
+
+func TestGameSessionActor_RevealEdgework(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	config := valueobject.NewEasyGameSessionConfig("test")
+	config.Modifiers = []valueobject.Modifier{valueobject.ModifierEdgeworkOnRequest}
+	sessionActor, _ := actors.NewGameSessionActor(rng, config)
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	bomb, _ := newSingleWireBomb(rng)
+	sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+	})
+	reveal := func(bombID uuid.UUID) actors.Response {
+		return sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+			return actors.RevealEdgeworkMessage{BombID: bombID, ResponseChannel: respChan}
+		})
+	}
+
+	// Act
+	inLobby := reveal(bomb.ID)
+	startGame(t, sessionActor)
+	hidden := getSnapshot(t, sessionActor).Bombs[0]
+	unknown := reveal(uuid.New())
+	revealed := reveal(bomb.ID)
+
+	// Assert
+	assert.ErrorIs(t, inLobby.Error(), valueobject.ErrGameNotStarted)
+	assert.False(t, hidden.EdgeworkRevealed)
+	assert.ErrorIs(t, unknown.Error(), actors.ErrBombNotFound)
+	assert.True(t, revealed.IsSuccess())
+	assert.True(t, getSnapshot(t, sessionActor).Bombs[0].EdgeworkRevealed)
+}
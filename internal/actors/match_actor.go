@@ -0,0 +1,380 @@
+package actors
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// How often a match checks on its sessions between their events. Bombs that run out of
+// time explode without an event, so this is how the match notices them.
+const matchPollInterval = time.Second
+
+// How long a match waits once it can be decided before naming the winner. Each session's
+// events reach the match on their own, so a team that finished first can be heard from
+// after one that finished just behind them.
+const matchSettleDelay = 250 * time.Millisecond
+
+// Scoreboards a match watcher can fall behind by before older ones are dropped
+const matchWatchBufferSize = 16
+
+// A team's view of the shared scoreboard. The current scoreboard arrives straight away and
+// a new one each time it changes. The channel is closed once the match is finished, the
+// watch is closed or the match stops.
+type MatchWatch struct {
+	Updates <-chan valueobject.Scoreboard
+	close   func()
+	once    sync.Once
+}
+
+// Stops the updates. Safe to call more than once.
+func (w *MatchWatch) Close() {
+	w.once.Do(w.close)
+}
+
+// Runs a versus match between sessions built from the same config and seed. The match
+// follows each session's events as they happen, keeps the scoreboard and starts every
+// session at once so that no team gets a head start.
+type MatchActor struct {
+	BaseActor
+	match *entities.Match
+	// In the same order as the match's teams
+	sessions []*GameSessionActor
+	lobbies  map[uuid.UUID]valueobject.Lobby
+
+	// Set once the match is waiting out matchSettleDelay before being decided
+	deciding bool
+
+	watchers      map[uint64]chan valueobject.Scoreboard
+	nextWatcherID uint64
+	// Closed once the match is finished, to stop following the sessions
+	finished chan struct{}
+}
+
+// A team's session as it was at the given time, handed to the match's loop
+type teamSessionMessage struct {
+	session SessionSnapshot
+	at      time.Time
+}
+
+func (m teamSessionMessage) MessageType() string {
+	return "TeamSession"
+}
+
+// Sent once every session has been asked to start, to answer whoever started the match
+type matchStartedMessage struct {
+	responseChannel chan Response
+}
+
+func (m matchStartedMessage) MessageType() string {
+	return "MatchStarted"
+}
+
+// Names the winner once the match has waited out matchSettleDelay
+type decideMatchMessage struct{}
+
+func (m decideMatchMessage) MessageType() string {
+	return "DecideMatch"
+}
+
+// Stops a watcher's updates. Sent by MatchWatch.Close.
+type unwatchMatchMessage struct {
+	watcherID uint64
+}
+
+func (m unwatchMatchMessage) MessageType() string {
+	return "UnwatchMatch"
+}
+
+// Creates a match between the given teams, one session per team in the same order. The
+// sessions should be waiting in their lobbies and set to be started by their match.
+func NewMatchActor(teams []string, sessions []*GameSessionActor) (actor *MatchActor, matchID uuid.UUID) {
+	matchID = uuid.New()
+
+	matchTeams := make([]valueobject.MatchTeam, 0, len(teams))
+	for i, team := range teams {
+		matchTeams = append(matchTeams, valueobject.MatchTeam{Name: team, SessionID: sessions[i].GetSessionID()})
+	}
+
+	actor = &MatchActor{
+		BaseActor: NewBaseActor(100),
+		match:     entities.NewMatch(matchID, matchTeams),
+		sessions:  sessions,
+		lobbies:   make(map[uuid.UUID]valueobject.Lobby),
+		watchers:  make(map[uint64]chan valueobject.Scoreboard),
+		finished:  make(chan struct{}),
+	}
+
+	return actor, matchID
+}
+
+func (m *MatchActor) Start() {
+	go m.processMessages()
+	for _, session := range m.sessions {
+		go m.follow(session)
+	}
+	go m.poll()
+}
+
+func (m *MatchActor) GetMatchID() uuid.UUID {
+	return m.match.MatchID
+}
+
+func (m *MatchActor) processMessages() {
+	for {
+		select {
+		case msg := <-m.Mailbox():
+			m.handleMessage(msg)
+		case <-m.Done():
+			for _, updates := range m.watchers {
+				close(updates)
+			}
+			return
+		}
+	}
+}
+
+func (m *MatchActor) handleMessage(msg Message) {
+	switch msg := msg.(type) {
+	case teamSessionMessage:
+		m.handleTeamSession(msg)
+	case decideMatchMessage:
+		m.match.Decide()
+		m.broadcast()
+	case StartMatchMessage:
+		m.handleStartMatch(msg)
+	case matchStartedMessage:
+		if msg.responseChannel != nil {
+			msg.responseChannel <- SuccessResponse{Data: m.match.Scoreboard()}
+		}
+	case GetScoreboardMessage:
+		msg.ResponseChannel <- SuccessResponse{Data: m.match.Scoreboard()}
+	case ClaimMatchTeamMessage:
+		team, err := m.match.ClaimTeam(msg.Team)
+		if err != nil {
+			msg.ResponseChannel <- ErrorResponse{Err: err}
+			break
+		}
+		msg.ResponseChannel <- SuccessResponse{Data: team}
+	case WatchMatchMessage:
+		m.handleWatchMatch(msg)
+	case unwatchMatchMessage:
+		m.handleUnwatchMatch(msg)
+	default:
+		log.Printf("match received unhandled message type: %T", msg)
+		if msg, ok := msg.(RequestMessage); ok {
+			msg.GetResponseChannel() <- ErrorResponse{Err: ErrUnhandledMessageType}
+		}
+	}
+}
+
+// Hands every update from the session to the match's loop until the match is finished.
+func (m *MatchActor) follow(session *GameSessionActor) {
+	resp := requestWithin(session, DefaultRequestTimeout, func(respChan chan Response) Message {
+		return ObserveSessionMessage{ResponseChannel: respChan}
+	})
+	if !resp.IsSuccess() {
+		log.Printf("match %v: error observing session %v: %v", m.match.MatchID, session.GetSessionID(), resp.Error())
+		return
+	}
+
+	watch := resp.(SuccessResponse).Data.(*SessionWatch)
+	defer watch.Close()
+
+	for {
+		select {
+		case update, ok := <-watch.Updates:
+			if !ok {
+				return
+			}
+			m.Send(teamSessionMessage{session: update.Session, at: update.Event.At})
+		case <-m.finished:
+			return
+		case <-m.Done():
+			return
+		}
+	}
+}
+
+// Snapshots every session on an interval until the match is finished.
+func (m *MatchActor) poll() {
+	ticker := time.NewTicker(matchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			for _, session := range m.sessions {
+				resp := requestWithin(session, DefaultRequestTimeout, func(respChan chan Response) Message {
+					return GetBombsMessage{ResponseChannel: respChan}
+				})
+				if !resp.IsSuccess() {
+					log.Printf("match %v: error polling session %v: %v", m.match.MatchID, session.GetSessionID(), resp.Error())
+					continue
+				}
+				m.Send(teamSessionMessage{session: resp.(SuccessResponse).Data.(SessionSnapshot), at: time.Now()})
+			}
+		case <-m.finished:
+			return
+		case <-m.Done():
+			return
+		}
+	}
+}
+
+func (m *MatchActor) handleTeamSession(msg teamSessionMessage) {
+	if m.match.IsFinished() {
+		return
+	}
+
+	m.lobbies[msg.session.SessionID] = msg.session.Lobby
+	if m.match.RecordProgress(teamProgress(msg.session), msg.at) {
+		m.broadcast()
+	}
+
+	if m.match.CanDecide() && !m.deciding {
+		m.deciding = true
+		time.AfterFunc(matchSettleDelay, func() {
+			m.Send(decideMatchMessage{})
+		})
+	}
+
+	if !m.match.IsStarted() && m.allTeamsReady() {
+		if err := m.start(nil); err != nil {
+			log.Printf("match %v: error starting: %v", m.match.MatchID, err)
+		}
+	}
+}
+
+// Returns whether every team has someone playing and everyone playing is ready, as a
+// single session's lobby would before starting on its own.
+func (m *MatchActor) allTeamsReady() bool {
+	for _, session := range m.sessions {
+		lobby, ok := m.lobbies[session.GetSessionID()]
+		if !ok || !lobby.AllReady() {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *MatchActor) handleStartMatch(msg StartMatchMessage) {
+	if err := m.start(msg.ResponseChannel); err != nil {
+		msg.ResponseChannel <- ErrorResponse{Err: err}
+	}
+}
+
+// Starts every team's session at once, with their clocks starting when the match did so
+// that a session that hears late doesn't lose time. The scoreboard is sent to the response
+// channel, if there is one, once every session has been started.
+func (m *MatchActor) start(respChan chan Response) error {
+	now := time.Now()
+	if err := m.match.Start(now); err != nil {
+		return err
+	}
+
+	var wg sync.WaitGroup
+	for _, session := range m.sessions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp := requestWithin(session, DefaultRequestTimeout, func(respChan chan Response) Message {
+				return StartGameMessage{ResponseChannel: respChan, byMatch: true, at: now}
+			})
+			if !resp.IsSuccess() {
+				log.Printf("match %v: error starting session %v: %v", m.match.MatchID, session.GetSessionID(), resp.Error())
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		m.Send(matchStartedMessage{responseChannel: respChan})
+	}()
+
+	m.broadcast()
+	return nil
+}
+
+// Sends the scoreboard to every watcher. Once the match is finished the final scoreboard
+// is the last they get.
+func (m *MatchActor) broadcast() {
+	scoreboard := m.match.Scoreboard()
+	for id, updates := range m.watchers {
+		offerScoreboard(updates, scoreboard)
+		if scoreboard.Finished {
+			close(updates)
+			delete(m.watchers, id)
+		}
+	}
+
+	if scoreboard.Finished {
+		close(m.finished)
+	}
+}
+
+// Queues a scoreboard without blocking. A watcher who has fallen behind loses their oldest
+// scoreboard, which the newer one replaces anyway.
+func offerScoreboard(updates chan valueobject.Scoreboard, scoreboard valueobject.Scoreboard) {
+	select {
+	case updates <- scoreboard:
+		return
+	default:
+	}
+
+	select {
+	case <-updates:
+	default:
+	}
+	select {
+	case updates <- scoreboard:
+	default:
+	}
+}
+
+func (m *MatchActor) handleWatchMatch(msg WatchMatchMessage) {
+	updates := make(chan valueobject.Scoreboard, matchWatchBufferSize)
+	updates <- m.match.Scoreboard()
+
+	m.nextWatcherID++
+	id := m.nextWatcherID
+	if m.match.IsFinished() {
+		close(updates)
+	} else {
+		m.watchers[id] = updates
+	}
+
+	msg.ResponseChannel <- SuccessResponse{Data: &MatchWatch{
+		Updates: updates,
+		close: func() {
+			m.Send(unwatchMatchMessage{watcherID: id})
+		},
+	}}
+}
+
+func (m *MatchActor) handleUnwatchMatch(msg unwatchMatchMessage) {
+	if updates, ok := m.watchers[msg.watcherID]; ok {
+		delete(m.watchers, msg.watcherID)
+		close(updates)
+	}
+}
+
+// Totals a team's progress across every bomb in its session. Strikes a modifier hides are
+// left out until their bomb is finished, as every team sees the scoreboard.
+func teamProgress(session SessionSnapshot) valueobject.TeamProgress {
+	progress := valueobject.TeamProgress{
+		SessionID: session.SessionID,
+		State:     session.State,
+	}
+	for _, bomb := range session.Bombs {
+		progress.ModulesSolved += bomb.ModulesSolved
+		progress.ModulesTotal += bomb.ModulesTotal
+		if !bomb.StrikesHidden() {
+			progress.Strikes += bomb.StrikeCount
+		}
+	}
+	return progress
+}
@@ -0,0 +1,243 @@
+package actors_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/actors"
+	"github.com/ZaneH/defuse.party-go/internal/application/command"
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+)
+
+type matchTeam struct {
+	session *actors.GameSessionActor
+	bomb    *entities.Bomb
+	wires   *entities.WiresModule
+}
+
+// Creates a session for each team with a single wires bomb, waiting to be started by its
+// match
+func newMatchTeams(t *testing.T, count int) []matchTeam {
+	rng := services.NewSeededRNGFromString("test")
+	config := valueobject.NewEasyGameSessionConfig("test")
+	config.StartedByMatch = true
+
+	teams := make([]matchTeam, 0, count)
+	for range count {
+		sessionActor, _ := actors.NewGameSessionActor(rng, config)
+		sessionActor.Start()
+		t.Cleanup(sessionActor.Stop)
+
+		bomb, wiresModule := newSingleWireBomb(rng)
+		sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+			return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+		})
+		teams = append(teams, matchTeam{session: sessionActor, bomb: bomb, wires: wiresModule})
+	}
+	return teams
+}
+
+func (team matchTeam) cutWire(t *testing.T, position int) {
+	sendAndWait(t, team.session, func(respChan chan actors.Response) actors.Message {
+		return actors.ModuleCommandMessage{
+			Command: &command.WiresInputCommand{
+				BaseModuleInputCommand: command.BaseModuleInputCommand{
+					SessionID: team.session.GetSessionID(),
+					BombID:    team.bomb.ID,
+					ModuleID:  team.wires.GetModuleID(),
+				},
+				WirePosition: position,
+			},
+			ResponseChannel: respChan,
+		}
+	})
+}
+
+func watchMatch(t *testing.T, matchActor *actors.MatchActor) *actors.MatchWatch {
+	resp := sendAndWait(t, matchActor, func(respChan chan actors.Response) actors.Message {
+		return actors.WatchMatchMessage{ResponseChannel: respChan}
+	})
+	watch := resp.(actors.SuccessResponse).Data.(*actors.MatchWatch)
+	t.Cleanup(watch.Close)
+	return watch
+}
+
+// Reads scoreboards until one matches, failing if none does in time
+func waitForScoreboard(t *testing.T, watch *actors.MatchWatch, matches func(valueobject.Scoreboard) bool) valueobject.Scoreboard {
+	timeout := time.After(3 * time.Second)
+	for {
+		select {
+		case scoreboard, ok := <-watch.Updates:
+			if !ok {
+				t.Fatalf("watch closed early")
+			}
+			if matches(scoreboard) {
+				return scoreboard
+			}
+		case <-timeout:
+			t.Fatalf("timeout waiting for scoreboard")
+			return valueobject.Scoreboard{}
+		}
+	}
+}
+
+func TestMatchActor_FirstTeamToDefuseWins(t *testing.T) {
+	// Arrange
+	teams := newMatchTeams(t, 2)
+	red, blue := teams[0], teams[1]
+
+	matchActor, _ := actors.NewMatchActor([]string{"red", "blue"}, []*actors.GameSessionActor{red.session, blue.session})
+	matchActor.Start()
+	defer matchActor.Stop()
+
+	watch := watchMatch(t, matchActor)
+
+	// Act
+	headStart := sendAndWait(t, red.session, func(respChan chan actors.Response) actors.Message {
+		return actors.StartGameMessage{ResponseChannel: respChan}
+	})
+	startResp := sendAndWait(t, matchActor, func(respChan chan actors.Response) actors.Message {
+		return actors.StartMatchMessage{ResponseChannel: respChan}
+	})
+
+	red.cutWire(t, 1)
+	waitForScoreboard(t, watch, func(scoreboard valueobject.Scoreboard) bool {
+		return scoreboard.Teams[0].Strikes == 1
+	})
+	blue.cutWire(t, 2)
+	red.cutWire(t, 2)
+
+	final := waitForScoreboard(t, watch, func(scoreboard valueobject.Scoreboard) bool {
+		return scoreboard.Finished
+	})
+
+	// Assert
+	assert.ErrorIs(t, headStart.Error(), valueobject.ErrStartedByMatch, "Teams shouldn't be able to start early")
+	if assert.True(t, startResp.IsSuccess()) {
+		assert.NotNil(t, startResp.(actors.SuccessResponse).Data.(valueobject.Scoreboard).StartedAt)
+	}
+
+	assert.Equal(t, "blue", final.Winner)
+	assert.Equal(t, valueobject.SessionStateCompleted, final.Teams[1].State)
+	assert.Equal(t, 1, final.Teams[1].ModulesSolved)
+	assert.NotNil(t, final.Teams[1].FinishedAt)
+	assert.Equal(t, 1, final.Teams[0].Strikes)
+	assert.Equal(t, valueobject.BombStateDefused, getSnapshot(t, red.session).Bombs[0].State, "Losing teams can still finish their bombs")
+
+	select {
+	case _, ok := <-watch.Updates:
+		assert.False(t, ok, "No scoreboards should follow the final one")
+	case <-time.After(1 * time.Second):
+		t.Fatal("Updates should be closed once the match is finished")
+	}
+}
+
+func TestMatchActor_StartsOnceEveryTeamIsReady(t *testing.T) {
+	// Arrange
+	teams := newMatchTeams(t, 2)
+	sessions := []*actors.GameSessionActor{teams[0].session, teams[1].session}
+
+	matchActor, _ := actors.NewMatchActor([]string{"red", "blue"}, sessions)
+	matchActor.Start()
+	defer matchActor.Stop()
+
+	watch := watchMatch(t, matchActor)
+
+	setReady := func(sessionActor *actors.GameSessionActor) {
+		joinLobby(t, sessionActor, "defuser", valueobject.PlayerRoleDefuser)
+		sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+			return actors.SetPlayerReadyMessage{PlayerID: "defuser", Ready: true, ResponseChannel: respChan}
+		})
+	}
+
+	// Act
+	setReady(sessions[0])
+	waiting := getSnapshot(t, sessions[0])
+	setReady(sessions[1])
+
+	started := waitForScoreboard(t, watch, func(scoreboard valueobject.Scoreboard) bool {
+		return scoreboard.StartedAt != nil
+	})
+
+	// Assert
+	assert.Equal(t, valueobject.SessionStateLobby, waiting.State, "A ready team should wait for the others")
+	assert.False(t, started.Finished)
+	for _, sessionActor := range sessions {
+		assert.Eventually(t, func() bool {
+			return getSnapshot(t, sessionActor).State == valueobject.SessionStateInProgress
+		}, time.Second, 10*time.Millisecond, "Every team's session should start with the match")
+	}
+}
+
+func TestMatchActor_TeamsStartTogether(t *testing.T) {
+	// Arrange
+	teams := newMatchTeams(t, 3)
+	sessions := []*actors.GameSessionActor{teams[0].session, teams[1].session, teams[2].session}
+
+	matchActor, _ := actors.NewMatchActor([]string{"red", "blue", "green"}, sessions)
+	matchActor.Start()
+	defer matchActor.Stop()
+
+	// Act
+	resp := sendAndWait(t, matchActor, func(respChan chan actors.Response) actors.Message {
+		return actors.StartMatchMessage{ResponseChannel: respChan}
+	})
+
+	// Assert
+	if !assert.True(t, resp.IsSuccess()) {
+		return
+	}
+	startedAt := resp.(actors.SuccessResponse).Data.(valueobject.Scoreboard).StartedAt
+	for _, sessionActor := range sessions {
+		bomb := getSnapshot(t, sessionActor).Bombs[0]
+		if assert.NotNil(t, bomb.StartedAt, "Every session should be started by the time the match answers") {
+			assert.Equal(t, *startedAt, *bomb.StartedAt, "No team should get a head start")
+		}
+	}
+}
+
+func TestActorSystem_FinishedMatchesAreStopped(t *testing.T) {
+	// Arrange
+	actorSystem := actors.NewActorSystem()
+	actorSystem.SetMatchRetention(50 * time.Millisecond)
+	rng := services.NewSeededRNGFromString("test")
+	config := valueobject.NewEasyGameSessionConfig("test")
+	config.StartedByMatch = true
+
+	var teams []matchTeam
+	var sessions []*actors.GameSessionActor
+	for range 2 {
+		sessionActor, err := actorSystem.CreateGameSession(rng, config)
+		assert.NoError(t, err)
+		bomb, wiresModule := newSingleWireBomb(rng)
+		sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+			return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+		})
+		teams = append(teams, matchTeam{session: sessionActor, bomb: bomb, wires: wiresModule})
+		sessions = append(sessions, sessionActor)
+	}
+
+	matchActor, err := actorSystem.CreateMatch([]string{"red", "blue"}, sessions)
+	assert.NoError(t, err)
+	matchID := matchActor.GetMatchID()
+	sendAndWait(t, matchActor, func(respChan chan actors.Response) actors.Message {
+		return actors.StartMatchMessage{ResponseChannel: respChan}
+	})
+
+	// Act
+	teams[0].cutWire(t, 2)
+	teams[1].cutWire(t, 2)
+
+	// Assert
+	assert.Eventually(t, func() bool {
+		_, err := actorSystem.GetMatch(matchID)
+		return err != nil
+	}, 3*time.Second, 20*time.Millisecond, "The match should be stopped once it's been finished a while")
+	for _, sessionActor := range sessions {
+		_, err := actorSystem.GetGameSession(sessionActor.GetSessionID())
+		assert.Error(t, err, "The match's sessions should be stopped with it")
+	}
+}
@@ -20,6 +20,14 @@ func TestMazeModuleActor_NavigationNoWall(t *testing.T) {
 	bomb := entities.NewBomb(rng, valueobject.NewDefaultBombConfig())
 	mazeModule := entities.NewMazeModule(rng)
 	mazeModule.SetBomb(bomb)
+
+	// Pinned so the maze doesn't depend on how many random numbers building the bomb used
+	testState := entities.NewMazeState(rng)
+	testState.GoalPosition = valueobject.Point2D{X: 3, Y: 4}
+	testState.PlayerPosition = valueobject.Point2D{X: 4, Y: 1}
+	testState.Variant = 5
+	mazeModule.SetState(testState)
+
 	mazeModuleActor := actors.NewMazeModuleActor(mazeModule)
 	mazeModuleActor.Start() // Start the actor to process messages
 	defer mazeModuleActor.Stop()
@@ -119,6 +127,14 @@ func TestMazeModuleActor_NavigationHitWall(t *testing.T) {
 	bomb := entities.NewBomb(rng, valueobject.NewDefaultBombConfig())
 	mazeModule := entities.NewMazeModule(rng)
 	mazeModule.SetBomb(bomb)
+
+	// Pinned so the maze doesn't depend on how many random numbers building the bomb used
+	testState := entities.NewMazeState(rng)
+	testState.GoalPosition = valueobject.Point2D{X: 4, Y: 1}
+	testState.PlayerPosition = valueobject.Point2D{X: 2, Y: 3}
+	testState.Variant = 7
+	mazeModule.SetState(testState)
+
 	mazeModuleActor := actors.NewMazeModuleActor(mazeModule)
 	mazeModuleActor.Start() // Start the actor to process messages
 	defer mazeModuleActor.Stop()
@@ -1,8 +1,13 @@
 package actors
 
 import (
+	"sync/atomic"
+	"time"
+
 	"github.com/ZaneH/defuse.party-go/internal/application/command"
 	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/google/uuid"
 )
 
 type Message interface {
@@ -22,6 +27,44 @@ type RequestMessage interface {
 type ModuleCommandMessage struct {
 	Command         command.ModuleInputCommand
 	ResponseChannel chan Response
+	// Asks the bomb to reply with an explainedResult, for sessions with spectators
+	explainRule bool
+	// Shared by every actor the command passes through. Nil for commands sent straight to
+	// a bomb or module.
+	claim *commandClaim
+}
+
+// Settles whether a module command is applied or given up on, whichever comes first. A
+// caller that gives up can only tell its player the command timed out if the module hadn't
+// started on it; once started, the command is applied and its reply is on the way.
+type commandClaim struct {
+	state atomic.Int32
+}
+
+const (
+	commandPending int32 = iota
+	commandApplied
+	commandAbandoned
+)
+
+// Called by the module before it applies the command. Reports false if the caller has
+// already given up on it.
+func (c *commandClaim) apply() bool {
+	return c == nil || c.state.CompareAndSwap(commandPending, commandApplied)
+}
+
+// Called by a caller whose deadline has passed. Reports whether the command is given up
+// on; false means the module has started on it.
+func (c *commandClaim) abandon() bool {
+	c.state.CompareAndSwap(commandPending, commandAbandoned)
+	return c.state.Load() == commandAbandoned
+}
+
+// A bomb's reply to a ModuleCommandMessage that asked for the rule behind the result. The
+// rule is kept apart from the result so that it only reaches spectators.
+type explainedResult struct {
+	result command.ModuleInputCommandResult
+	rule   *valueobject.RuleExplanation
 }
 
 func (m ModuleCommandMessage) MessageType() string {
@@ -45,6 +88,7 @@ func (m AddBombMessage) GetResponseChannel() chan Response {
 	return m.ResponseChannel
 }
 
+// Asks a session actor for a SessionSnapshot of the session and its bombs.
 type GetBombsMessage struct {
 	ResponseChannel chan Response
 }
@@ -57,6 +101,271 @@ func (m GetBombsMessage) GetResponseChannel() chan Response {
 	return m.ResponseChannel
 }
 
+type GetGameReportMessage struct {
+	ResponseChannel chan Response
+}
+
+func (m GetGameReportMessage) MessageType() string {
+	return "GetGameReport"
+}
+
+func (m GetGameReportMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+// Starts a bomb's clock. The bomb actor replies once the bomb is armed.
+type ArmBombMessage struct {
+	// When the clock starts
+	At time.Time
+	// Holds the clock straight away, for bombs armed while their session is paused
+	Paused          bool
+	ResponseChannel chan Response
+}
+
+func (m ArmBombMessage) MessageType() string {
+	return "ArmBomb"
+}
+
+func (m ArmBombMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+// Pauses or resumes a bomb's clock at the given moment.
+type SetBombPausedMessage struct {
+	Paused          bool
+	At              time.Time
+	ResponseChannel chan Response
+}
+
+func (m SetBombPausedMessage) MessageType() string {
+	return "SetBombPaused"
+}
+
+func (m SetBombPausedMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+// Shows a bomb's edgework to players when it's hidden by valueobject.ModifierEdgeworkOnRequest.
+// Sent to a session, which passes it on to the bomb.
+type RevealEdgeworkMessage struct {
+	BombID          uuid.UUID
+	ResponseChannel chan Response
+}
+
+func (m RevealEdgeworkMessage) MessageType() string {
+	return "RevealEdgework"
+}
+
+func (m RevealEdgeworkMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+// Asks a bomb actor for an entities.BombSnapshot of its bomb.
+type GetBombSnapshotMessage struct {
+	ResponseChannel chan Response
+}
+
+func (m GetBombSnapshotMessage) MessageType() string {
+	return "GetBombSnapshot"
+}
+
+func (m GetBombSnapshotMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+// Adds a player to a session's lobby. The session replies with its valueobject.Lobby.
+type JoinLobbyMessage struct {
+	PlayerID        string
+	Role            valueobject.PlayerRole
+	ResponseChannel chan Response
+}
+
+func (m JoinLobbyMessage) MessageType() string {
+	return "JoinLobby"
+}
+
+func (m JoinLobbyMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+// Marks a player in a session's lobby as ready or not. The game starts once every player
+// is ready. The session replies with its valueobject.Lobby.
+type SetPlayerReadyMessage struct {
+	PlayerID        string
+	Ready           bool
+	ResponseChannel chan Response
+}
+
+func (m SetPlayerReadyMessage) MessageType() string {
+	return "SetPlayerReady"
+}
+
+func (m SetPlayerReadyMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+// Hands bomb faces to a defuser in a session's lobby, taking them from whoever owned them.
+// The session replies with its valueobject.Lobby.
+type AssignFacesMessage struct {
+	// Player asking: the defuser themselves or the session's host
+	CallerID        string
+	PlayerID        string
+	Faces           []int
+	ResponseChannel chan Response
+}
+
+func (m AssignFacesMessage) MessageType() string {
+	return "AssignFaces"
+}
+
+func (m AssignFacesMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+// Pauses a session's game: every bomb's clock is held and input is rejected until it's
+// resumed. The session replies once every bomb is paused.
+type PauseGameMessage struct {
+	ResponseChannel chan Response
+}
+
+func (m PauseGameMessage) MessageType() string {
+	return "PauseGame"
+}
+
+func (m PauseGameMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+type ResumeGameMessage struct {
+	ResponseChannel chan Response
+}
+
+func (m ResumeGameMessage) MessageType() string {
+	return "ResumeGame"
+}
+
+func (m ResumeGameMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+// Checks that a player can see the session's live state and pause it. The session replies
+// with the error from entities.GameSession.CheckPlaying, if there is one.
+type CheckPlayingMessage struct {
+	PlayerID        string
+	ResponseChannel chan Response
+}
+
+func (m CheckPlayingMessage) MessageType() string {
+	return "CheckPlaying"
+}
+
+func (m CheckPlayingMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+// Starts watching a session as a spectator who joined its lobby. The session replies with
+// a *SessionWatch.
+type WatchSessionMessage struct {
+	PlayerID        string
+	ResponseChannel chan Response
+}
+
+func (m WatchSessionMessage) MessageType() string {
+	return "WatchSession"
+}
+
+func (m WatchSessionMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+// Follows a session as it happens, without joining its lobby. Used by the server itself,
+// such as by versus matches, which can't wait out the spectator delay. The session
+// replies with a *SessionWatch.
+type ObserveSessionMessage struct {
+	ResponseChannel chan Response
+}
+
+func (m ObserveSessionMessage) MessageType() string {
+	return "ObserveSession"
+}
+
+func (m ObserveSessionMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+// Ends a session's lobby and arms its bombs. The session replies with its
+// valueobject.Lobby. Sessions in a versus match can only be started by their match.
+type StartGameMessage struct {
+	ResponseChannel chan Response
+	// Set when the session's match is starting it
+	byMatch bool
+	// When the match started, so every team's clocks start together
+	at time.Time
+}
+
+func (m StartGameMessage) MessageType() string {
+	return "StartGame"
+}
+
+func (m StartGameMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+// Starts every session in a match at once, without waiting for the teams to be ready. The
+// match replies with its valueobject.Scoreboard.
+type StartMatchMessage struct {
+	ResponseChannel chan Response
+}
+
+func (m StartMatchMessage) MessageType() string {
+	return "StartMatch"
+}
+
+func (m StartMatchMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+// Asks a match for its valueobject.Scoreboard.
+type GetScoreboardMessage struct {
+	ResponseChannel chan Response
+}
+
+func (m GetScoreboardMessage) MessageType() string {
+	return "GetScoreboard"
+}
+
+func (m GetScoreboardMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+// Hands a team its session, the first time the team is claimed. The match replies with a
+// valueobject.MatchTeam.
+type ClaimMatchTeamMessage struct {
+	Team            string
+	ResponseChannel chan Response
+}
+
+func (m ClaimMatchTeamMessage) MessageType() string {
+	return "ClaimMatchTeam"
+}
+
+func (m ClaimMatchTeamMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
+// Starts watching a match's scoreboard. The match replies with a *MatchWatch.
+type WatchMatchMessage struct {
+	ResponseChannel chan Response
+}
+
+func (m WatchMatchMessage) MessageType() string {
+	return "WatchMatch"
+}
+
+func (m WatchMatchMessage) GetResponseChannel() chan Response {
+	return m.ResponseChannel
+}
+
 type SuccessResponse struct {
 	Data interface{}
 }
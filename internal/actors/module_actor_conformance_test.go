@@ -0,0 +1,368 @@
+package actors_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/actors"
+	"github.com/ZaneH/defuse.party-go/internal/application/command"
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// What the conformance suite needs to know to drive a module type's actor.
+type moduleActorConformance struct {
+	// Commands a player could send to the module in its starting state
+	inputs func(base command.BaseModuleInputCommand, module entities.Module) []command.ModuleInputCommand
+	// Whether any of the inputs can give a strike
+	canStrike bool
+}
+
+// Every module type in the domain's module registry needs an entry here.
+var moduleActorConformanceCases = map[valueobject.ModuleType]moduleActorConformance{
+	valueobject.ClockModule: {
+		inputs: func(command.BaseModuleInputCommand, entities.Module) []command.ModuleInputCommand {
+			return nil
+		},
+	},
+	valueobject.WiresModule: {
+		inputs: func(base command.BaseModuleInputCommand, module entities.Module) []command.ModuleInputCommand {
+			var cmds []command.ModuleInputCommand
+			for _, wire := range module.(*entities.WiresModule).State.Wires {
+				cmds = append(cmds, &command.WiresInputCommand{BaseModuleInputCommand: base, WirePosition: wire.Position})
+			}
+			return cmds
+		},
+		canStrike: true,
+	},
+	valueobject.PasswordModule: {
+		inputs: func(base command.BaseModuleInputCommand, _ entities.Module) []command.ModuleInputCommand {
+			return []command.ModuleInputCommand{
+				&command.PasswordSubmitCommand{BaseModuleInputCommand: base},
+				&command.PasswordLetterChangeCommand{BaseModuleInputCommand: base, LetterIndex: 0, Direction: valueobject.Increment},
+				&command.PasswordLetterChangeCommand{BaseModuleInputCommand: base, LetterIndex: 0, Direction: valueobject.Decrement},
+			}
+		},
+		canStrike: true,
+	},
+	valueobject.BigButtonModule: {
+		inputs: func(base command.BaseModuleInputCommand, _ entities.Module) []command.ModuleInputCommand {
+			return []command.ModuleInputCommand{
+				&command.BigButtonInputCommand{BaseModuleInputCommand: base, PressType: valueobject.PressTypeTap},
+				&command.BigButtonInputCommand{BaseModuleInputCommand: base, PressType: valueobject.PressTypeHold},
+				&command.BigButtonInputCommand{BaseModuleInputCommand: base, PressType: valueobject.PressTypeRelease, ReleaseTimestamp: time.Now().Unix()},
+			}
+		},
+		canStrike: true,
+	},
+	valueobject.SimonModule: {
+		inputs: func(base command.BaseModuleInputCommand, _ entities.Module) []command.ModuleInputCommand {
+			var cmds []command.ModuleInputCommand
+			for _, color := range []valueobject.Color{valueobject.Red, valueobject.Blue, valueobject.Green, valueobject.Yellow} {
+				cmds = append(cmds, &command.SimonInputCommand{BaseModuleInputCommand: base, Color: color})
+			}
+			return cmds
+		},
+		canStrike: true,
+	},
+	valueobject.KeypadModule: {
+		inputs: func(base command.BaseModuleInputCommand, module entities.Module) []command.ModuleInputCommand {
+			var cmds []command.ModuleInputCommand
+			for _, symbol := range module.(*entities.KeypadModule).State.DisplayedSymbols {
+				cmds = append(cmds, &command.KeypadInputCommand{BaseModuleInputCommand: base, Symbol: symbol})
+			}
+			return cmds
+		},
+		canStrike: true,
+	},
+	valueobject.WhosOnFirstModule: {
+		inputs: func(base command.BaseModuleInputCommand, module entities.Module) []command.ModuleInputCommand {
+			var cmds []command.ModuleInputCommand
+			for _, word := range module.(*entities.WhosOnFirstModule).State.ButtonWords {
+				cmds = append(cmds, &command.WhosOnFirstInputCommand{BaseModuleInputCommand: base, Word: word})
+			}
+			return cmds
+		},
+		canStrike: true,
+	},
+	valueobject.MemoryModule: {
+		inputs: func(base command.BaseModuleInputCommand, module entities.Module) []command.ModuleInputCommand {
+			var cmds []command.ModuleInputCommand
+			for i := range module.(*entities.MemoryModule).State.DisplayedNumbers {
+				cmds = append(cmds, &command.MemoryInputCommand{BaseModuleInputCommand: base, ButtonIndex: i})
+			}
+			return cmds
+		},
+		canStrike: true,
+	},
+	valueobject.MorseModule: {
+		inputs: func(base command.BaseModuleInputCommand, _ entities.Module) []command.ModuleInputCommand {
+			return []command.ModuleInputCommand{
+				&command.MorseTxCommand{BaseModuleInputCommand: base},
+				&command.MorseChangeFrequencyCommand{BaseModuleInputCommand: base, Direction: valueobject.Increment},
+				&command.MorseChangeFrequencyCommand{BaseModuleInputCommand: base, Direction: valueobject.Decrement},
+			}
+		},
+		canStrike: true,
+	},
+	valueobject.NeedyVentGasModule: {
+		inputs: func(base command.BaseModuleInputCommand, _ entities.Module) []command.ModuleInputCommand {
+			return []command.ModuleInputCommand{
+				&command.NeedyVentGasCommand{BaseModuleInputCommand: base, Input: true},
+				&command.NeedyVentGasCommand{BaseModuleInputCommand: base, Input: false},
+			}
+		},
+		canStrike: true,
+	},
+	valueobject.NeedyKnobModule: {
+		// Needy knobs only strike when their countdown runs out
+		inputs: func(base command.BaseModuleInputCommand, _ entities.Module) []command.ModuleInputCommand {
+			return []command.ModuleInputCommand{
+				&command.NeedyKnobCommand{BaseModuleInputCommand: base},
+			}
+		},
+	},
+	valueobject.MazeModule: {
+		inputs: func(base command.BaseModuleInputCommand, _ entities.Module) []command.ModuleInputCommand {
+			var cmds []command.ModuleInputCommand
+			for _, direction := range []valueobject.CardinalDirection{valueobject.North, valueobject.East, valueobject.South, valueobject.West} {
+				cmds = append(cmds, &command.MazeCommand{BaseModuleInputCommand: base, Direction: direction})
+			}
+			return cmds
+		},
+		canStrike: true,
+	},
+}
+
+// A command that no module actor handles
+type unknownModuleCommand struct {
+	command.BaseModuleInputCommand
+}
+
+// A registered module's actor, reached through the bomb actor that owns it so the suite
+// runs the actor the way bombs do. Starting and stopping it starts and stops the bomb.
+type bombModuleActor struct {
+	bomb   *actors.BombActor
+	module entities.Module
+}
+
+func (a *bombModuleActor) Start() {
+	a.bomb.Start()
+}
+
+func (a *bombModuleActor) Stop() {
+	a.bomb.Stop()
+}
+
+// Must only be called once the actor is started
+func (a *bombModuleActor) Send(msg actors.Message) {
+	a.bomb.GetModuleActor(a.module.GetModuleID()).Send(msg)
+}
+
+func (a *bombModuleActor) GetModuleID() uuid.UUID {
+	return a.module.GetModuleID()
+}
+
+func (a *bombModuleActor) GetModule() entities.Module {
+	return a.module
+}
+
+// Builds the module the bomb factory would for the given type, on a bomb of its own. The
+// actor isn't started so that tests can change the module first.
+func newRegisteredModuleActor(t *testing.T, moduleType valueobject.ModuleType, seed string, scheduled bool) (actors.ModuleActor, []command.ModuleInputCommand) {
+	t.Helper()
+
+	rng := services.NewSeededRNGFromString(seed)
+	bomb := entities.NewBomb(rng, valueobject.NewDefaultBombConfig())
+	module, err := services.NewModuleFactory(rng).CreateModule(moduleType)
+	if err != nil {
+		t.Fatalf("creating module: %v", err)
+	}
+	if err := bomb.AddModule(module, valueobject.ModulePosition{}); err != nil {
+		t.Fatalf("adding module: %v", err)
+	}
+	module.SetBomb(bomb)
+
+	// Bombs skip modules they can't build an actor for, so check for one here
+	if _, err := actors.CreateModuleActor(bomb, module); err != nil {
+		t.Fatalf("creating actor: %v", err)
+	}
+	bombActor := actors.NewBombActor(bomb)
+	bombActor.SetModuleScheduling(scheduled)
+
+	base := command.BaseModuleInputCommand{BombID: bomb.ID, ModuleID: module.GetModuleID()}
+	return &bombModuleActor{bomb: bombActor, module: module}, moduleActorConformanceCases[moduleType].inputs(base, module)
+}
+
+func sendModuleCommand(t *testing.T, actor actors.Actor, cmd command.ModuleInputCommand) actors.Response {
+	return sendAndWait(t, actor, func(respChan chan actors.Response) actors.Message {
+		return actors.ModuleCommandMessage{Command: cmd, ResponseChannel: respChan}
+	})
+}
+
+func TestModuleActorConformance(t *testing.T) {
+	for _, mode := range []struct {
+		name      string
+		scheduled bool
+	}{
+		{name: "per-bomb-scheduler", scheduled: true},
+		{name: "goroutine-per-module", scheduled: false},
+	} {
+		t.Run(mode.name, func(t *testing.T) {
+			testModuleActorConformance(t, mode.scheduled)
+		})
+	}
+}
+
+func testModuleActorConformance(t *testing.T, scheduled bool) {
+	for _, moduleType := range entities.RegisteredModuleTypes() {
+		t.Run(moduleType.String(), func(t *testing.T) {
+			conformance, ok := moduleActorConformanceCases[moduleType]
+			if !ok {
+				t.Fatalf("%v is registered but has no conformance case", moduleType)
+			}
+
+			t.Run("RejectsUnknownCommands", func(t *testing.T) {
+				// Arrange
+				actor, _ := newRegisteredModuleActor(t, moduleType, "conformance", scheduled)
+				actor.Start()
+				defer actor.Stop()
+
+				// Act
+				cmdResp := sendModuleCommand(t, actor, &unknownModuleCommand{})
+				msgResp := sendAndWait(t, actor, func(respChan chan actors.Response) actors.Message {
+					return actors.GetBombSnapshotMessage{ResponseChannel: respChan}
+				})
+
+				// Assert
+				assert.False(t, cmdResp.IsSuccess(), "Unknown commands should be answered with an error")
+				assert.False(t, msgResp.IsSuccess(), "Unknown messages should be answered with an error")
+			})
+
+			t.Run("ReportsResultsAndStrikes", func(t *testing.T) {
+				// Arrange: each input is sent to a fresh module so earlier inputs don't change the outcome
+				struck := false
+
+				for seed := range 10 {
+					_, inputs := newRegisteredModuleActor(t, moduleType, fmt.Sprintf("conformance-%d", seed), scheduled)
+					for i := range inputs {
+						actor, inputs := newRegisteredModuleActor(t, moduleType, fmt.Sprintf("conformance-%d", seed), scheduled)
+						actor.Start()
+
+						// Act
+						resp := sendModuleCommand(t, actor, inputs[i])
+						actor.Stop()
+
+						// Assert
+						if !resp.IsSuccess() {
+							continue
+						}
+
+						result, ok := resp.(actors.SuccessResponse).Data.(command.ModuleInputCommandResult)
+						if !assert.True(t, ok, "%T should be a ModuleInputCommandResult so the bomb sees strikes", resp.(actors.SuccessResponse).Data) {
+							return
+						}
+						struck = struck || result.HasStrike()
+					}
+				}
+
+				assert.Equal(t, conformance.canStrike, struck, "Strikes should be reported through the result")
+			})
+
+			t.Run("SolvedModuleRejectsInput", func(t *testing.T) {
+				// Arrange
+				actor, inputs := newRegisteredModuleActor(t, moduleType, "conformance", scheduled)
+				module := actor.GetModule()
+				if state := module.GetModuleState(); state != nil {
+					state.MarkAsSolved()
+				}
+				before := module.String()
+
+				actor.Start()
+				defer actor.Stop()
+
+				for _, input := range inputs {
+					// Act
+					resp := sendModuleCommand(t, actor, input)
+
+					// Assert
+					assert.True(t, errors.Is(resp.Error(), actors.ErrModuleSolved), "%T should be rejected by a solved module", input)
+				}
+				assert.Equal(t, before, module.String(), "Solved module shouldn't change")
+			})
+
+			t.Run("StopsCleanly", func(t *testing.T) {
+				// Arrange
+				actor, inputs := newRegisteredModuleActor(t, moduleType, "conformance", scheduled)
+				actor.Start()
+
+				// Act: more sends than any mailbox holds
+				actor.Stop()
+				sent := make(chan struct{})
+				go func() {
+					defer close(sent)
+					for range 200 {
+						actor.Send(actors.ModuleCommandMessage{
+							Command:         &unknownModuleCommand{},
+							ResponseChannel: make(chan actors.Response, 1),
+						})
+						for _, input := range inputs {
+							actor.Send(actors.ModuleCommandMessage{
+								Command:         input,
+								ResponseChannel: make(chan actors.Response, 1),
+							})
+						}
+					}
+				}()
+
+				// Assert
+				select {
+				case <-sent:
+				case <-time.After(1 * time.Second):
+					t.Fatal("Sending to a stopped actor shouldn't block")
+				}
+			})
+
+			// Run with -race: the actor must handle one message at a time
+			t.Run("SerializesConcurrentSends", func(t *testing.T) {
+				// Arrange
+				actor, inputs := newRegisteredModuleActor(t, moduleType, "conformance", scheduled)
+				actor.Start()
+				defer actor.Stop()
+
+				inputs = append(inputs, &unknownModuleCommand{})
+
+				// Act
+				var wg sync.WaitGroup
+				for worker := range 8 {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						for i := range 25 {
+							respChan := make(chan actors.Response, 1)
+							actor.Send(actors.ModuleCommandMessage{
+								Command:         inputs[(worker+i)%len(inputs)],
+								ResponseChannel: respChan,
+							})
+
+							// Assert
+							select {
+							case <-respChan:
+							case <-time.After(1 * time.Second):
+								t.Errorf("Worker %d: timeout waiting for response %d", worker, i)
+								return
+							}
+						}
+					}()
+				}
+				wg.Wait()
+			})
+		})
+	}
+}
@@ -4,35 +4,45 @@ import (
 	"fmt"
 
 	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
 )
 
+type moduleActorConstructor func(module entities.Module) (ModuleActor, error)
+
+// Actor for every module type that can be placed on a bomb
+var moduleActorConstructors = map[valueobject.ModuleType]moduleActorConstructor{
+	valueobject.ClockModule: moduleActor(func(module *entities.ClockModule) *StubModuleActor {
+		return NewStubModuleActor(module, 0)
+	}),
+	valueobject.WiresModule:        moduleActor(NewWiresModuleActor),
+	valueobject.PasswordModule:     moduleActor(NewPasswordModuleActor),
+	valueobject.BigButtonModule:    moduleActor(NewBigButtonModuleActor),
+	valueobject.SimonModule:        moduleActor(NewSimonModuleActor),
+	valueobject.KeypadModule:       moduleActor(NewKeypadModuleActor),
+	valueobject.WhosOnFirstModule:  moduleActor(NewWhosOnFirstModuleActor),
+	valueobject.MemoryModule:       moduleActor(NewMemoryModuleActor),
+	valueobject.MorseModule:        moduleActor(NewMorseModuleActor),
+	valueobject.NeedyVentGasModule: moduleActor(NewNeedyVentGasModuleActor),
+	valueobject.NeedyKnobModule:    moduleActor(NewNeedyKnobModuleActor),
+	valueobject.MazeModule:         moduleActor(NewMazeModuleActor),
+}
+
+// Adapts a typed actor constructor to the registry
+func moduleActor[M entities.Module, A ModuleActor](newActor func(M) A) moduleActorConstructor {
+	return func(module entities.Module) (ModuleActor, error) {
+		typed, ok := module.(M)
+		if !ok {
+			return nil, fmt.Errorf("%w: expected %T but got %T", ErrInvalidModuleType, typed, module)
+		}
+		return newActor(typed), nil
+	}
+}
+
 func CreateModuleActor(bomb *entities.Bomb, module entities.Module) (ModuleActor, error) {
-	switch module := module.(type) {
-	case *entities.ClockModule:
-		return NewStubModuleActor(module, 0), nil
-	case *entities.WiresModule:
-		return NewWiresModuleActor(module), nil
-	case *entities.PasswordModule:
-		return NewPasswordModuleActor(module), nil
-	case *entities.BigButtonModule:
-		return NewBigButtonModuleActor(module), nil
-	case *entities.SimonModule:
-		return NewSimonModuleActor(module), nil
-	case *entities.KeypadModule:
-		return NewKeypadModuleActor(module), nil
-	case *entities.WhosOnFirstModule:
-		return NewWhosOnFirstModuleActor(module), nil
-	case *entities.MemoryModule:
-		return NewMemoryModuleActor(module), nil
-	case *entities.MorseModule:
-		return NewMorseModuleActor(module), nil
-	case *entities.NeedyVentGasModule:
-		return NewNeedyVentGasModuleActor(module), nil
-	case *entities.NeedyKnobModule:
-		return NewNeedyKnobModuleActor(module), nil
-	case *entities.MazeModule:
-		return NewMazeModuleActor(module), nil
-	default:
+	newActor, ok := moduleActorConstructors[module.GetType()]
+	if !ok {
 		return nil, fmt.Errorf("unsupported module type: %v", module.GetType())
 	}
+
+	return newActor(module)
 }
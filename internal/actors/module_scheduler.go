@@ -0,0 +1,32 @@
+package actors
+
+// Module actors that can run on a bomb's goroutine. Every actor built on BaseModuleActor
+// can.
+type schedulableModuleActor interface {
+	ModuleActor
+	runOn(scheduler *moduleScheduler)
+	isScheduledOn(scheduler *moduleScheduler) bool
+	dispatch(msg Message)
+}
+
+// Runs a bomb's module actors on the bomb's goroutine. A level 10 bomb has up to 24
+// modules, so this saves a goroutine and mailbox for each of them. Modules on one bomb were
+// already handled one at a time, since the bomb waits for each reply.
+type moduleScheduler struct {
+	bomb *BombActor
+}
+
+// A message for a scheduled module actor, queued in its bomb's mailbox
+type scheduledModuleMessage struct {
+	actor schedulableModuleActor
+	msg   Message
+}
+
+func (m scheduledModuleMessage) MessageType() string {
+	return "ScheduledModule"
+}
+
+// Queues a message sent to one of the bomb's modules from outside the bomb.
+func (s *moduleScheduler) schedule(actor schedulableModuleActor, msg Message) {
+	s.bomb.Send(scheduledModuleMessage{actor: actor, msg: msg})
+}
@@ -2,6 +2,7 @@ package actors
 
 import (
 	"errors"
+	"time"
 
 	"github.com/ZaneH/defuse.party-go/internal/application/command"
 	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
@@ -49,10 +50,11 @@ func (a *NeedyKnobModuleActor) handleModuleCommand(msg ModuleCommandMessage) {
 				Solved: a.module.GetModuleState().IsSolved(),
 				Strike: false,
 			},
-			DisplayedPattern:  needyKnobModule.State.DisplayedPattern,
-			DialDirection:     needyKnobModule.State.DialDirection,
-			CoundownStartedAt: needyKnobModule.State.CountdownStartedAt,
-			CountdownDuration: needyKnobModule.State.CountdownDuration,
+			DisplayedPattern:   needyKnobModule.State.DisplayedPattern,
+			DialDirection:      needyKnobModule.State.DialDirection,
+			CoundownStartedAt:  needyKnobModule.State.CountdownStartedAt,
+			CountdownDuration:  needyKnobModule.State.CountdownDuration,
+			CountdownRemaining: needyKnobModule.CountdownRemaining(time.Now()),
 		}
 
 		if err != nil {
@@ -2,6 +2,7 @@ package actors
 
 import (
 	"errors"
+	"time"
 
 	"github.com/ZaneH/defuse.party-go/internal/application/command"
 	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
@@ -52,6 +53,7 @@ func (a *NeedyVentGasModuleActor) handleModuleCommand(msg ModuleCommandMessage)
 			DisplayedQuestion:  needyVentGasModule.GetCurrentQuestion(),
 			CountdownStartedAt: needyVentGasModule.State.CountdownStartedAt,
 			CountdownDuration:  needyVentGasModule.State.CountdownDuration,
+			CountdownRemaining: needyVentGasModule.CountdownRemaining(time.Now()),
 		}
 
 		if err != nil {
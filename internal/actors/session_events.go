@@ -0,0 +1,268 @@
+package actors
+
+import (
+	"cmp"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+)
+
+// How long updates are kept past the session's spectator delay, for spectators who are
+// slow to read them
+const eventLogGrace = time.Minute
+
+// What a spectator receives for each of a session's events: the event and the whole
+// session as it was straight after it.
+type SpectatorUpdate struct {
+	Event   valueobject.SessionEvent
+	Session SessionSnapshot
+}
+
+// A spectator's read-only view of a session. Updates arrive the session's spectator delay
+// after they happened, or straight away for observers, and the channel is closed once the
+// watch is closed or the session stops.
+type SessionWatch struct {
+	Updates <-chan SpectatorUpdate
+	close   func()
+	once    sync.Once
+}
+
+// Stops the updates. Safe to call more than once.
+func (w *SessionWatch) Close() {
+	w.once.Do(w.close)
+}
+
+// Every update published to a session's spectators, kept once for all of them. Each
+// spectator reads it by sequence at their own pace, so a long delay only costs the updates
+// made during it.
+type eventLog struct {
+	mu sync.Mutex
+	// In sequence order. Updates older than keepFor are dropped as new ones are added.
+	updates []SpectatorUpdate
+	keepFor time.Duration
+	// Closed and replaced whenever an update is added
+	added chan struct{}
+}
+
+func newEventLog(keepFor time.Duration) *eventLog {
+	return &eventLog{keepFor: keepFor, added: make(chan struct{})}
+}
+
+func (l *eventLog) append(update SpectatorUpdate) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.updates = append(l.updates, update)
+	keepFrom := update.Event.At.Add(-l.keepFor)
+	stale := 0
+	for stale < len(l.updates) && l.updates[stale].Event.At.Before(keepFrom) {
+		stale++
+	}
+	l.updates = slices.Delete(l.updates, 0, stale)
+
+	close(l.added)
+	l.added = make(chan struct{})
+}
+
+// Returns the first update with at least the given sequence. If there isn't one yet, returns
+// a channel that's closed once another update is added.
+func (l *eventLog) next(sequence uint64) (SpectatorUpdate, bool, <-chan struct{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	i, _ := slices.BinarySearchFunc(l.updates, sequence, func(update SpectatorUpdate, sequence uint64) int {
+		return cmp.Compare(update.Event.Sequence, sequence)
+	})
+	if i == len(l.updates) {
+		return SpectatorUpdate{}, false, l.added
+	}
+	return l.updates[i], true, nil
+}
+
+// A spectator watching a session. Each one waits out the session's delay on its own
+// goroutine, so a long delay or a slow spectator never holds up the game or other
+// spectators.
+type spectator struct {
+	id    uint64
+	delay time.Duration
+	// Sequence of the update the spectator starts with: the session as it was when they
+	// started watching
+	from    uint64
+	log     *eventLog
+	updates chan SpectatorUpdate
+	done    chan struct{}
+}
+
+func newSpectator(id uint64, delay time.Duration, from uint64, log *eventLog) *spectator {
+	return &spectator{
+		id:      id,
+		delay:   delay,
+		from:    from,
+		log:     log,
+		updates: make(chan SpectatorUpdate),
+		done:    make(chan struct{}),
+	}
+}
+
+// Hands updates to the spectator once they're old enough, until the spectator stops
+// watching or the session stops.
+func (s *spectator) run(sessionDone <-chan struct{}) {
+	defer close(s.updates)
+
+	sequence := s.from
+	for {
+		update, ok := s.next(sequence, sessionDone)
+		if !ok {
+			return
+		}
+		if update.Event.Sequence > sequence {
+			log.Printf("spectator %d missed events %d to %d", s.id, sequence, update.Event.Sequence-1)
+		}
+		sequence = update.Event.Sequence + 1
+
+		if !s.waitUntil(update.Event.At.Add(s.delay), sessionDone) {
+			return
+		}
+		select {
+		case s.updates <- update:
+		case <-s.done:
+			return
+		case <-sessionDone:
+			return
+		}
+	}
+}
+
+// Waits for the first update with at least the given sequence. Returns false if the
+// spectator stopped watching or the session stopped first.
+func (s *spectator) next(sequence uint64, sessionDone <-chan struct{}) (SpectatorUpdate, bool) {
+	for {
+		update, ok, added := s.log.next(sequence)
+		if ok {
+			return update, true
+		}
+
+		select {
+		case <-added:
+		case <-s.done:
+			return SpectatorUpdate{}, false
+		case <-sessionDone:
+			return SpectatorUpdate{}, false
+		}
+	}
+}
+
+// Returns false if the spectator stopped watching or the session stopped first.
+func (s *spectator) waitUntil(at time.Time, sessionDone <-chan struct{}) bool {
+	wait := time.Until(at)
+	if wait <= 0 {
+		return true
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-s.done:
+		return false
+	case <-sessionDone:
+		return false
+	}
+}
+
+// Stops a spectator's updates. Sent by SessionWatch.Close.
+type unwatchSessionMessage struct {
+	spectatorID uint64
+}
+
+func (m unwatchSessionMessage) MessageType() string {
+	return "UnwatchSession"
+}
+
+// Publishes an event to every spectator along with the session as it is straight after it.
+// The bombs are asked for their snapshots inside the loop so that they match the event, but
+// the answers are waited for on a goroutine, so a slow bomb only holds up what spectators
+// see. Does nothing while nobody is watching, so a session only pays for snapshots while it
+// has spectators.
+func (g *GameSessionActor) publish(event valueobject.SessionEvent) {
+	if len(g.spectators) == 0 {
+		return
+	}
+
+	g.eventSequence++
+	event.Sequence = g.eventSequence
+	pending, session := g.askForSnapshots(g.GetOrderedBombActors()), g.describeSession()
+
+	// Snapshots can come back in any order, so each update waits for the one before it
+	previous, published := g.published, make(chan struct{})
+	g.published = published
+
+	go func() {
+		defer close(published)
+
+		snapshot, err := g.collectSnapshots(pending, session)
+		<-previous
+		if err != nil {
+			log.Printf("session %v: error taking snapshot for event %d: %v", session.SessionID, event.Sequence, err)
+			return
+		}
+		g.events.append(SpectatorUpdate{Event: event, Session: snapshot})
+	}()
+}
+
+// Lets a spectator who joined the lobby watch the session. The first update they get is
+// the session as it was when they started watching.
+func (g *GameSessionActor) handleWatchSession(msg WatchSessionMessage) {
+	player, ok := g.session.Player(msg.PlayerID)
+	if !ok {
+		msg.ResponseChannel <- ErrorResponse{Err: valueobject.ErrPlayerNotInLobby}
+		return
+	}
+	if player.Role != valueobject.PlayerRoleSpectator {
+		msg.ResponseChannel <- ErrorResponse{Err: valueobject.ErrNotSpectator}
+		return
+	}
+
+	g.replyWatch(msg.ResponseChannel, player, g.session.SpectatorDelay)
+}
+
+func (g *GameSessionActor) handleObserveSession(msg ObserveSessionMessage) {
+	g.replyWatch(msg.ResponseChannel, valueobject.LobbyPlayer{}, 0)
+}
+
+func (g *GameSessionActor) replyWatch(respChan chan Response, player valueobject.LobbyPlayer, delay time.Duration) {
+	respChan <- SuccessResponse{Data: g.watch(player, delay)}
+}
+
+// Adds a spectator who sees events the given delay after they happen, starting with the
+// session as it is now. If that first snapshot can't be taken, they start with the next
+// event instead.
+func (g *GameSessionActor) watch(player valueobject.LobbyPlayer, delay time.Duration) *SessionWatch {
+	g.nextSpectatorID++
+	s := newSpectator(g.nextSpectatorID, delay, g.eventSequence+1, g.events)
+	g.spectators[s.id] = s
+	g.publish(valueobject.SessionEvent{
+		Type:   valueobject.SessionEventWatching,
+		At:     time.Now(),
+		Player: player,
+	})
+	go s.run(g.Done())
+
+	return &SessionWatch{
+		Updates: s.updates,
+		close: func() {
+			g.Send(unwatchSessionMessage{spectatorID: s.id})
+		},
+	}
+}
+
+func (g *GameSessionActor) handleUnwatchSession(msg unwatchSessionMessage) {
+	if s, ok := g.spectators[msg.spectatorID]; ok {
+		delete(g.spectators, msg.spectatorID)
+		close(s.done)
+	}
+}
@@ -0,0 +1,248 @@
+package actors_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/actors"
+	"github.com/ZaneH/defuse.party-go/internal/application/command"
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+)
+
+func joinLobby(t *testing.T, sessionActor *actors.GameSessionActor, playerID string, role valueobject.PlayerRole) {
+	resp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.JoinLobbyMessage{PlayerID: playerID, Role: role, ResponseChannel: respChan}
+	})
+	assert.NoError(t, resp.Error(), "Expected %s to join", playerID)
+}
+
+func nextUpdate(t *testing.T, watch *actors.SessionWatch) actors.SpectatorUpdate {
+	select {
+	case update, ok := <-watch.Updates:
+		if !ok {
+			t.Fatalf("watch closed early")
+		}
+		return update
+	case <-time.After(1 * time.Second):
+		t.Fatalf("timeout waiting for update")
+		return actors.SpectatorUpdate{}
+	}
+}
+
+func TestGameSessionActor_SpectatorsWatchDelayedEvents(t *testing.T) {
+	// Arrange
+	const delay = 100 * time.Millisecond
+	rng := services.NewSeededRNGFromString("test")
+	config := valueobject.NewEasyGameSessionConfig("test")
+	config.SpectatorDelay = delay
+
+	sessionActor, sessionID := actors.NewGameSessionActor(rng, config)
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	bomb, wiresModule := newSingleWireBomb(rng)
+	sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+	})
+	joinLobby(t, sessionActor, "defuser", valueobject.PlayerRoleDefuser)
+	startGame(t, sessionActor)
+	joinLobby(t, sessionActor, "stream", valueobject.PlayerRoleSpectator)
+
+	watch := func(playerID string) actors.Response {
+		return sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+			return actors.WatchSessionMessage{PlayerID: playerID, ResponseChannel: respChan}
+		})
+	}
+
+	// Act
+	playerWatch := watch("defuser")
+	spectatorWatch := watch("stream")
+	if !assert.True(t, spectatorWatch.IsSuccess(), "Spectators should be able to watch") {
+		return
+	}
+	sessionWatch := spectatorWatch.(actors.SuccessResponse).Data.(*actors.SessionWatch)
+	defer sessionWatch.Close()
+
+	cutWire := func(playerID string, position int) actors.Response {
+		return sendAndWait(t, sessionActor, cutWireAs(playerID, sessionID, bomb, wiresModule, position))
+	}
+	spectatorResp := cutWire("stream", 2)
+	anonymousResp := cutWire("", 2)
+	strikeResp := cutWire("defuser", 1)
+	cutWire("defuser", 2)
+
+	updates := make([]actors.SpectatorUpdate, 0, 3)
+	for range 3 {
+		updates = append(updates, nextUpdate(t, sessionWatch))
+	}
+
+	// Assert
+	assert.ErrorIs(t, playerWatch.Error(), valueobject.ErrNotSpectator, "Players mustn't see the expert's side")
+	assert.ErrorIs(t, spectatorResp.Error(), valueobject.ErrSpectatorsOnlyWatch)
+	assert.ErrorIs(t, anonymousResp.Error(), valueobject.ErrPlayerNotInLobby, "Delayed sessions need to know who's sending input")
+
+	for _, update := range updates {
+		assert.GreaterOrEqual(t, time.Since(update.Event.At), delay, "Spectators should be kept behind the game")
+	}
+
+	watching, strike, solved := updates[0], updates[1], updates[2]
+	assert.Equal(t, valueobject.SessionEventWatching, watching.Event.Type)
+	assert.Equal(t, "stream", watching.Event.Player.PlayerID)
+	assert.Len(t, watching.Session.Bombs, 1, "Spectators should start with the whole session")
+
+	assert.Equal(t, valueobject.SessionEventModuleInput, strike.Event.Type)
+	assert.Equal(t, watching.Event.Sequence+1, strike.Event.Sequence)
+	assert.True(t, strike.Event.Strike)
+	assert.Equal(t, wiresModule.GetModuleID(), strike.Event.ModuleID)
+	if assert.NotNil(t, strike.Event.Rule, "Spectators should see the rule the expert reads") {
+		assert.Equal(t, "wires.3.no_red", strike.Event.Rule.RuleID)
+	}
+	assert.Nil(t, strikeResp.(actors.SuccessResponse).Data.(*command.WiresInputCommandResult).Rule, "Players shouldn't see the rule outside practice")
+
+	assert.True(t, solved.Event.Solved)
+	assert.Equal(t, valueobject.BombStateDefused, solved.Session.Bombs[0].State)
+}
+
+func TestGameSessionActor_ClosingWatchEndsUpdates(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	sessionActor, _ := actors.NewGameSessionActor(rng, valueobject.NewEasyGameSessionConfig("test"))
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	joinLobby(t, sessionActor, "stream", valueobject.PlayerRoleSpectator)
+	resp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.WatchSessionMessage{PlayerID: "stream", ResponseChannel: respChan}
+	})
+	sessionWatch := resp.(actors.SuccessResponse).Data.(*actors.SessionWatch)
+	nextUpdate(t, sessionWatch)
+
+	// Act
+	sessionWatch.Close()
+	sessionWatch.Close()
+
+	// Assert
+	select {
+	case _, ok := <-sessionWatch.Updates:
+		assert.False(t, ok, "No updates should arrive after closing")
+	case <-time.After(1 * time.Second):
+		t.Fatal("Updates should be closed")
+	}
+}
+
+func TestGameSessionActor_SpectatorsDontMissEventsDuringTheDelay(t *testing.T) {
+	// Arrange
+	const delay = 200 * time.Millisecond
+	const players = 500
+	rng := services.NewSeededRNGFromString("test")
+	config := valueobject.NewEasyGameSessionConfig("test")
+	config.SpectatorDelay = delay
+
+	sessionActor, _ := actors.NewGameSessionActor(rng, config)
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	joinLobby(t, sessionActor, "stream", valueobject.PlayerRoleSpectator)
+	resp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.WatchSessionMessage{PlayerID: "stream", ResponseChannel: respChan}
+	})
+	sessionWatch := resp.(actors.SuccessResponse).Data.(*actors.SessionWatch)
+	defer sessionWatch.Close()
+
+	// Act: more events than any spectator could be sent before the first one is due
+	for i := range players {
+		joinLobby(t, sessionActor, fmt.Sprintf("player %d", i), valueobject.PlayerRoleExpert)
+	}
+	var updates []actors.SpectatorUpdate
+	for range players + 1 {
+		updates = append(updates, nextUpdate(t, sessionWatch))
+	}
+
+	// Assert
+	for i, update := range updates[1:] {
+		assert.Equal(t, updates[0].Event.Sequence+uint64(i)+1, update.Event.Sequence, "No event should be skipped")
+		assert.Len(t, update.Session.Lobby.Players, i+2, "Each update should show the session as it was after its event")
+	}
+}
+
+func TestGameSessionActor_SlowBombDoesntHoldUpWatchedInput(t *testing.T) {
+	// Arrange
+	const timeout = 500 * time.Millisecond
+	rng := services.NewSeededRNGFromString("test")
+	config := valueobject.NewEasyGameSessionConfig("test")
+	config.BombMode = valueobject.BombModeParallel
+
+	sessionActor, sessionID := actors.NewGameSessionActor(rng, config)
+	sessionActor.SetRequestTimeout(timeout)
+	sessionActor.Start()
+	defer sessionActor.Stop()
+
+	stuckBomb, stuckWires := newSingleWireBomb(rng)
+	knobBomb, knob := newKnobBomb(rng)
+	for _, bomb := range []*entities.Bomb{stuckBomb, knobBomb} {
+		sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+			return actors.AddBombMessage{Bomb: bomb, ResponseChannel: respChan}
+		})
+	}
+	startGame(t, sessionActor)
+
+	resp := sendAndWait(t, sessionActor, func(respChan chan actors.Response) actors.Message {
+		return actors.ObserveSessionMessage{ResponseChannel: respChan}
+	})
+	sessionWatch := resp.(actors.SuccessResponse).Data.(*actors.SessionWatch)
+	defer sessionWatch.Close()
+	nextUpdate(t, sessionWatch)
+
+	realActor, err := actors.CreateModuleActor(stuckBomb, stuckWires)
+	assert.NoError(t, err)
+	realActor.Start()
+	blocked, release := make(chan struct{}, 1), make(chan struct{})
+	sessionActor.GetOrderedBombActors()[0].ReplaceModuleActor(stuckWires.GetModuleID(), blockingModuleActor{ModuleActor: realActor, blocked: blocked, release: release})
+	sessionActor.Send(wireCut(sessionID, stuckBomb, stuckWires)(make(chan actors.Response, 1)))
+	<-blocked
+
+	turnKnob := func(respChan chan actors.Response) actors.Message {
+		return actors.ModuleCommandMessage{
+			Command: &command.NeedyKnobCommand{
+				BaseModuleInputCommand: command.BaseModuleInputCommand{
+					SessionID: sessionID,
+					BombID:    knobBomb.ID,
+					ModuleID:  knob.GetModuleID(),
+				},
+			},
+			ResponseChannel: respChan,
+		}
+	}
+
+	// Act
+	started := time.Now()
+	var inputs []actors.Response
+	for range 3 {
+		inputs = append(inputs, sendAndWait(t, sessionActor, turnKnob))
+	}
+	took := time.Since(started)
+	close(release)
+
+	var updates []actors.SpectatorUpdate
+	for range 3 {
+		updates = append(updates, nextUpdate(t, sessionWatch))
+	}
+
+	// Assert
+	for _, input := range inputs {
+		assert.NoError(t, input.Error())
+	}
+	assert.Less(t, took, timeout/2, "Input to another bomb shouldn't wait on the stuck bomb's snapshot")
+
+	for i, update := range updates {
+		assert.Equal(t, valueobject.SessionEventModuleInput, update.Event.Type)
+		assert.Equal(t, knob.GetModuleID(), update.Event.ModuleID)
+		if i > 0 {
+			assert.Equal(t, updates[i-1].Event.Sequence+1, update.Event.Sequence, "Updates should arrive in order")
+		}
+	}
+}
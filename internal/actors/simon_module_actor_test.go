@@ -41,6 +41,9 @@ func TestSimonModuleActor_TestVowelCompleteSequence(t *testing.T) {
 		Bomb:            bomb,
 		ResponseChannel: make(chan actors.Response, 1),
 	})
+	gameSession.Send(actors.StartGameMessage{
+		ResponseChannel: make(chan actors.Response, 1),
+	})
 
 	sessionID := gameSession.GetSessionID()
 
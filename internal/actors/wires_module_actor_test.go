@@ -139,6 +139,79 @@ func TestWiresModuleActor_FourWiresMoreThanOneRedOddSerial(t *testing.T) {
 	t.Logf("Final state: %s", specifiedModule)
 }
 
+func TestWiresModuleActor_FourWiresMoreThanOneYellow(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("test")
+	bomb := entities.NewBomb(rng, valueobject.NewDefaultBombConfig())
+	bomb.SerialNumber = "1111"
+	simpleWiresModule := entities.NewWiresModule(rng)
+	simpleWiresModule.SetBomb(bomb)
+	simpleWiresModuleActor := actors.NewWiresModuleActor(simpleWiresModule)
+	simpleWiresModuleActor.Start() // Start the actor to process messages
+	defer simpleWiresModuleActor.Stop()
+
+	simpleWiresModule.SetState(entities.WiresState{
+		Wires: []valueobject.Wire{
+			{WireColor: valueobject.Yellow, Position: 1},
+			{WireColor: valueobject.Yellow, Position: 2},
+			{WireColor: valueobject.Black, Position: 3},
+			{WireColor: valueobject.White, Position: 4},
+		},
+	})
+
+	actions := []struct {
+		desc    string
+		wirePos int
+		solved  bool
+		strike  bool
+	}{
+		{
+			desc:    "Cut the last wire (White)",
+			wirePos: 4,
+			solved:  false,
+			strike:  true,
+		},
+		{
+			desc:    "Cut the last Yellow wire",
+			wirePos: 2,
+			solved:  true,
+			strike:  false,
+		},
+	}
+
+	for i, action := range actions {
+		t.Run(action.desc, func(t *testing.T) {
+			// Act
+			respChan := make(chan actors.Response, 1)
+			simpleWiresModuleActor.Send(actors.ModuleCommandMessage{
+				Command: &command.WiresInputCommand{
+					BaseModuleInputCommand: command.BaseModuleInputCommand{
+						SessionID: uuid.New(),
+						BombID:    bomb.ID,
+						ModuleID:  simpleWiresModule.GetModuleID(),
+					},
+					WirePosition: action.wirePos,
+				},
+				ResponseChannel: respChan,
+			})
+
+			// Assert
+			var resp actors.Response
+			select {
+			case resp = <-respChan:
+			case <-time.After(1 * time.Second):
+				t.Fatalf("Step %d: timeout waiting for response", i+1)
+			}
+
+			if successResp, ok := resp.(actors.SuccessResponse); assert.True(t, ok, "Step %d: expected success response", i+1) {
+				result := successResp.Data.(*command.WiresInputCommandResult)
+				assert.Equal(t, action.solved, result.Solved, "Step %d: solved state mismatch", i+1)
+				assert.Equal(t, action.strike, result.Strike, "Step %d: strike state mismatch", i+1)
+			}
+		})
+	}
+}
+
 func TestWiresModuleActor_ThreeWiresNoRed(t *testing.T) {
 	// Arrange
 	rng := services.NewSeededRNGFromString("test")
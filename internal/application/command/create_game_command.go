@@ -1,6 +1,8 @@
 package command
 
 import (
+	"time"
+
 	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
 	"github.com/google/uuid"
 )
@@ -12,23 +14,72 @@ const (
 	ConfigTypeLevel
 	ConfigTypeMission
 	ConfigTypeCustom
+	ConfigTypePractice
+	ConfigTypeChallenge
+	ConfigTypeBombCode
 )
 
 type CreateGameCommand struct {
 	Seed       string
 	ConfigType ConfigType
+	// Generator the bombs are built with. GeneratorVersionLatest picks the current one.
+	GeneratorVersion valueobject.GeneratorVersion
+	// Stops players from pausing the game. Challenges can never be paused.
+	DisablePause bool
+	// How far behind the game spectators are kept
+	SpectatorDelay time.Duration
+	// Set by the match service for sessions that start together with the rest of their
+	// match
+	StartedByMatch bool
+	// Optional rules every bomb in the game is played with
+	Modifiers []valueobject.Modifier
 
 	// Level-based config (1-10)
 	Level int
 
 	// Mission-based config
 	Mission valueobject.Mission
+	// Catalog mission ID ("<pack>/<mission>"), as listed by ListMissions. Takes precedence
+	// over Mission.
+	MissionID string
 
 	// Custom config
 	CustomConfig *valueobject.BombConfig
+	// Number of bombs built from the custom config, or from the mission's spec. 0 plays a
+	// mission with as many bombs as it was made for.
+	NumBombs int
+	// How the bombs are played when there is more than one
+	BombMode valueobject.BombMode
+
+	// Practice config: the single module type to drill
+	PracticeModule valueobject.ModuleType
+
+	// Challenge config: the period of the challenge to attempt and who is attempting it
+	ChallengePeriod valueobject.ChallengePeriod
+	PlayerID        string
+	// Resolved by the challenge service. The seed comes from the challenge, not the command.
+	Challenge *valueobject.Challenge
+
+	// Bomb code config: bombs exported from another session, played in the given BombMode
+	BombCodes []string
 }
 
 type CreateGameCommandResult struct {
 	SessionID uuid.UUID
-	Config    valueobject.BombConfig
+	// Seed the session was generated from, including one generated for an empty seed.
+	// Empty for challenges, which keep their seed hidden.
+	Seed string
+	// Identifies the settings the session was generated from. Custom sessions are ranked
+	// on the leaderboard for this hash.
+	ConfigHash       string
+	GeneratorVersion valueobject.GeneratorVersion
+	// Config of the first bomb. Every bomb in a session shares the same config.
+	Config   valueobject.BombConfig
+	NumBombs int
+	BombMode valueobject.BombMode
+	// Number of modules of each type placed across every bomb, not counting clocks
+	ModuleCounts map[valueobject.ModuleType]int
+	// Code for every bomb in the session, in play order. Importing them rebuilds the bombs
+	// exactly. Empty for challenges, like the seed.
+	BombCodes []string
 }
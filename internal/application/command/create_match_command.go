@@ -0,0 +1,23 @@
+package command
+
+import (
+	"github.com/google/uuid"
+)
+
+type CreateMatchCommand struct {
+	// Config every team's session is created from. Challenges can't be played as a match
+	// and match sessions can never be paused.
+	Game CreateGameCommand
+	// Names of the teams racing, one session each
+	Teams []string
+}
+
+type CreateMatchCommandResult struct {
+	MatchID uuid.UUID
+	// Names of the teams in the order they were given. Each team gets its session by
+	// joining the match, so nobody is told another team's.
+	Teams []string
+	// Result of creating the first team's session. Every team's session has the same seed
+	// and bombs.
+	Game *CreateGameCommandResult
+}
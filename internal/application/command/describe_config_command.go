@@ -0,0 +1,29 @@
+package command
+
+import "github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+
+// Result of resolving a CreateGameCommand without creating a session.
+type DescribeConfigResult struct {
+	// Seed the layout was generated from, or would be once the config is fixed. Generated
+	// when the command didn't set one.
+	Seed     string
+	BombMode valueobject.BombMode
+	// Generator the layout was planned with
+	GeneratorVersion valueobject.GeneratorVersion
+	// Problems reported by ValidateBombConfig. Modules are only planned when this is empty.
+	ValidationErrors valueobject.ValidationErrors
+	Bombs            []PlannedBomb
+}
+
+type PlannedBomb struct {
+	Config valueobject.BombConfig
+	// Modules the bomb factory would place, including the clock, ordered by position
+	Modules []PlannedModule
+	// Code that imports this exact bomb
+	Code string
+}
+
+type PlannedModule struct {
+	Type     valueobject.ModuleType
+	Position valueobject.ModulePosition
+}
@@ -1,6 +1,7 @@
 package command
 
 import (
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
 	"github.com/google/uuid"
 )
 
@@ -8,32 +9,60 @@ type ModuleInputCommand interface {
 	GetSessionID() uuid.UUID
 	GetBombID() uuid.UUID
 	GetModuleID() uuid.UUID
+	GetPlayerID() string
 }
 
 type BaseModuleInputCommand struct {
 	SessionID uuid.UUID
 	BombID    uuid.UUID
 	ModuleID  uuid.UUID
+	// Defuser sending the input. Needed for modules on a face a defuser owns.
+	PlayerID string
 }
 
 type BaseModuleInputCommandResult struct {
 	Solved bool
 	Strike bool
+	// Whether the bomb counted the strike. Modifiers can drop strikes the module still
+	// reports.
+	StrikeCounted bool
+	// Rule the strike was given for. Only set for strikes on practice bombs.
+	Rule *valueobject.RuleExplanation
 }
 
 type ModuleInputCommandResult interface {
 	HasStrike() bool
+	IsStrikeCounted() bool
+	SetStrikeCounted(counted bool)
 	IsSolved() bool
+	GetRule() *valueobject.RuleExplanation
+	SetRule(rule *valueobject.RuleExplanation)
 }
 
 func (r BaseModuleInputCommandResult) HasStrike() bool {
 	return r.Strike
 }
 
+func (r BaseModuleInputCommandResult) IsStrikeCounted() bool {
+	return r.StrikeCounted
+}
+
+func (r *BaseModuleInputCommandResult) SetStrikeCounted(counted bool) {
+	r.StrikeCounted = counted
+}
+
 func (r BaseModuleInputCommandResult) IsSolved() bool {
 	return r.Solved
 }
 
+func (r BaseModuleInputCommandResult) GetRule() *valueobject.RuleExplanation {
+	return r.Rule
+}
+
+func (r *BaseModuleInputCommandResult) SetRule(rule *valueobject.RuleExplanation) {
+	r.Rule = rule
+}
+
 func (c *BaseModuleInputCommand) GetSessionID() uuid.UUID {
 	return c.SessionID
 }
@@ -45,3 +74,7 @@ func (c *BaseModuleInputCommand) GetModuleID() uuid.UUID {
 func (c *BaseModuleInputCommand) GetBombID() uuid.UUID {
 	return c.BombID
 }
+
+func (c *BaseModuleInputCommand) GetPlayerID() string {
+	return c.PlayerID
+}
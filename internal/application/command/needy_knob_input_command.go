@@ -1,6 +1,10 @@
 package command
 
-import "github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+import (
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+)
 
 type NeedyKnobCommand struct {
 	BaseModuleInputCommand
@@ -12,4 +16,6 @@ type NeedyKnobCommandResult struct {
 	DialDirection     valueobject.CardinalDirection
 	CoundownStartedAt int64
 	CountdownDuration int16
+	// Countdown time left in bomb time
+	CountdownRemaining time.Duration
 }
@@ -1,5 +1,7 @@
 package command
 
+import "time"
+
 type NeedyVentGasCommand struct {
 	BaseModuleInputCommand
 	// True for Y, false for N
@@ -11,4 +13,6 @@ type NeedyVentGasCommandResult struct {
 	DisplayedQuestion  string
 	CountdownStartedAt int64
 	CountdownDuration  int16
+	// Countdown time left in bomb time
+	CountdownRemaining time.Duration
 }
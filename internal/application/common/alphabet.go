@@ -2,3 +2,4 @@ package common
 
 const ALPHABET = "abcdefghijklmnopqrstuvwxyz"
 const ALPHABET_UPPERCASE = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+const DIGITS = "0123456789"
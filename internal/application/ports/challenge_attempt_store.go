@@ -0,0 +1,9 @@
+package ports
+
+type ChallengeAttemptStore interface {
+	// Records that the player started the challenge. Returns
+	// valueobject.ErrChallengeAlreadyAttempted if they already have.
+	Claim(challengeID string, playerID string) error
+	// Forgets an attempt that couldn't be started
+	Release(challengeID string, playerID string)
+}
@@ -0,0 +1,11 @@
+package ports
+
+import "github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+
+type LeaderboardStore interface {
+	// Records a result. Returns valueobject.ErrResultAlreadySubmitted if the session already
+	// has a result on any leaderboard.
+	Add(entry valueobject.LeaderboardEntry) error
+	// Returns up to limit entries on the leaderboard, best first
+	Top(key valueobject.LeaderboardKey, limit int) ([]valueobject.LeaderboardEntry, error)
+}
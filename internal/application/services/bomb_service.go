@@ -26,19 +26,28 @@ func (s *BombService) CreateBombInSession(
 	sessionID uuid.UUID,
 	config valueobject.BombConfig,
 ) (*entities.Bomb, error) {
-	sessionActor, err := s.sessionManager.GetGameSession(sessionID)
-	if err != nil {
-		return nil, err
-	}
-
 	mf := services.NewModuleFactory(rng)
 	bf := services.NewBombFactory(mf)
 	bomb := bf.CreateBomb(rng, config)
 
-	if err := sessionActor.AddBomb(bomb); err != nil {
-		log.Printf("error adding bomb to session: %v", err)
+	if err := s.AddBombToSession(sessionID, bomb); err != nil {
 		return nil, err
 	}
 
 	return bomb, nil
 }
+
+// Adds a bomb that was already built, such as one imported from a bomb code.
+func (s *BombService) AddBombToSession(sessionID uuid.UUID, bomb *entities.Bomb) error {
+	sessionActor, err := s.sessionManager.GetGameSession(sessionID)
+	if err != nil {
+		return err
+	}
+
+	if err := sessionActor.AddBomb(bomb); err != nil {
+		log.Printf("error adding bomb to session: %v", err)
+		return err
+	}
+
+	return nil
+}
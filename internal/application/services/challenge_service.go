@@ -0,0 +1,122 @@
+package services
+
+import (
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/actors"
+	"github.com/ZaneH/defuse.party-go/internal/application/command"
+	"github.com/ZaneH/defuse.party-go/internal/application/ports"
+	"github.com/ZaneH/defuse.party-go/internal/domain/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+const (
+	// Player IDs a client can be issued within playerIDWindow
+	playerIDsPerClient = 3
+	playerIDWindow     = 24 * time.Hour
+)
+
+type ChallengeService struct {
+	gameService *GameService
+	// Keeps upcoming challenge seeds from being guessed and signs player IDs
+	secret   []byte
+	attempts ports.ChallengeAttemptStore
+
+	mu sync.Mutex
+	// When each client was issued its player IDs within the last playerIDWindow
+	issued map[string][]time.Time
+}
+
+func NewChallengeService(gameService *GameService, secret []byte, attempts ports.ChallengeAttemptStore) *ChallengeService {
+	return &ChallengeService{
+		gameService: gameService,
+		secret:      secret,
+		attempts:    attempts,
+		issued:      make(map[string][]time.Time),
+	}
+}
+
+// Returns the challenge for the period containing t. Challenges that haven't started yet
+// aren't revealed.
+func (s *ChallengeService) GetChallenge(period valueobject.ChallengePeriod, t time.Time) (valueobject.Challenge, error) {
+	challenge, err := services.NewChallenge(s.secret, period, t)
+	if err != nil {
+		return valueobject.Challenge{}, err
+	}
+
+	if challenge.StartsAt.After(time.Now()) {
+		return valueobject.Challenge{}, valueobject.ErrChallengeNotStarted
+	}
+
+	return challenge, nil
+}
+
+// Issues a new player ID to the client, identified by its address. Only IDs issued here
+// can attempt a challenge, and a client only gets a few a day, so attempts can't be
+// practised on throwaway IDs.
+func (s *ChallengeService) RegisterPlayer(client string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for other, times := range s.issued {
+		times = slices.DeleteFunc(times, func(issuedAt time.Time) bool {
+			return now.Sub(issuedAt) >= playerIDWindow
+		})
+		if len(times) == 0 {
+			delete(s.issued, other)
+		} else {
+			s.issued[other] = times
+		}
+	}
+
+	if len(s.issued[client]) >= playerIDsPerClient {
+		return "", valueobject.ErrTooManyChallengePlayers
+	}
+	s.issued[client] = append(s.issued[client], now)
+
+	return services.SignChallengePlayerID(s.secret, uuid.NewString()), nil
+}
+
+// Fills in the command's current challenge without using up an attempt.
+func (s *ChallengeService) ResolveChallenge(cmd *command.CreateGameCommand) error {
+	challenge, err := s.GetChallenge(cmd.ChallengePeriod, time.Now())
+	if err != nil {
+		return err
+	}
+
+	cmd.Challenge = &challenge
+	return nil
+}
+
+// Starts the player's only attempt at the current challenge.
+func (s *ChallengeService) StartChallenge(cmd *command.CreateGameCommand) (*actors.GameSessionActor, *command.CreateGameCommandResult, error) {
+	if err := valueobject.ValidatePlayerID(cmd.PlayerID); err != nil {
+		return nil, nil, valueobject.ValidationErrors{*err}
+	}
+
+	playerID := strings.TrimSpace(cmd.PlayerID)
+	if !services.VerifyChallengePlayerID(s.secret, playerID) {
+		return nil, nil, valueobject.ValidationErrors{{Field: "player_id", Message: "player ID wasn't issued by this server"}}
+	}
+
+	if err := s.ResolveChallenge(cmd); err != nil {
+		return nil, nil, err
+	}
+
+	if err := s.attempts.Claim(cmd.Challenge.ID, playerID); err != nil {
+		return nil, nil, err
+	}
+
+	session, result, err := s.gameService.CreateGameSession(cmd)
+	if err != nil {
+		s.attempts.Release(cmd.Challenge.ID, playerID)
+		return nil, nil, err
+	}
+
+	return session, result, nil
+}
@@ -0,0 +1,151 @@
+package services_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/application/command"
+	"github.com/ZaneH/defuse.party-go/internal/application/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/challenge"
+	"github.com/stretchr/testify/assert"
+)
+
+func newChallengeService(secret string) *services.ChallengeService {
+	return services.NewChallengeService(newGameService(), []byte(secret), challenge.NewMemoryAttemptStore())
+}
+
+func registerPlayer(t *testing.T, challengeService *services.ChallengeService, client string) string {
+	playerID, err := challengeService.RegisterPlayer(client)
+	assert.NoError(t, err)
+	return playerID
+}
+
+func TestChallengeService_ChallengeIsDerivedFromDateAndSecret(t *testing.T) {
+	// Arrange
+	day := time.Date(2024, 3, 14, 15, 0, 0, 0, time.UTC)
+
+	// Act
+	first, err := newChallengeService("secret").GetChallenge(valueobject.ChallengePeriodWeekly, day)
+	assert.NoError(t, err)
+	again, err := newChallengeService("secret").GetChallenge(valueobject.ChallengePeriodWeekly, day.AddDate(0, 0, 2))
+	assert.NoError(t, err)
+	otherSecret, err := newChallengeService("other").GetChallenge(valueobject.ChallengePeriodWeekly, day)
+	assert.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, "weekly:2024-W11", first.ID)
+	assert.Equal(t, time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), first.StartsAt, "Weekly challenges start on Monday")
+	assert.Equal(t, first, again, "Every day of the week should get the same challenge")
+	assert.NotEqual(t, first.Seed, otherSecret.Seed, "The seed should depend on the secret")
+	assert.GreaterOrEqual(t, first.Level, 6)
+	assert.LessOrEqual(t, first.Level, 10)
+
+	_, err = newChallengeService("secret").GetChallenge(valueobject.ChallengePeriodDaily, time.Now().AddDate(0, 0, 1))
+	assert.ErrorIs(t, err, valueobject.ErrChallengeNotStarted, "Upcoming challenges shouldn't be revealed")
+}
+
+func TestChallengeService_OneAttemptPerPlayer(t *testing.T) {
+	// Arrange
+	challengeService := newChallengeService("secret")
+	start := func(playerID string) (*command.CreateGameCommandResult, error) {
+		_, result, err := challengeService.StartChallenge(&command.CreateGameCommand{
+			ConfigType: command.ConfigTypeChallenge,
+			PlayerID:   playerID,
+			// Ignored: everyone plays the challenge's seed
+			Seed: playerID,
+		})
+		return result, err
+	}
+	alice := registerPlayer(t, challengeService, "10.0.0.1")
+	bob := registerPlayer(t, challengeService, "10.0.0.2")
+
+	// Act
+	aliceResult, err := start(alice)
+	assert.NoError(t, err)
+	bobResult, err := start(bob)
+	assert.NoError(t, err)
+	_, retryErr := start(" " + alice + " ")
+
+	// Assert
+	assert.ErrorIs(t, retryErr, valueobject.ErrChallengeAlreadyAttempted)
+	assert.Equal(t, aliceResult.ConfigHash, bobResult.ConfigHash, "Every player should get the same config")
+	assert.Equal(t, aliceResult.ModuleCounts, bobResult.ModuleCounts, "Every player should get the same bomb")
+}
+
+func TestChallengeService_OnlyIssuedPlayerIDsCanAttempt(t *testing.T) {
+	// Arrange
+	challengeService := newChallengeService("secret")
+	issued := registerPlayer(t, challengeService, "10.0.0.1")
+	otherServer := registerPlayer(t, newChallengeService("other"), "10.0.0.1")
+	tampered := []byte(issued)
+	tampered[len(tampered)-1] ^= 1
+
+	for _, playerID := range []string{
+		"alice",
+		string(tampered),
+		"someone-else" + issued[strings.Index(issued, "."):],
+		otherServer,
+	} {
+		// Act
+		_, _, err := challengeService.StartChallenge(&command.CreateGameCommand{
+			ConfigType: command.ConfigTypeChallenge,
+			PlayerID:   playerID,
+		})
+
+		// Assert
+		var validationErrs valueobject.ValidationErrors
+		if assert.ErrorAs(t, err, &validationErrs, playerID) && assert.Len(t, validationErrs, 1) {
+			assert.Equal(t, "player_id", validationErrs[0].Field)
+		}
+	}
+
+	_, _, err := challengeService.StartChallenge(&command.CreateGameCommand{
+		ConfigType: command.ConfigTypeChallenge,
+		PlayerID:   issued,
+	})
+	assert.NoError(t, err, "Rejected attempts shouldn't use up the player's attempt")
+}
+
+func TestChallengeService_PlayerIDsAreIssuedSparingly(t *testing.T) {
+	// Arrange
+	challengeService := newChallengeService("secret")
+	var errs []error
+
+	// Act
+	for range 4 {
+		_, err := challengeService.RegisterPlayer("10.0.0.1")
+		errs = append(errs, err)
+	}
+	_, otherClientErr := challengeService.RegisterPlayer("10.0.0.2")
+
+	// Assert
+	assert.Equal(t, []error{nil, nil, nil, valueobject.ErrTooManyChallengePlayers}, errs)
+	assert.NoError(t, otherClientErr, "Each client should have its own limit")
+}
+
+func TestChallengeService_SeedStaysOnTheServer(t *testing.T) {
+	// Arrange
+	gameService := newGameService()
+	challengeService := services.NewChallengeService(gameService, []byte("secret"), challenge.NewMemoryAttemptStore())
+	cmd := &command.CreateGameCommand{
+		ConfigType: command.ConfigTypeChallenge,
+		PlayerID:   registerPlayer(t, challengeService, "10.0.0.1"),
+	}
+
+	// Act
+	_, describeErr := gameService.DescribeConfig(cmd)
+	session, result, err := challengeService.StartChallenge(cmd)
+	assert.NoError(t, err)
+	report, reportErr := gameService.GetGameReport(context.Background(), session.GetSessionID())
+
+	// Assert
+	assert.ErrorIs(t, describeErr, valueobject.ErrChallengeNotDescribable)
+	assert.Empty(t, result.Seed)
+	assert.Empty(t, result.BombCodes, "Bomb codes carry the seed")
+	if assert.NoError(t, reportErr) {
+		assert.Empty(t, report.Seed)
+	}
+}
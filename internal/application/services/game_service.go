@@ -3,11 +3,18 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/ZaneH/defuse.party-go/internal/actors"
 	"github.com/ZaneH/defuse.party-go/internal/application/command"
+	"github.com/ZaneH/defuse.party-go/internal/application/ports"
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	dPorts "github.com/ZaneH/defuse.party-go/internal/domain/ports"
 	"github.com/ZaneH/defuse.party-go/internal/domain/services"
 	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
 	"github.com/google/uuid"
@@ -16,68 +23,444 @@ import (
 type GameService struct {
 	actorSystem *actors.ActorSystem
 	bombService *BombService
+	missions    *valueobject.MissionCatalog
+	leaderboard ports.LeaderboardStore
 }
 
-func NewGameService(actorSystem *actors.ActorSystem, bombService *BombService) *GameService {
-	return &GameService{actorSystem: actorSystem, bombService: bombService}
+func NewGameService(actorSystem *actors.ActorSystem, bombService *BombService, missions *valueobject.MissionCatalog, leaderboard ports.LeaderboardStore) *GameService {
+	return &GameService{actorSystem: actorSystem, bombService: bombService, missions: missions, leaderboard: leaderboard}
 }
 
-func (s *GameService) CreateGameSession(cmd *command.CreateGameCommand) (*actors.GameSessionActor, valueobject.BombConfig, error) {
-	var config valueobject.GameSessionConfig
-	var err error
+func (s *GameService) CreateGameSession(cmd *command.CreateGameCommand) (*actors.GameSessionActor, *command.CreateGameCommandResult, error) {
+	config, err := s.resolveSessionConfig(cmd)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rng := services.NewSeededRNGFromString(config.Seed())
+	session, err := s.actorSystem.CreateGameSession(rng, config)
+
+	if err != nil {
+		log.Printf("error creating game session: %v", err)
+		return nil, nil, errors.New("failed to create game session")
+	}
+
+	result := &command.CreateGameCommandResult{
+		SessionID:        session.GetSessionID(),
+		Seed:             config.Seed(),
+		ConfigHash:       config.ConfigHash(),
+		GeneratorVersion: config.GeneratorVersion,
+		NumBombs:         len(config.BombConfigs),
+		BombMode:         config.BombMode,
+		ModuleCounts:     make(map[valueobject.ModuleType]int),
+	}
+
+	bombs, err := s.buildBombs(rng, cmd, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, bomb := range bombs {
+		// Export before the bomb is added to the session and can be played
+		code, err := services.EncodeBombCode(bomb, config.Seed())
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to export bomb code: %w", err)
+		}
+		result.BombCodes = append(result.BombCodes, code)
+
+		if err := s.bombService.AddBombToSession(session.GetSessionID(), bomb); err != nil {
+			return nil, nil, errors.New("failed to create bomb in session")
+		}
+
+		for _, module := range bomb.Modules {
+			if module.GetType() != valueobject.ClockModule {
+				result.ModuleCounts[module.GetType()]++
+			}
+		}
+	}
+
+	if len(config.BombConfigs) > 0 {
+		result.Config = config.BombConfigs[0]
+	}
+
+	if config.SeedHidden {
+		// Either would let the bombs be rebuilt outside the session
+		result.Seed = ""
+		result.BombCodes = nil
+	}
+
+	return session, result, nil
+}
+
+// Builds the session's bombs: generated from the config by the bomb factory, or rebuilt
+// from the command's bomb codes.
+func (s *GameService) buildBombs(rng dPorts.RandomGenerator, cmd *command.CreateGameCommand, config valueobject.GameSessionConfig) ([]*entities.Bomb, error) {
+	bombs := make([]*entities.Bomb, 0, len(config.BombConfigs))
+
+	if cmd.ConfigType == command.ConfigTypeBombCode {
+		for _, code := range cmd.BombCodes {
+			bomb, _, err := services.DecodeBombCode(code)
+			if err != nil {
+				return nil, err
+			}
+			bombs = append(bombs, bomb)
+		}
+		return bombs, nil
+	}
+
+	for _, c := range config.BombConfigs {
+		generator, err := services.NewBombGenerator(config.GeneratorVersion, rng)
+		if err != nil {
+			return nil, err
+		}
+		bombs = append(bombs, generator.CreateBomb(rng, c))
+	}
+
+	return bombs, nil
+}
 
+// Resolves the command into the session config CreateGameSession would use.
+func (s *GameService) resolveSessionConfig(cmd *command.CreateGameCommand) (valueobject.GameSessionConfig, error) {
+	version, err := valueobject.ResolveGeneratorVersion(cmd.GeneratorVersion)
+	if err != nil {
+		return valueobject.GameSessionConfig{}, err
+	}
+
+	if errs := valueobject.ValidateModifiers(cmd.Modifiers); errs.HasErrors() {
+		return valueobject.GameSessionConfig{}, errs
+	}
+	if cmd.ConfigType == command.ConfigTypeChallenge && len(cmd.Modifiers) > 0 {
+		return valueobject.GameSessionConfig{}, valueobject.ValidationErrors{{Field: "modifiers", Message: "challenges are played without modifiers"}}
+	}
+
+	config, err := s.resolveConfigType(cmd)
+	if err != nil {
+		return valueobject.GameSessionConfig{}, err
+	}
+
+	if err := valueobject.ValidateSpectatorDelay(cmd.SpectatorDelay); err != nil {
+		return valueobject.GameSessionConfig{}, valueobject.ValidationErrors{*err}
+	}
+
+	config.GeneratorVersion = version
+	if cmd.DisablePause {
+		config.PauseDisabled = true
+	}
+	config.SpectatorDelay = cmd.SpectatorDelay
+	config.StartedByMatch = cmd.StartedByMatch
+	config.Modifiers = slices.Clone(cmd.Modifiers)
+	return config, nil
+}
+
+func (s *GameService) resolveConfigType(cmd *command.CreateGameCommand) (valueobject.GameSessionConfig, error) {
 	switch cmd.ConfigType {
 	case command.ConfigTypeLevel:
-		config, err = valueobject.NewGameSessionConfigFromLevel(cmd.Seed, cmd.Level)
+		return valueobject.NewGameSessionConfigFromLevel(cmd.Seed, cmd.Level)
 	case command.ConfigTypeMission:
-		config, err = valueobject.NewGameSessionConfigFromMission(cmd.Seed, cmd.Mission)
+		config, err := s.resolveMissionConfig(cmd)
+		if err != nil || cmd.NumBombs == 0 {
+			return config, err
+		}
+		return config.WithBombCount(cmd.NumBombs, cmd.BombMode)
 	case command.ConfigTypeCustom:
 		if cmd.CustomConfig == nil {
-			return nil, valueobject.BombConfig{}, errors.New("custom config required for custom config type")
+			return valueobject.GameSessionConfig{}, errors.New("custom config required for custom config type")
+		}
+		return valueobject.NewGameSessionConfigFromCustom(cmd.Seed, *cmd.CustomConfig, max(cmd.NumBombs, 1), cmd.BombMode)
+	case command.ConfigTypePractice:
+		return valueobject.NewGameSessionConfigForPractice(cmd.Seed, cmd.PracticeModule)
+	case command.ConfigTypeBombCode:
+		return resolveBombCodeConfig(cmd)
+	case command.ConfigTypeChallenge:
+		if cmd.Challenge == nil {
+			return valueobject.GameSessionConfig{}, errors.New("challenge must be resolved before creating a challenge game")
 		}
-		config, err = valueobject.NewGameSessionConfigFromCustom(cmd.Seed, *cmd.CustomConfig)
+		return valueobject.NewGameSessionConfigForChallenge(*cmd.Challenge)
 	default:
 		// Default to easy (level 1)
-		config = valueobject.NewEasyGameSessionConfig(cmd.Seed)
+		return valueobject.NewEasyGameSessionConfig(cmd.Seed), nil
 	}
+}
 
+func (s *GameService) resolveMissionConfig(cmd *command.CreateGameCommand) (valueobject.GameSessionConfig, error) {
+	if cmd.MissionID != "" {
+		mission, err := s.missions.Get(cmd.MissionID)
+		if err != nil {
+			return valueobject.GameSessionConfig{}, err
+		}
+		if mission.IsBuiltIn() {
+			return valueobject.NewGameSessionConfigFromMission(cmd.Seed, mission.Mission)
+		}
+		config := valueobject.NewGameSessionConfigFromMissionDefinition(cmd.Seed, mission.Definition)
+		config.Leaderboard = valueobject.MissionPackLeaderboardKey(mission.ID)
+		return config, nil
+	}
+	return valueobject.NewGameSessionConfigFromMission(cmd.Seed, cmd.Mission)
+}
+
+// Describes the bombs in the command's codes. The session plays with the first code's seed.
+func resolveBombCodeConfig(cmd *command.CreateGameCommand) (valueobject.GameSessionConfig, error) {
+	if len(cmd.BombCodes) == 0 {
+		return valueobject.GameSessionConfig{}, valueobject.ValidationErrors{{Field: "bomb_codes", Message: "at least one bomb code is required"}}
+	}
+
+	var seed string
+	bombConfigs := make([]valueobject.BombConfig, 0, len(cmd.BombCodes))
+	for i, code := range cmd.BombCodes {
+		bomb, codeSeed, err := services.DecodeBombCode(code)
+		if err != nil {
+			return valueobject.GameSessionConfig{}, fmt.Errorf("bomb code %d: %w", i+1, err)
+		}
+		if i == 0 {
+			seed = codeSeed
+		}
+
+		layout, err := entities.ExportBombLayout(bomb)
+		if err != nil {
+			return valueobject.GameSessionConfig{}, err
+		}
+		bombConfigs = append(bombConfigs, layout.Config())
+	}
+
+	return valueobject.NewGameSessionConfigFromBombCodes(seed, cmd.BombCodes, bombConfigs, cmd.BombMode)
+}
+
+// Resolves the command exactly like CreateGameSession and reports the bombs it would
+// build, without creating a session. Invalid custom configs are reported through the
+// result's ValidationErrors rather than as an error, along with the seed the bombs would
+// have been generated from. Challenges can't be described, as that would show their bombs
+// before an attempt.
+func (s *GameService) DescribeConfig(cmd *command.CreateGameCommand) (*command.DescribeConfigResult, error) {
+	// Resolved up front so an invalid config reports the same seed a fixed one would use
+	if cmd.ConfigType == command.ConfigTypeChallenge {
+		return nil, valueobject.ErrChallengeNotDescribable
+	}
+
+	resolved := *cmd
+	resolved.Seed = valueobject.ResolveSeed(cmd.Seed)
+	cmd = &resolved
+
+	config, err := s.resolveSessionConfig(cmd)
+
+	var validationErrs valueobject.ValidationErrors
+	if errors.As(err, &validationErrs) && cmd.ConfigType == command.ConfigTypeCustom {
+		// Zero if the generator version itself is invalid
+		version, _ := valueobject.ResolveGeneratorVersion(cmd.GeneratorVersion)
+		result := &command.DescribeConfigResult{
+			Seed:             cmd.Seed,
+			BombMode:         cmd.BombMode,
+			GeneratorVersion: version,
+			ValidationErrors: validationErrs,
+		}
+		for range max(cmd.NumBombs, 1) {
+			result.Bombs = append(result.Bombs, command.PlannedBomb{Config: *cmd.CustomConfig})
+		}
+		return result, nil
+	}
 	if err != nil {
-		return nil, valueobject.BombConfig{}, err
+		return nil, err
 	}
 
-	rng := services.NewSeededRNGFromString(config.Seed())
-	session, err := s.actorSystem.CreateGameSession(rng, config)
+	result := &command.DescribeConfigResult{
+		Seed:             config.Seed(),
+		BombMode:         config.BombMode,
+		GeneratorVersion: config.GeneratorVersion,
+	}
+
+	// Imported bombs were already checked when their codes were decoded
+	if cmd.ConfigType != command.ConfigTypeBombCode {
+		for _, c := range config.BombConfigs {
+			result.ValidationErrors = append(result.ValidationErrors, valueobject.ValidateBombConfig(c)...)
+		}
+	}
+	if result.ValidationErrors.HasErrors() {
+		for _, c := range config.BombConfigs {
+			result.Bombs = append(result.Bombs, command.PlannedBomb{Config: c})
+		}
+		return result, nil
+	}
 
+	// Same RNG stream and factory calls as CreateGameSession, so the layout matches
+	rng := services.NewSeededRNGFromString(config.Seed())
+	bombs, err := s.buildBombs(rng, cmd, config)
 	if err != nil {
-		log.Printf("error creating game session: %v", err)
-		return nil, valueobject.BombConfig{}, errors.New("failed to create game session")
+		return nil, err
 	}
 
-	for _, c := range config.BombConfigs {
-		_, err = s.bombService.CreateBombInSession(rng, session.GetSessionID(), c)
+	for i, bomb := range bombs {
+		code, err := services.EncodeBombCode(bomb, config.Seed())
+		if err != nil {
+			return nil, fmt.Errorf("failed to export bomb code: %w", err)
+		}
+
+		modules := make([]command.PlannedModule, 0, len(bomb.Modules))
+		for _, module := range bomb.Modules {
+			modules = append(modules, command.PlannedModule{
+				Type:     module.GetType(),
+				Position: module.GetPosition(),
+			})
+		}
+		sort.Slice(modules, func(i, j int) bool {
+			a, b := modules[i].Position, modules[j].Position
+			if a.Face != b.Face {
+				return a.Face < b.Face
+			}
+			if a.Row != b.Row {
+				return a.Row < b.Row
+			}
+			return a.Column < b.Column
+		})
+
+		result.Bombs = append(result.Bombs, command.PlannedBomb{Config: config.BombConfigs[i], Modules: modules, Code: code})
 	}
 
+	return result, nil
+}
+
+// Returns the built-in missions followed by every mission loaded from mission packs.
+func (s *GameService) ListMissions() []valueobject.CatalogMission {
+	return s.missions.List()
+}
+
+// Returns a copy of the session and its bombs. The live session is owned by its actors, so
+// the copy is taken by them and is safe to read while the game carries on.
+func (s *GameService) GetSessionSnapshot(ctx context.Context, sessionID uuid.UUID) (actors.SessionSnapshot, error) {
+	sessionActor, err := s.actorSystem.GetGameSession(sessionID)
 	if err != nil {
-		return nil, valueobject.BombConfig{}, errors.New("failed to create bomb in session")
+		log.Printf("error retrieving game session: %v", err)
+		return actors.SessionSnapshot{}, errors.New("game session not found")
 	}
 
-	// Return the first bomb config for the response
-	var bombConfig valueobject.BombConfig
-	if len(config.BombConfigs) > 0 {
-		bombConfig = config.BombConfigs[0]
+	respChan := make(chan actors.Response, 1)
+
+	sessionActor.Send(actors.GetBombsMessage{
+		ResponseChannel: respChan,
+	})
+
+	select {
+	case resp := <-respChan:
+		if !resp.IsSuccess() {
+			return actors.SessionSnapshot{}, resp.Error()
+		}
+		return resp.(actors.SuccessResponse).Data.(actors.SessionSnapshot), nil
+
+	case <-time.After(5 * time.Second):
+		return actors.SessionSnapshot{}, errors.New("timeout reading game session")
+
+	case <-ctx.Done():
+		return actors.SessionSnapshot{}, ctx.Err()
+	}
+}
+
+// Adds a player to the session's lobby, or changes their role if they already joined.
+func (s *GameService) JoinLobby(ctx context.Context, sessionID uuid.UUID, playerID string, role valueobject.PlayerRole) (valueobject.Lobby, error) {
+	return s.requestLobby(ctx, sessionID, func(respChan chan actors.Response) actors.Message {
+		return actors.JoinLobbyMessage{PlayerID: playerID, Role: role, ResponseChannel: respChan}
+	})
+}
+
+// Marks a player as ready or not. The game starts as soon as every player in the lobby is
+// ready.
+func (s *GameService) SetPlayerReady(ctx context.Context, sessionID uuid.UUID, playerID string, ready bool) (valueobject.Lobby, error) {
+	return s.requestLobby(ctx, sessionID, func(respChan chan actors.Response) actors.Message {
+		return actors.SetPlayerReadyMessage{PlayerID: playerID, Ready: ready, ResponseChannel: respChan}
+	})
+}
+
+// Hands bomb faces to a defuser, taking them from whoever owned them. Face indices apply to
+// every bomb in the session. The caller has to be the defuser or the session's host.
+func (s *GameService) AssignFaces(ctx context.Context, sessionID uuid.UUID, callerID string, playerID string, faces []int) (valueobject.Lobby, error) {
+	return s.requestLobby(ctx, sessionID, func(respChan chan actors.Response) actors.Message {
+		return actors.AssignFacesMessage{CallerID: callerID, PlayerID: playerID, Faces: faces, ResponseChannel: respChan}
+	})
+}
+
+// Ends the session's lobby and arms its bombs, whether or not every player is ready.
+func (s *GameService) StartGame(ctx context.Context, sessionID uuid.UUID) (valueobject.Lobby, error) {
+	return s.requestLobby(ctx, sessionID, func(respChan chan actors.Response) actors.Message {
+		return actors.StartGameMessage{ResponseChannel: respChan}
+	})
+}
+
+// Pauses every armed bomb in the session: their clocks and needy countdowns are held and
+// input is rejected until the game is resumed.
+func (s *GameService) PauseGame(ctx context.Context, sessionID uuid.UUID) error {
+	_, err := s.askSession(ctx, sessionID, "pausing game", func(respChan chan actors.Response) actors.Message {
+		return actors.PauseGameMessage{ResponseChannel: respChan}
+	})
+	return err
+}
+
+func (s *GameService) ResumeGame(ctx context.Context, sessionID uuid.UUID) error {
+	_, err := s.askSession(ctx, sessionID, "resuming game", func(respChan chan actors.Response) actors.Message {
+		return actors.ResumeGameMessage{ResponseChannel: respChan}
+	})
+	return err
+}
+
+// Shows the bomb's edgework to the players in a game played with
+// valueobject.ModifierEdgeworkOnRequest. Does nothing to bombs whose edgework is shown anyway.
+func (s *GameService) RevealEdgework(ctx context.Context, sessionID uuid.UUID, bombID uuid.UUID) error {
+	_, err := s.askSession(ctx, sessionID, "revealing edgework", func(respChan chan actors.Response) actors.Message {
+		return actors.RevealEdgeworkMessage{BombID: bombID, ResponseChannel: respChan}
+	})
+	return err
+}
+
+// Checks that a player can see the session's live state and pause it. Spectators are turned
+// away, and so is anyone who doesn't say who they are in a session with a spectator delay.
+func (s *GameService) CheckPlaying(ctx context.Context, sessionID uuid.UUID, playerID string) error {
+	_, err := s.askSession(ctx, sessionID, "checking player", func(respChan chan actors.Response) actors.Message {
+		return actors.CheckPlayingMessage{PlayerID: playerID, ResponseChannel: respChan}
+	})
+	return err
+}
+
+// Starts watching a session as a spectator who joined its lobby. The caller must close the
+// watch once it's done with it.
+func (s *GameService) WatchSession(ctx context.Context, sessionID uuid.UUID, playerID string) (*actors.SessionWatch, error) {
+	data, err := s.askSession(ctx, sessionID, "watching session", func(respChan chan actors.Response) actors.Message {
+		return actors.WatchSessionMessage{PlayerID: playerID, ResponseChannel: respChan}
+	})
+	if err != nil {
+		return nil, err
 	}
+	return data.(*actors.SessionWatch), nil
+}
 
-	return session, bombConfig, nil
+func (s *GameService) requestLobby(ctx context.Context, sessionID uuid.UUID, newMsg func(chan actors.Response) actors.Message) (valueobject.Lobby, error) {
+	data, err := s.askSession(ctx, sessionID, "updating lobby", newMsg)
+	if err != nil {
+		return valueobject.Lobby{}, err
+	}
+	return data.(valueobject.Lobby), nil
 }
 
-func (s *GameService) GetGameSession(ctx context.Context, sessionID uuid.UUID) (*actors.GameSessionActor, error) {
+// Sends a message to the session's actor and returns the data it replies with.
+func (s *GameService) askSession(ctx context.Context, sessionID uuid.UUID, action string, newMsg func(chan actors.Response) actors.Message) (interface{}, error) {
 	sessionActor, err := s.actorSystem.GetGameSession(sessionID)
 	if err != nil {
 		log.Printf("error retrieving game session: %v", err)
 		return nil, errors.New("game session not found")
 	}
 
-	return sessionActor, nil
+	respChan := make(chan actors.Response, 1)
+
+	sessionActor.Send(newMsg(respChan))
+
+	select {
+	case resp := <-respChan:
+		if !resp.IsSuccess() {
+			return nil, resp.Error()
+		}
+		return resp.(actors.SuccessResponse).Data, nil
+
+	case <-time.After(5 * time.Second):
+		return nil, fmt.Errorf("timeout %s", action)
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
 }
 
 func (s *GameService) ProcessModuleInput(ctx context.Context, cmd command.ModuleInputCommand) (interface{}, error) {
@@ -108,3 +491,78 @@ func (s *GameService) ProcessModuleInput(ctx context.Context, cmd command.Module
 		return nil, ctx.Err()
 	}
 }
+
+// Returns the session's score and per-module timeline. Works while the session is still in
+// progress; bombs that haven't finished are scored as they stand.
+func (s *GameService) GetGameReport(ctx context.Context, sessionID uuid.UUID) (valueobject.GameReport, error) {
+	sessionActor, err := s.actorSystem.GetGameSession(sessionID)
+	if err != nil {
+		log.Printf("error retrieving game session: %v", err)
+		return valueobject.GameReport{}, errors.New("game session not found")
+	}
+
+	respChan := make(chan actors.Response, 1)
+
+	sessionActor.Send(actors.GetGameReportMessage{
+		ResponseChannel: respChan,
+	})
+
+	select {
+	case resp := <-respChan:
+		if !resp.IsSuccess() {
+			return valueobject.GameReport{}, resp.Error()
+		}
+		return resp.(actors.SuccessResponse).Data.(valueobject.GameReport), nil
+
+	case <-time.After(5 * time.Second):
+		return valueobject.GameReport{}, errors.New("timeout building game report")
+
+	case <-ctx.Done():
+		return valueobject.GameReport{}, ctx.Err()
+	}
+}
+
+// Ranks a session on its leaderboard. The result is read from the session itself, so only
+// sessions the server saw completed can be submitted, and each session only once.
+func (s *GameService) SubmitResult(ctx context.Context, sessionID uuid.UUID, teamName string) (valueobject.LeaderboardEntry, error) {
+	if err := valueobject.ValidateTeamName(teamName); err != nil {
+		return valueobject.LeaderboardEntry{}, valueobject.ValidationErrors{*err}
+	}
+
+	report, err := s.GetGameReport(ctx, sessionID)
+	if err != nil {
+		return valueobject.LeaderboardEntry{}, err
+	}
+
+	if report.State != valueobject.SessionStateCompleted {
+		return valueobject.LeaderboardEntry{}, valueobject.ErrSessionNotCompleted
+	}
+
+	if report.Leaderboard == "" {
+		return valueobject.LeaderboardEntry{}, valueobject.ErrSessionNotRanked
+	}
+
+	entry := valueobject.LeaderboardEntry{
+		SessionID:   sessionID,
+		Leaderboard: report.Leaderboard,
+		TeamName:    strings.TrimSpace(teamName),
+		Seed:        report.Seed,
+		SubmittedAt: time.Now(),
+	}
+	for _, bomb := range report.Bombs {
+		entry.TimeRemaining += bomb.TimeRemaining
+		entry.Strikes += bomb.Strikes
+	}
+
+	if err := s.leaderboard.Add(entry); err != nil {
+		return valueobject.LeaderboardEntry{}, err
+	}
+
+	return entry, nil
+}
+
+// Returns up to limit entries on the leaderboard, best first. A limit of 0 returns every
+// entry.
+func (s *GameService) GetLeaderboard(key valueobject.LeaderboardKey, limit int) ([]valueobject.LeaderboardEntry, error) {
+	return s.leaderboard.Top(key, limit)
+}
@@ -0,0 +1,467 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/actors"
+	"github.com/ZaneH/defuse.party-go/internal/application/command"
+	"github.com/ZaneH/defuse.party-go/internal/application/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/adapters"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/leaderboard"
+	"github.com/stretchr/testify/assert"
+)
+
+func newGameService() *services.GameService {
+	actorSystem := actors.NewActorSystem()
+	bombService := services.NewBombService(adapters.NewActorSystemAdapter(actorSystem))
+	return services.NewGameService(actorSystem, bombService, valueobject.NewMissionCatalog(), leaderboard.NewMemoryStore())
+}
+
+func TestGameService_DescribeConfigMatchesCreatedSession(t *testing.T) {
+	// Arrange
+	gameService := newGameService()
+	cmd := &command.CreateGameCommand{
+		Seed:       "describe",
+		ConfigType: command.ConfigTypeMission,
+		Mission:    valueobject.MissionDoubleTrouble,
+	}
+
+	// Act
+	result, err := gameService.DescribeConfig(cmd)
+	assert.NoError(t, err)
+
+	session, _, err := gameService.CreateGameSession(cmd)
+	assert.NoError(t, err)
+	defer session.Stop()
+
+	// Assert
+	assert.Empty(t, result.ValidationErrors)
+	bombActors := session.GetOrderedBombActors()
+	assert.Len(t, result.Bombs, len(bombActors))
+
+	for i, planned := range result.Bombs {
+		bomb := bombActors[i].GetBomb()
+		assert.Len(t, planned.Modules, len(bomb.Modules))
+
+		for _, module := range bomb.Modules {
+			assert.Contains(t, planned.Modules, command.PlannedModule{
+				Type:     module.GetType(),
+				Position: module.GetPosition(),
+			})
+		}
+	}
+}
+
+func TestGameService_DescribeConfigReportsValidationErrors(t *testing.T) {
+	// Arrange
+	gameService := newGameService()
+	bombConfig := valueobject.NewDefaultBombConfig()
+	bombConfig.MaxStrikes = 0
+	cmd := &command.CreateGameCommand{
+		Seed:         "describe",
+		ConfigType:   command.ConfigTypeCustom,
+		CustomConfig: &bombConfig,
+		NumBombs:     2,
+	}
+
+	// Act
+	result, err := gameService.DescribeConfig(cmd)
+	cmd.Seed = ""
+	unseeded, unseededErr := gameService.DescribeConfig(cmd)
+
+	// Assert
+	assert.NoError(t, err, "Invalid configs should be described, not rejected")
+	assert.NotEmpty(t, result.ValidationErrors)
+	assert.Equal(t, "describe", result.Seed)
+	assert.Equal(t, valueobject.LatestGeneratorVersion, result.GeneratorVersion)
+	if assert.NoError(t, unseededErr) {
+		assert.NotEmpty(t, unseeded.Seed, "A seed should be generated even when the config is invalid")
+	}
+	assert.Len(t, result.Bombs, 2)
+	for _, planned := range result.Bombs {
+		assert.Empty(t, planned.Modules, "Modules shouldn't be planned for an invalid config")
+	}
+}
+
+func TestGameService_CreateGameSessionReportsGeneratedConfig(t *testing.T) {
+	// Arrange
+	gameService := newGameService()
+	cmd := &command.CreateGameCommand{
+		ConfigType: command.ConfigTypeMission,
+		Mission:    valueobject.MissionTheFirstBomb,
+	}
+
+	// Act
+	session, result, err := gameService.CreateGameSession(cmd)
+	assert.NoError(t, err)
+	defer session.Stop()
+
+	// Assert
+	assert.NotEmpty(t, result.Seed, "An empty seed should be replaced with a generated one")
+	assert.Equal(t, "The First Bomb", result.Config.MissionName)
+	assert.Equal(t, 1, result.Config.MissionSection)
+
+	placed := 0
+	for _, count := range result.ModuleCounts {
+		placed += count
+	}
+	bomb := session.GetOrderedBombActors()[0].GetBomb()
+	assert.Equal(t, len(bomb.Modules)-1, placed, "Every module except the clock should be counted")
+
+	// Reusing the seed reproduces the same bomb
+	cmd.Seed = result.Seed
+	replay, replayResult, err := gameService.CreateGameSession(cmd)
+	assert.NoError(t, err)
+	defer replay.Stop()
+	assert.Equal(t, result.ModuleCounts, replayResult.ModuleCounts)
+}
+
+func TestGameService_MissionsCanBePlayedWithMoreBombs(t *testing.T) {
+	// Arrange
+	gameService := newGameService()
+	cmd := &command.CreateGameCommand{
+		ConfigType: command.ConfigTypeMission,
+		Mission:    valueobject.MissionTheFirstBomb,
+		NumBombs:   3,
+		BombMode:   valueobject.BombModeSequential,
+	}
+
+	// Act
+	session, result, err := gameService.CreateGameSession(cmd)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer session.Stop()
+
+	cmd.NumBombs = valueobject.MaxBombs + 1
+	_, _, tooManyErr := gameService.CreateGameSession(cmd)
+
+	// Assert
+	assert.Equal(t, 3, result.NumBombs)
+	assert.Equal(t, valueobject.BombModeSequential, result.BombMode)
+	assert.Len(t, session.GetOrderedBombActors(), 3)
+	assert.Equal(t, "The First Bomb", result.Config.MissionName)
+
+	var validationErrs valueobject.ValidationErrors
+	if assert.ErrorAs(t, tooManyErr, &validationErrs) && assert.Len(t, validationErrs, 1) {
+		assert.Equal(t, "num_bombs", validationErrs[0].Field)
+	}
+}
+
+func TestGameService_ListedMissionsCanBeSelectedByID(t *testing.T) {
+	// Arrange
+	gameService := newGameService()
+	missions := gameService.ListMissions()
+	seen := make(map[string]bool, len(missions))
+
+	for _, mission := range missions {
+		// Act
+		session, result, err := gameService.CreateGameSession(&command.CreateGameCommand{
+			ConfigType: command.ConfigTypeMission,
+			MissionID:  mission.ID,
+		})
+
+		// Assert
+		assert.False(t, seen[mission.ID], "Mission IDs should be unique")
+		seen[mission.ID] = true
+		if assert.NoError(t, err, mission.ID) {
+			session.Stop()
+			assert.Equal(t, mission.Definition.Name, result.Config.MissionName)
+		}
+	}
+	assert.Equal(t, "builtin/whos-on-first", valueobject.BuiltInMissionID(valueobject.MissionWhosOnFirstChallenge))
+}
+
+func TestGameService_PracticeConfig(t *testing.T) {
+	// Arrange
+	gameService := newGameService()
+
+	// Act
+	result, err := gameService.DescribeConfig(&command.CreateGameCommand{
+		ConfigType:     command.ConfigTypePractice,
+		PracticeModule: valueobject.WhosOnFirstModule,
+	})
+	_, needyErr := gameService.DescribeConfig(&command.CreateGameCommand{
+		ConfigType:     command.ConfigTypePractice,
+		PracticeModule: valueobject.NeedyKnobModule,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Empty(t, result.ValidationErrors)
+	if assert.Len(t, result.Bombs, 1) {
+		assert.ElementsMatch(t,
+			[]valueobject.ModuleType{valueobject.ClockModule, valueobject.WhosOnFirstModule},
+			[]valueobject.ModuleType{result.Bombs[0].Modules[0].Type, result.Bombs[0].Modules[1].Type},
+		)
+	}
+	assert.Error(t, needyErr, "Needy modules can't be practiced")
+}
+
+func TestGameService_SubmitResultOnlyAcceptsCompletedSessions(t *testing.T) {
+	// Arrange
+	gameService := newGameService()
+	session, result, err := gameService.CreateGameSession(&command.CreateGameCommand{
+		Seed:         "leaderboard",
+		ConfigType:   command.ConfigTypeLevel,
+		Level:        2,
+		DisablePause: true,
+	})
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	// Act & Assert: still in the lobby, then with the bomb armed
+	_, err = gameService.SubmitResult(ctx, result.SessionID, "Team Rocket")
+	assert.ErrorIs(t, err, valueobject.ErrSessionNotCompleted, "Sessions in the lobby shouldn't be accepted")
+
+	_, err = gameService.StartGame(ctx, result.SessionID)
+	assert.NoError(t, err)
+	_, err = gameService.SubmitResult(ctx, result.SessionID, "Team Rocket")
+	assert.ErrorIs(t, err, valueobject.ErrSessionNotCompleted, "In progress sessions shouldn't be accepted")
+
+	for _, bombActor := range session.GetOrderedBombActors() {
+		for _, module := range bombActor.GetBomb().Modules {
+			if state := module.GetModuleState(); state != nil {
+				state.MarkAsSolved()
+			}
+		}
+	}
+
+	entry, err := gameService.SubmitResult(ctx, result.SessionID, "  Team Rocket ")
+	assert.NoError(t, err)
+	assert.Equal(t, "Team Rocket", entry.TeamName)
+	assert.Equal(t, valueobject.LevelLeaderboardKey(2), entry.Leaderboard)
+	assert.Equal(t, "leaderboard", entry.Seed)
+
+	_, err = gameService.SubmitResult(ctx, result.SessionID, "Team Rocket")
+	assert.ErrorIs(t, err, valueobject.ErrResultAlreadySubmitted, "A session can only be submitted once")
+
+	entries, err := gameService.GetLeaderboard(valueobject.LevelLeaderboardKey(2), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []valueobject.LeaderboardEntry{entry}, entries)
+}
+
+func TestGameService_SessionsThatCanBePausedArentRanked(t *testing.T) {
+	// Arrange
+	gameService := newGameService()
+	session, result, err := gameService.CreateGameSession(&command.CreateGameCommand{
+		Seed:       "pausable",
+		ConfigType: command.ConfigTypeLevel,
+		Level:      2,
+	})
+	assert.NoError(t, err)
+	ctx := context.Background()
+	_, err = gameService.StartGame(ctx, result.SessionID)
+	assert.NoError(t, err)
+
+	for _, bombActor := range session.GetOrderedBombActors() {
+		for _, module := range bombActor.GetBomb().Modules {
+			if state := module.GetModuleState(); state != nil {
+				state.MarkAsSolved()
+			}
+		}
+	}
+
+	// Act
+	_, err = gameService.SubmitResult(ctx, result.SessionID, "Team Rocket")
+
+	// Assert
+	assert.ErrorIs(t, err, valueobject.ErrSessionNotRanked)
+}
+
+func TestGameService_LobbyStartsOnceEveryoneIsReady(t *testing.T) {
+	// Arrange
+	gameService := newGameService()
+	session, result, err := gameService.CreateGameSession(&command.CreateGameCommand{
+		Seed:       "lobby",
+		ConfigType: command.ConfigTypeLevel,
+		Level:      1,
+	})
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = gameService.JoinLobby(ctx, result.SessionID, "defuser", valueobject.PlayerRoleDefuser)
+	assert.NoError(t, err)
+	_, err = gameService.JoinLobby(ctx, result.SessionID, "expert", valueobject.PlayerRoleExpert)
+	assert.NoError(t, err)
+
+	// Act
+	firstReady, err := gameService.SetPlayerReady(ctx, result.SessionID, "defuser", true)
+	assert.NoError(t, err)
+	snapshot, err := gameService.GetSessionSnapshot(ctx, result.SessionID)
+	assert.NoError(t, err)
+	allReady, err := gameService.SetPlayerReady(ctx, result.SessionID, "expert", true)
+	assert.NoError(t, err)
+
+	// Assert
+	assert.False(t, firstReady.Started, "The game should wait for every player")
+	assert.Equal(t, valueobject.SessionStateLobby, snapshot.State)
+	assert.Equal(t, valueobject.BombStateWaiting, snapshot.Bombs[0].State, "Bombs shouldn't be armed in the lobby")
+	assert.True(t, allReady.Started, "The game should start once everyone is ready")
+	assert.Equal(t, []valueobject.LobbyPlayer{
+		{PlayerID: "defuser", Role: valueobject.PlayerRoleDefuser, Ready: true},
+		{PlayerID: "expert", Role: valueobject.PlayerRoleExpert, Ready: true},
+	}, allReady.Players)
+	assert.Equal(t, valueobject.BombStateArmed, session.GetOrderedBombActors()[0].GetBomb().GetState())
+
+	_, err = gameService.JoinLobby(ctx, result.SessionID, "late", valueobject.PlayerRoleExpert)
+	assert.ErrorIs(t, err, valueobject.ErrGameAlreadyStarted, "Players can't join a started game")
+	_, err = gameService.StartGame(ctx, result.SessionID)
+	assert.ErrorIs(t, err, valueobject.ErrGameAlreadyStarted)
+}
+
+func TestGameService_PauseGame(t *testing.T) {
+	// Arrange
+	gameService := newGameService()
+	ctx := context.Background()
+	_, casual, err := gameService.CreateGameSession(&command.CreateGameCommand{Seed: "pause", ConfigType: command.ConfigTypeLevel, Level: 1})
+	assert.NoError(t, err)
+	_, competitive, err := gameService.CreateGameSession(&command.CreateGameCommand{Seed: "pause", ConfigType: command.ConfigTypeLevel, Level: 1, DisablePause: true})
+	assert.NoError(t, err)
+
+	// Act & Assert
+	assert.ErrorIs(t, gameService.PauseGame(ctx, casual.SessionID), valueobject.ErrGameNotStarted, "A game in the lobby has nothing to pause")
+
+	_, err = gameService.StartGame(ctx, casual.SessionID)
+	assert.NoError(t, err)
+	assert.NoError(t, gameService.PauseGame(ctx, casual.SessionID))
+	assert.ErrorIs(t, gameService.PauseGame(ctx, casual.SessionID), valueobject.ErrGamePaused)
+
+	snapshot, err := gameService.GetSessionSnapshot(ctx, casual.SessionID)
+	assert.NoError(t, err)
+	assert.True(t, snapshot.Paused)
+	assert.Equal(t, valueobject.BombStatePaused, snapshot.Bombs[0].State)
+
+	assert.NoError(t, gameService.ResumeGame(ctx, casual.SessionID))
+	assert.ErrorIs(t, gameService.ResumeGame(ctx, casual.SessionID), valueobject.ErrGameNotPaused)
+
+	_, err = gameService.StartGame(ctx, competitive.SessionID)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, gameService.PauseGame(ctx, competitive.SessionID), valueobject.ErrPauseDisabled, "Competitive sessions can't be paused")
+}
+
+func TestGameService_SpectatorDelayIsLimited(t *testing.T) {
+	// Arrange
+	gameService := newGameService()
+	cmd := &command.CreateGameCommand{
+		Seed:           "spectators",
+		ConfigType:     command.ConfigTypeLevel,
+		Level:          1,
+		SpectatorDelay: valueobject.MaxSpectatorDelay + time.Second,
+	}
+
+	// Act
+	_, _, err := gameService.CreateGameSession(cmd)
+
+	// Assert
+	var validationErrs valueobject.ValidationErrors
+	if assert.ErrorAs(t, err, &validationErrs) && assert.Len(t, validationErrs, 1) {
+		assert.Equal(t, "spectator_delay_seconds", validationErrs[0].Field)
+	}
+}
+
+func TestGameService_ModifiersApplyToEveryBomb(t *testing.T) {
+	// Arrange
+	gameService := newGameService()
+	modifiers := []valueobject.Modifier{valueobject.ModifierHiddenTimer, valueobject.ModifierTimeMode}
+	bombConfig := valueobject.NewDefaultBombConfig()
+	cmd := &command.CreateGameCommand{
+		Seed:         "modifiers",
+		ConfigType:   command.ConfigTypeCustom,
+		CustomConfig: &bombConfig,
+		NumBombs:     2,
+		Modifiers:    modifiers,
+	}
+
+	// Act
+	session, _, err := gameService.CreateGameSession(cmd)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer session.Stop()
+	snapshot, err := gameService.GetSessionSnapshot(context.Background(), session.GetSessionID())
+
+	// Assert
+	if assert.NoError(t, err) && assert.Len(t, snapshot.Bombs, 2) {
+		for _, bomb := range snapshot.Bombs {
+			assert.Equal(t, modifiers, bomb.Modifiers)
+		}
+	}
+}
+
+func TestGameService_ModifiersAreValidated(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		cmd       command.CreateGameCommand
+		modifiers []valueobject.Modifier
+	}{
+		{
+			name:      "unknown",
+			modifiers: []valueobject.Modifier{0},
+		},
+		{
+			name:      "repeated",
+			modifiers: []valueobject.Modifier{valueobject.ModifierZen, valueobject.ModifierZen},
+		},
+		{
+			name:      "conflicting",
+			modifiers: []valueobject.Modifier{valueobject.ModifierZen, valueobject.ModifierTimeMode},
+		},
+		{
+			name:      "challenge",
+			cmd:       command.CreateGameCommand{ConfigType: command.ConfigTypeChallenge},
+			modifiers: []valueobject.Modifier{valueobject.ModifierHiddenStrikes},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			gameService := newGameService()
+			cmd := tc.cmd
+			cmd.Modifiers = tc.modifiers
+
+			// Act
+			_, _, err := gameService.CreateGameSession(&cmd)
+
+			// Assert
+			var validationErrs valueobject.ValidationErrors
+			if assert.ErrorAs(t, err, &validationErrs) && assert.Len(t, validationErrs, 1) {
+				assert.Equal(t, "modifiers", validationErrs[0].Field)
+			}
+		})
+	}
+}
+
+func TestGameService_BombCodesRecreateSession(t *testing.T) {
+	// Arrange
+	gameService := newGameService()
+	_, original, err := gameService.CreateGameSession(&command.CreateGameCommand{
+		Seed:       "export",
+		ConfigType: command.ConfigTypeLevel,
+		Level:      6,
+	})
+	assert.NoError(t, err)
+
+	// Act
+	session, imported, err := gameService.CreateGameSession(&command.CreateGameCommand{
+		ConfigType: command.ConfigTypeBombCode,
+		BombCodes:  original.BombCodes,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, original.BombCodes, 1)
+	assert.Equal(t, original.BombCodes, imported.BombCodes, "Imported bombs should export to the same codes")
+	assert.Equal(t, original.ModuleCounts, imported.ModuleCounts)
+	assert.Equal(t, "export", imported.Seed, "The session should play with the code's seed")
+	assert.Equal(t, original.Config.Timer, imported.Config.Timer)
+	assert.Len(t, session.GetOrderedBombActors(), 1)
+
+	_, _, err = gameService.CreateGameSession(&command.CreateGameCommand{
+		ConfigType: command.ConfigTypeBombCode,
+		BombCodes:  []string{"garbage"},
+	})
+	assert.ErrorIs(t, err, valueobject.ErrInvalidBombCode)
+}
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/actors"
+	"github.com/ZaneH/defuse.party-go/internal/application/command"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+type MatchService struct {
+	gameService *GameService
+}
+
+func NewMatchService(gameService *GameService) *MatchService {
+	return &MatchService{gameService: gameService}
+}
+
+// Creates a session for each team from the same resolved config and seed, so that every
+// team plays identical bombs, and a match to race them. The sessions wait in their lobbies
+// until every team is ready or the match is started.
+func (s *MatchService) CreateMatch(cmd *command.CreateMatchCommand) (*command.CreateMatchCommandResult, error) {
+	if errs := valueobject.ValidateMatchTeams(cmd.Teams); errs.HasErrors() {
+		return nil, errs
+	}
+	if cmd.Game.ConfigType == command.ConfigTypeChallenge {
+		return nil, valueobject.ErrMatchChallenges
+	}
+
+	gameCmd := cmd.Game
+	config, err := s.gameService.resolveSessionConfig(&gameCmd)
+	if err != nil {
+		return nil, err
+	}
+	// An empty seed would give every team a different random one
+	gameCmd.Seed = config.Seed()
+	gameCmd.DisablePause = true
+	gameCmd.StartedByMatch = true
+
+	teams := make([]string, 0, len(cmd.Teams))
+	sessions := make([]*actors.GameSessionActor, 0, len(cmd.Teams))
+	var gameResult *command.CreateGameCommandResult
+	for _, team := range cmd.Teams {
+		session, result, err := s.gameService.CreateGameSession(&gameCmd)
+		if err != nil {
+			s.stopSessions(sessions)
+			return nil, err
+		}
+
+		teams = append(teams, strings.TrimSpace(team))
+		sessions = append(sessions, session)
+		if gameResult == nil {
+			gameResult = result
+		}
+	}
+
+	match, err := s.gameService.actorSystem.CreateMatch(teams, sessions)
+	if err != nil {
+		log.Printf("error creating match: %v", err)
+		s.stopSessions(sessions)
+		return nil, errors.New("failed to create match")
+	}
+
+	return &command.CreateMatchCommandResult{
+		MatchID: match.GetMatchID(),
+		Teams:   teams,
+		Game:    gameResult,
+	}, nil
+}
+
+func (s *MatchService) stopSessions(sessions []*actors.GameSessionActor) {
+	for _, session := range sessions {
+		if err := s.gameService.actorSystem.StopGameSession(session.GetSessionID()); err != nil {
+			log.Printf("error stopping session %v: %v", session.GetSessionID(), err)
+		}
+	}
+}
+
+// Starts every team's session at once, whether or not the teams are ready.
+func (s *MatchService) StartMatch(ctx context.Context, matchID uuid.UUID) (valueobject.Scoreboard, error) {
+	return s.requestScoreboard(ctx, matchID, "starting match", func(respChan chan actors.Response) actors.Message {
+		return actors.StartMatchMessage{ResponseChannel: respChan}
+	})
+}
+
+// Hands a team the session it plays in. Only the first to join a team is told its session.
+func (s *MatchService) JoinMatch(ctx context.Context, matchID uuid.UUID, team string) (valueobject.MatchTeam, error) {
+	data, err := s.askMatch(ctx, matchID, "joining match", func(respChan chan actors.Response) actors.Message {
+		return actors.ClaimMatchTeamMessage{Team: team, ResponseChannel: respChan}
+	})
+	if err != nil {
+		return valueobject.MatchTeam{}, err
+	}
+	return data.(valueobject.MatchTeam), nil
+}
+
+func (s *MatchService) GetScoreboard(ctx context.Context, matchID uuid.UUID) (valueobject.Scoreboard, error) {
+	return s.requestScoreboard(ctx, matchID, "getting scoreboard", func(respChan chan actors.Response) actors.Message {
+		return actors.GetScoreboardMessage{ResponseChannel: respChan}
+	})
+}
+
+// Starts watching a match's scoreboard. The caller must close the watch once it's done
+// with it.
+func (s *MatchService) WatchMatch(ctx context.Context, matchID uuid.UUID) (*actors.MatchWatch, error) {
+	data, err := s.askMatch(ctx, matchID, "watching match", func(respChan chan actors.Response) actors.Message {
+		return actors.WatchMatchMessage{ResponseChannel: respChan}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data.(*actors.MatchWatch), nil
+}
+
+func (s *MatchService) requestScoreboard(ctx context.Context, matchID uuid.UUID, action string, newMsg func(chan actors.Response) actors.Message) (valueobject.Scoreboard, error) {
+	data, err := s.askMatch(ctx, matchID, action, newMsg)
+	if err != nil {
+		return valueobject.Scoreboard{}, err
+	}
+	return data.(valueobject.Scoreboard), nil
+}
+
+// Sends a message to the match's actor and returns the data it replies with.
+func (s *MatchService) askMatch(ctx context.Context, matchID uuid.UUID, action string, newMsg func(chan actors.Response) actors.Message) (interface{}, error) {
+	matchActor, err := s.gameService.actorSystem.GetMatch(matchID)
+	if err != nil {
+		return nil, valueobject.ErrMatchNotFound
+	}
+
+	respChan := make(chan actors.Response, 1)
+
+	matchActor.Send(newMsg(respChan))
+
+	select {
+	case resp := <-respChan:
+		if !resp.IsSuccess() {
+			return nil, resp.Error()
+		}
+		return resp.(actors.SuccessResponse).Data, nil
+
+	case <-time.After(5 * time.Second):
+		return nil, fmt.Errorf("timeout %s", action)
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,117 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ZaneH/defuse.party-go/internal/application/command"
+	"github.com/ZaneH/defuse.party-go/internal/application/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchService_TeamsRaceIdenticalBombs(t *testing.T) {
+	// Arrange
+	gameService := newGameService()
+	matchService := services.NewMatchService(gameService)
+	ctx := context.Background()
+
+	// Act
+	result, err := matchService.CreateMatch(&command.CreateMatchCommand{
+		Game:  command.CreateGameCommand{ConfigType: command.ConfigTypeLevel, Level: 3},
+		Teams: []string{"red", " blue "},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	teams := make([]valueobject.MatchTeam, 0, len(result.Teams))
+	snapshots := make([]entities.BombSnapshot, 0, len(result.Teams))
+	for _, name := range result.Teams {
+		team, err := matchService.JoinMatch(ctx, result.MatchID, name)
+		assert.NoError(t, err)
+		teams = append(teams, team)
+
+		snapshot, err := gameService.GetSessionSnapshot(ctx, team.SessionID)
+		assert.NoError(t, err)
+		assert.Equal(t, valueobject.SessionStateLobby, snapshot.State)
+		assert.True(t, snapshot.PauseDisabled, "Teams shouldn't be able to stop their clocks")
+		snapshots = append(snapshots, snapshot.Bombs[0])
+	}
+
+	_, earlyErr := gameService.StartGame(ctx, teams[0].SessionID)
+	scoreboard, err := matchService.StartMatch(ctx, result.MatchID)
+	assert.NoError(t, err)
+	_, againErr := matchService.StartMatch(ctx, result.MatchID)
+
+	// Assert
+	assert.Equal(t, []string{"red", "blue"}, result.Teams)
+	assert.NotEmpty(t, result.Game.Seed, "Every team should share one generated seed")
+
+	red, blue := snapshots[0], snapshots[1]
+	assert.Equal(t, red.SerialNumber, blue.SerialNumber)
+	if assert.Len(t, blue.Modules, len(red.Modules)) {
+		for i := range red.Modules {
+			assert.Equal(t, red.Modules[i].Type, blue.Modules[i].Type)
+			assert.Equal(t, red.Modules[i].Position, blue.Modules[i].Position)
+		}
+	}
+
+	assert.ErrorIs(t, earlyErr, valueobject.ErrStartedByMatch, "Teams shouldn't be able to start before the others")
+	assert.NotNil(t, scoreboard.StartedAt)
+	assert.Len(t, scoreboard.Teams, 2)
+	assert.ErrorIs(t, againErr, valueobject.ErrGameAlreadyStarted)
+
+	for _, team := range teams {
+		snapshot, err := gameService.GetSessionSnapshot(ctx, team.SessionID)
+		assert.NoError(t, err)
+		assert.Equal(t, valueobject.SessionStateInProgress, snapshot.State)
+	}
+}
+
+func TestMatchService_EachTeamIsOnlyToldItsOwnSession(t *testing.T) {
+	// Arrange
+	matchService := services.NewMatchService(newGameService())
+	ctx := context.Background()
+	result, err := matchService.CreateMatch(&command.CreateMatchCommand{
+		Game:  command.CreateGameCommand{ConfigType: command.ConfigTypeLevel, Level: 1},
+		Teams: []string{"red", "blue"},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Act
+	red, redErr := matchService.JoinMatch(ctx, result.MatchID, " red ")
+	_, againErr := matchService.JoinMatch(ctx, result.MatchID, "red")
+	_, unknownErr := matchService.JoinMatch(ctx, result.MatchID, "green")
+	blue, blueErr := matchService.JoinMatch(ctx, result.MatchID, "blue")
+
+	// Assert
+	assert.NoError(t, redErr)
+	assert.NoError(t, blueErr)
+	assert.Equal(t, "red", red.Name)
+	assert.NotEqual(t, red.SessionID, blue.SessionID)
+	assert.ErrorIs(t, againErr, valueobject.ErrTeamClaimed, "Another team shouldn't be able to get red's session")
+	assert.ErrorIs(t, unknownErr, valueobject.ErrTeamNotInMatch)
+}
+
+func TestMatchService_RejectsInvalidMatches(t *testing.T) {
+	// Arrange
+	matchService := services.NewMatchService(newGameService())
+
+	// Act
+	_, oneTeamErr := matchService.CreateMatch(&command.CreateMatchCommand{Teams: []string{"solo"}})
+	_, duplicateErr := matchService.CreateMatch(&command.CreateMatchCommand{Teams: []string{"red", "red"}})
+	_, challengeErr := matchService.CreateMatch(&command.CreateMatchCommand{
+		Game:  command.CreateGameCommand{ConfigType: command.ConfigTypeChallenge},
+		Teams: []string{"red", "blue"},
+	})
+
+	// Assert
+	var validationErrs valueobject.ValidationErrors
+	assert.ErrorAs(t, oneTeamErr, &validationErrs)
+	assert.ErrorAs(t, duplicateErr, &validationErrs)
+	assert.ErrorIs(t, challengeErr, valueobject.ErrMatchChallenges)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/ZaneH/defuse.party-go/internal/domain/ports"
 	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
@@ -86,6 +87,7 @@ func (m *BigButtonModule) PressButton(pressType valueobject.PressType, releaseTi
 	if !handled {
 		// Short tap was not handled, so it's a strike
 		if pressType == valueobject.PressTypeTap {
+			m.explain("big_button.tap.hold_instead", "no tap rule applies → hold the button and release on the strip's digit")
 			return nil, true, errors.New("invalid short press")
 		}
 
@@ -105,17 +107,22 @@ func (m *BigButtonModule) PressButton(pressType valueobject.PressType, releaseTi
 // Handles a short press (tap) of the button. There are certain conditions that must be met
 // for the module to be marked as solved. If the conditions are not met, it will return an error.
 func (m *BigButtonModule) handleShortPress() (handled bool) {
-	if m.bomb.Batteries > 1 && m.State.Label == string(Detonate) {
+	edgework := m.bomb.Edgework()
+
+	if edgework.BatteryCount() > 1 && m.State.Label == string(Detonate) {
+		m.explain("big_button.tap.detonate_batteries", "\"Detonate\", >1 battery → tap")
 		m.State.MarkAsSolved()
 		return true
 	}
 
-	if m.bomb.Batteries > 2 && m.bomb.Indicators["FRK"].Lit {
+	if edgework.BatteryCount() > 2 && edgework.HasLitIndicator("FRK") {
+		m.explain("big_button.tap.frk_batteries", "lit FRK, >2 batteries → tap")
 		m.State.MarkAsSolved()
 		return true
 	}
 
 	if m.State.ButtonColor == valueobject.Red && m.State.Label == string(Hold) {
+		m.explain("big_button.tap.red_hold", "red \"Hold\" → tap")
 		m.State.MarkAsSolved()
 		return true
 	}
@@ -133,7 +140,7 @@ func (m *BigButtonModule) handleLongPress() (handled bool, color *valueobject.Co
 		handled = true
 	}
 
-	if m.State.ButtonColor == valueobject.White && m.bomb.Indicators["CAR"].Lit {
+	if m.State.ButtonColor == valueobject.White && m.bomb.Edgework().HasLitIndicator("CAR") {
 		m.State.ReleaseDigit = generateReleaseDigit(m.rng)
 		handled = true
 	}
@@ -159,17 +166,14 @@ func (m *BigButtonModule) handleLongPressRelease(releaseTime int64) (handled boo
 	if m.State.ReleaseDigit == nil {
 		return true, errors.New("release digit is nil")
 	} else {
-		// Check if any digit in MM:SS matches the release digit
-		startedAt := m.bomb.StartedAt.Unix()
-		duration := m.bomb.TimerDuration.Milliseconds() / 1000 // Convert to seconds
-
-		// Calculate remaining time on the bomb (in seconds)
-		elapsedTime := releaseTime - startedAt  // How many seconds have passed
-		remainingTime := duration - elapsedTime // How many seconds remain
+		// Check if any digit in MM:SS matches the release digit. The clock may be running
+		// faster after strikes, so use the time the bomb actually displayed on release.
+		remainingTime := int64(m.bomb.TimerAt(time.Unix(releaseTime, 0)).Seconds())
 
 		minutes := remainingTime / 60
 		seconds := remainingTime % 60
 		releaseDigit := *m.State.ReleaseDigit
+		m.explain("big_button.release.digit", fmt.Sprintf("strip color means %d → release when the timer has a %d in any position", releaseDigit, releaseDigit))
 
 		// Check if MM:SS contains the release digit
 		if strings.Contains(fmt.Sprintf("%02d:%02d", minutes, seconds), fmt.Sprintf("%d", releaseDigit)) {
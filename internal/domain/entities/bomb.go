@@ -13,32 +13,74 @@ import (
 )
 
 type Bomb struct {
-	ID            uuid.UUID
-	SerialNumber  string
-	TimerDuration time.Duration
-	StartedAt     *time.Time
-	StrikeCount   int
-	MaxStrikes    int
-	Faces         map[int]*BombFace
-	Modules       map[uuid.UUID]Module
-	Indicators    map[string]valueobject.Indicator
-	Batteries     int
-	Ports         []valueobject.Port
+	ID           uuid.UUID
+	SerialNumber string
+	Clock        *BombClock
+	StrikeCount  int
+	MaxStrikes   int
+	// Timer speed multipliers indexed by strike count
+	StrikeTimerRates []float64
+	Faces            map[int]*BombFace
+	Modules          map[uuid.UUID]Module
+	Indicators       map[string]valueobject.Indicator
+	// Total number of batteries across every holder
+	Batteries      int
+	BatteryHolders []valueobject.BatteryHolder
+	// Every port across all port plates
+	Ports      []valueobject.Port
+	PortPlates []valueobject.PortPlate
+	// Practice bombs never explode
+	Practice bool
+	// Rules the bomb is played with, in the order they were given
+	Modifiers []BombModifier
+	// Whether a player has asked to see edgework hidden by ModifierEdgeworkOnRequest
+	EdgeworkRevealed bool
+	// Strikes the bomb has counted from each module
+	moduleStrikes map[uuid.UUID]int
 }
 
+// How a new bomb's port plates are generated. Released generator versions keep the style
+// they shipped with.
+type PortPlateStyle int
+
+const (
+	// Every plate holds at least one port, so a bomb without ports has no plates
+	PortPlatesFilled PortPlateStyle = iota
+	// How many plates there are doesn't depend on the port count, so plates can be empty
+	PortPlatesAnyCount
+)
+
+// Most plates PortPlatesAnyCount puts on a bomb
+const maxGeneratedPortPlates = 3
+
 func NewBomb(rng ports.RandomGenerator, config valueobject.BombConfig) *Bomb {
+	return NewBombWithPortPlates(rng, config, PortPlatesFilled)
+}
+
+func NewBombWithPortPlates(rng ports.RandomGenerator, config valueobject.BombConfig, style PortPlateStyle) *Bomb {
+	batteryHolders := generateBatteryHolders(rng, generateRandomBatteryCount(rng, config.MinBatteries, config.MaxBatteries))
+	var portPlates []valueobject.PortPlate
+	if style == PortPlatesAnyCount {
+		portPlates = generateAnyPortPlates(rng, config.PortCount)
+	} else {
+		portPlates = generatePortPlates(rng, config.PortCount)
+	}
+
 	return &Bomb{
-		ID:            uuid.New(),
-		SerialNumber:  generateSerialNumber(rng),
-		TimerDuration: config.Timer,
-		StartedAt:     nil,
-		StrikeCount:   0,
-		MaxStrikes:    config.MaxStrikes,
-		Faces:         make(map[int]*BombFace),
-		Modules:       make(map[uuid.UUID]Module),
-		Indicators:    generateRandomIndicators(rng, config.MaxIndicatorCount),
-		Batteries:     generateRandomBatteryCount(rng, config.MinBatteries, config.MaxBatteries),
-		Ports:         generateRandomPorts(rng, config.PortCount),
+		ID:               uuid.New(),
+		SerialNumber:     generateSerialNumber(rng),
+		Clock:            NewBombClock(config.Timer),
+		StrikeCount:      0,
+		MaxStrikes:       config.MaxStrikes,
+		StrikeTimerRates: config.StrikeTimerRates,
+		Faces:            make(map[int]*BombFace),
+		Modules:          make(map[uuid.UUID]Module),
+		Indicators:       generateRandomIndicators(rng, config.MaxIndicatorCount),
+		Batteries:        countBatteries(batteryHolders),
+		BatteryHolders:   batteryHolders,
+		Ports:            flattenPortPlates(portPlates),
+		PortPlates:       portPlates,
+		Practice:         config.Practice,
 	}
 }
 
@@ -60,47 +102,270 @@ func (b *Bomb) AddModule(module Module, position valueobject.ModulePosition) err
 	return nil
 }
 
+// Returns the bomb's edgework for module rules to query.
+func (b *Bomb) Edgework() valueobject.Edgework {
+	return valueobject.Edgework{
+		SerialNumber:   b.SerialNumber,
+		Indicators:     b.Indicators,
+		Batteries:      b.Batteries,
+		BatteryHolders: b.BatteryHolders,
+		Ports:          b.Ports,
+		PortPlates:     b.PortPlates,
+	}
+}
+
+// Gives the bomb its own rules for each of the modifiers, replacing any it had.
+func (b *Bomb) SetModifiers(modifiers []valueobject.Modifier) {
+	b.Modifiers = make([]BombModifier, 0, len(modifiers))
+	for _, modifier := range modifiers {
+		b.Modifiers = append(b.Modifiers, NewBombModifier(modifier))
+	}
+}
+
+// Returns the modifiers the bomb is played with.
+func (b *Bomb) ModifierTypes() []valueobject.Modifier {
+	var modifiers []valueobject.Modifier
+	for _, modifier := range b.Modifiers {
+		modifiers = append(modifiers, modifier.Type())
+	}
+	return modifiers
+}
+
+// Adds a strike and speeds up the timer according to the new strike count.
 func (b *Bomb) AddStrike() {
 	b.StrikeCount++
+	b.Clock.SetRate(b.timerRateForStrikes(b.StrikeCount), time.Now())
+}
+
+// Adds a strike given by the module, unless one of the bomb's modifiers drops it. Returns
+// whether the strike was counted.
+func (b *Bomb) RecordStrike(moduleID uuid.UUID) bool {
+	for _, modifier := range b.Modifiers {
+		if !modifier.AllowStrike(b, moduleID) {
+			return false
+		}
+	}
+
+	b.AddStrike()
+	if b.moduleStrikes == nil {
+		b.moduleStrikes = make(map[uuid.UUID]int)
+	}
+	b.moduleStrikes[moduleID]++
+
+	for _, modifier := range b.Modifiers {
+		modifier.OnStrike(b, moduleID)
+	}
+	return true
+}
+
+// Returns how many of the bomb's strikes the module gave it.
+func (b *Bomb) ModuleStrikes(moduleID uuid.UUID) int {
+	return b.moduleStrikes[moduleID]
+}
+
+// Runs out every needy countdown that has ended, giving the bomb the strikes the needy
+// modules fail with. Countdowns only run out while the bomb is armed.
+func (b *Bomb) TimeOutNeedyModules(now time.Time) {
+	for moduleID, module := range b.Modules {
+		needy, ok := module.(NeedyModule)
+		if !ok || needy.CountdownRemaining(now) > 0 {
+			continue
+		}
+		if b.GetState() != valueobject.BombStateArmed {
+			return
+		}
+
+		if needy.TimeOut(now) {
+			b.RecordStrike(moduleID)
+		}
+	}
+}
+
+// Returns how much bomb time is left before the next needy countdown runs out, or false if
+// the bomb has no needy modules.
+func (b *Bomb) NextNeedyTimeOut(now time.Time) (time.Duration, bool) {
+	var next time.Duration
+	found := false
+
+	for _, module := range b.Modules {
+		if needy, ok := module.(NeedyModule); ok {
+			remaining := needy.CountdownRemaining(now)
+			if !found || remaining < next {
+				next, found = remaining, true
+			}
+		}
+	}
+	return next, found
+}
+
+// Lets the bomb's modifiers know the module was solved.
+func (b *Bomb) RecordSolve(moduleID uuid.UUID) {
+	for _, modifier := range b.Modifiers {
+		modifier.OnSolve(b, moduleID)
+	}
+}
+
+// Shows the edgework to players when it's hidden by ModifierEdgeworkOnRequest.
+func (b *Bomb) RevealEdgework() {
+	b.EdgeworkRevealed = true
+}
+
+// Returns whether the bomb's modifiers let it explode from the given cause.
+func (b *Bomb) canExplode(cause ExplosionCause) bool {
+	if b.Practice {
+		return false
+	}
+
+	for _, modifier := range b.Modifiers {
+		if !modifier.CanExplode(cause) {
+			return false
+		}
+	}
+	return true
+}
+
+// Returns whether the bomb has run out of strikes.
+func (b *Bomb) isStruckOut() bool {
+	return b.StrikeCount >= b.MaxStrikes && b.canExplode(ExplosionCauseStrikes)
+}
+
+// Returns whether every module that can be solved has been solved. Needy modules don't
+// count towards defusing the bomb.
+func (b *Bomb) IsDefused() bool {
+	for _, module := range b.Modules {
+		state := module.GetModuleState()
+		if state == nil || module.GetType().IsNeedy() {
+			continue
+		}
+
+		if !state.IsSolved() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (b *Bomb) IsExploded() bool {
+	if b.isStruckOut() {
+		return true
+	}
+
+	return b.Clock.IsStarted() && b.GetTimeLeft() <= 0 && b.canExplode(ExplosionCauseTimer)
+}
+
+// Returns where the bomb is in its lifecycle. A bomb that hasn't been armed yet is waiting
+// for its turn in a sequential session.
+func (b *Bomb) GetState() valueobject.BombState {
+	if b.isStruckOut() {
+		return valueobject.BombStateExploded
+	}
+
+	if b.Clock.IsStarted() && b.IsDefused() {
+		return valueobject.BombStateDefused
+	}
+
+	if b.IsExploded() {
+		return valueobject.BombStateExploded
+	}
+
+	if !b.Clock.IsStarted() {
+		return valueobject.BombStateWaiting
+	}
+
+	if b.Clock.IsPaused() {
+		return valueobject.BombStatePaused
+	}
+
+	return valueobject.BombStateArmed
+}
+
+// Returns how many of the solvable modules have been solved and how many there are.
+func (b *Bomb) GetSolvedModuleCount() (solved int, total int) {
+	for _, module := range b.Modules {
+		state := module.GetModuleState()
+		if state == nil || module.GetType().IsNeedy() {
+			continue
+		}
+
+		total++
+		if state.IsSolved() {
+			solved++
+		}
+	}
+
+	return solved, total
 }
 
 func (b *Bomb) GetTimeLeft() time.Duration {
-	now := time.Now()
-	return b.TimerDuration - time.Since(now)
+	return b.Clock.TimeLeftAt(time.Now())
+}
+
+// Returns what the bomb's timer shows at the given moment: the time left, unless one of the
+// bomb's modifiers shows something else.
+func (b *Bomb) TimerAt(t time.Time) time.Duration {
+	shown := b.Clock.TimeLeftAt(t)
+	for _, modifier := range b.Modifiers {
+		shown = modifier.ShowTimer(b, t, shown)
+	}
+	return shown
+}
+
+func (b *Bomb) StartTimer(at time.Time) {
+	b.Clock.Start(at)
 }
 
-func (b *Bomb) StartTimer() {
-	if b.StartedAt != nil {
-		return
+// Returns the timer speed for the given number of strikes. Strike counts past the end of
+// the configured rates keep the last rate.
+func (b *Bomb) timerRateForStrikes(strikes int) float64 {
+	if len(b.StrikeTimerRates) == 0 {
+		return 1.0
 	}
-	now := time.Now()
-	b.StartedAt = &now
+
+	idx := min(strikes, len(b.StrikeTimerRates)-1)
+	return b.StrikeTimerRates[idx]
 }
 
+// Letters used in serial numbers. O and Y never appear in the game's serial numbers.
+const serialNumberLetters = "ABCDEFGHIJKLMNPQRSTUVWXZ"
+
+// Generates a serial number in the game's format: two letters or digits, a digit, two
+// letters, and a final digit (e.g. "AL5QF2").
 func generateSerialNumber(rng ports.RandomGenerator) string {
+	pick := func(options string) byte {
+		return options[rng.GetIntInRange(0, len(options)-1)]
+	}
+
 	var sb strings.Builder
-	options := common.ALPHABET_UPPERCASE
-	for range 6 {
-		randIdx := rng.GetIntInRange(0, len(options)-1)
-		sb.WriteByte(options[randIdx])
+	for range 2 {
+		sb.WriteByte(pick(serialNumberLetters + common.DIGITS))
 	}
+	sb.WriteByte(pick(common.DIGITS))
+	for range 2 {
+		sb.WriteByte(pick(serialNumberLetters))
+	}
+	sb.WriteByte(pick(common.DIGITS))
 
 	return sb.String()
 }
 
+// Picks up to count indicators. A label never appears twice on the same bomb.
 func generateRandomIndicators(rng ports.RandomGenerator, count int) map[string]valueobject.Indicator {
-	options := valueobject.AVAILABLE_INDICATOR_LABELS
 	indicators := make(map[string]valueobject.Indicator, count)
 	if count == 0 {
 		return indicators
 	}
 
-	count = rng.GetIntInRange(0, count)
+	labels := make([]string, len(valueobject.AVAILABLE_INDICATOR_LABELS))
+	copy(labels, valueobject.AVAILABLE_INDICATOR_LABELS)
+	rng.Shuffle(len(labels), func(i, j int) {
+		labels[i], labels[j] = labels[j], labels[i]
+	})
+
+	count = min(rng.GetIntInRange(0, count), len(labels))
 
-	for range count {
+	for _, label := range labels[:count] {
 		lit := rng.GetIntInRange(0, 1) == 1
-		randIdx := rng.GetIntInRange(0, len(options)-1)
-		label := options[randIdx]
 		indicators[label] = valueobject.Indicator{
 			Lit:   lit,
 			Label: label,
@@ -117,15 +382,124 @@ func generateRandomBatteryCount(rng ports.RandomGenerator, minBatteries int, max
 	return rng.GetIntInRange(minBatteries, maxBatteries)
 }
 
-func generateRandomPorts(rng ports.RandomGenerator, count int) []valueobject.Port {
-	options := valueobject.AVAILABLE_PORTS
-	ports := make([]valueobject.Port, 0, count)
+// Splits the batteries across AA holders (two batteries each) and D holders (one each).
+func generateBatteryHolders(rng ports.RandomGenerator, batteries int) []valueobject.BatteryHolder {
+	holders := make([]valueobject.BatteryHolder, 0, batteries)
+
+	for batteries > 0 {
+		holder := valueobject.BatteryHolder{Type: valueobject.BatteryTypeD}
+		if batteries >= 2 && rng.GetIntInRange(0, 1) == 1 {
+			holder.Type = valueobject.BatteryTypeAA
+		}
 
-	for range count {
-		randIdx := rng.GetIntInRange(0, len(options)-1)
-		ports = append(ports, options[randIdx])
+		holders = append(holders, holder)
+		batteries -= holder.BatteryCount()
 	}
 
+	return holders
+}
+
+func countBatteries(holders []valueobject.BatteryHolder) int {
+	batteries := 0
+	for _, holder := range holders {
+		batteries += holder.BatteryCount()
+	}
+	return batteries
+}
+
+// Generates port plates holding exactly count ports in total. Each plate uses one of the
+// plate layouts and holds each of its ports at most once.
+func generatePortPlates(rng ports.RandomGenerator, count int) []valueobject.PortPlate {
+	plates := make([]valueobject.PortPlate, 0)
+
+	for remaining := count; remaining > 0; {
+		layout := valueobject.PORT_PLATE_LAYOUTS[rng.GetIntInRange(0, len(valueobject.PORT_PLATE_LAYOUTS)-1)]
+
+		// Keeps a random selection of the layout's ports, in layout order
+		order := make([]int, len(layout))
+		for i := range order {
+			order[i] = i
+		}
+		rng.Shuffle(len(order), func(i, j int) {
+			order[i], order[j] = order[j], order[i]
+		})
+		keep := make(map[int]bool)
+		for _, idx := range order[:rng.GetIntInRange(1, min(len(layout), remaining))] {
+			keep[idx] = true
+		}
+
+		plate := valueobject.PortPlate{Ports: make([]valueobject.Port, 0, len(keep))}
+		for idx, port := range layout {
+			if keep[idx] {
+				plate.Ports = append(plate.Ports, port)
+			}
+		}
+
+		plates = append(plates, plate)
+		remaining -= len(plate.Ports)
+	}
+
+	return plates
+}
+
+// Generates up to maxGeneratedPortPlates port plates holding exactly count ports in total,
+// with only as many plates as the ports need at the least. Any plate can be empty. Each
+// plate uses one of the plate layouts and holds each of its ports at most once.
+func generateAnyPortPlates(rng ports.RandomGenerator, count int) []valueobject.PortPlate {
+	layouts := valueobject.PORT_PLATE_LAYOUTS
+	widest := len(layouts[0])
+
+	fewest := (count + widest - 1) / widest
+	plateLayouts := make([][]valueobject.Port, rng.GetIntInRange(fewest, max(fewest, maxGeneratedPortPlates)))
+	capacity := 0
+	for i := range plateLayouts {
+		plateLayouts[i] = layouts[rng.GetIntInRange(0, len(layouts)-1)]
+		capacity += len(plateLayouts[i])
+	}
+	// Widens plates until they can hold every port
+	for i := 0; capacity < count; i++ {
+		capacity += widest - len(plateLayouts[i])
+		plateLayouts[i] = layouts[0]
+	}
+
+	plates := make([]valueobject.PortPlate, 0, len(plateLayouts))
+	remaining := count
+	for _, layout := range plateLayouts {
+		capacity -= len(layout)
+		// Leaves no more ports than the plates after this one can hold
+		drawn := rng.GetIntInRange(max(remaining-capacity, 0), min(len(layout), remaining))
+
+		order := make([]int, len(layout))
+		for i := range order {
+			order[i] = i
+		}
+		rng.Shuffle(len(order), func(i, j int) {
+			order[i], order[j] = order[j], order[i]
+		})
+		keep := make(map[int]bool)
+		for _, idx := range order[:drawn] {
+			keep[idx] = true
+		}
+
+		plate := valueobject.PortPlate{Ports: make([]valueobject.Port, 0, len(keep))}
+		for idx, port := range layout {
+			if keep[idx] {
+				plate.Ports = append(plate.Ports, port)
+			}
+		}
+
+		plates = append(plates, plate)
+		remaining -= len(plate.Ports)
+	}
+
+	return plates
+}
+
+func flattenPortPlates(plates []valueobject.PortPlate) []valueobject.Port {
+	ports := make([]valueobject.Port, 0)
+	for _, plate := range plates {
+		ports = append(ports, plate.Ports...)
+	}
 	return ports
 }
 
@@ -134,10 +508,12 @@ func (b *Bomb) String() string {
 	sb.WriteString("Bomb ID: " + b.ID.String() + "\n")
 	sb.WriteString("Serial Number: " + b.SerialNumber + "\n")
 	sb.WriteString("Time Remaining: " + b.GetTimeLeft().String() + "\n")
+	sb.WriteString("Timer Rate: " + fmt.Sprint(b.Clock.Rate()) + "\n")
 	sb.WriteString("Strike Count: " + fmt.Sprint(b.StrikeCount) + "\n")
 	sb.WriteString("Max Strikes: " + fmt.Sprint(b.MaxStrikes) + "\n")
 	sb.WriteString("Batteries: " + fmt.Sprint(b.Batteries) + "\n")
-	sb.WriteString("Ports: " + fmt.Sprintf("%+v", b.Ports) + "\n")
+	sb.WriteString("Battery Holders: " + fmt.Sprint(len(b.BatteryHolders)) + "\n")
+	sb.WriteString("Port Plates: " + fmt.Sprintf("%+v", b.PortPlates) + "\n")
 
 	for faceIndex, face := range b.Faces {
 		sb.WriteString("Face " + fmt.Sprint(faceIndex) + ":\n" + face.String() + "\n")
@@ -0,0 +1,198 @@
+package entities
+
+import "time"
+
+// BombClock tracks how much of the bomb's timer has been used up. Strikes speed up the
+// countdown, so elapsed time is accumulated piecewise across every rate change. Time spent
+// paused is left out.
+type BombClock struct {
+	// Total time on the clock when the bomb is armed
+	Duration time.Duration
+	// Time added to the clock since it was armed, or taken away if negative
+	adjustment time.Duration
+	// Rate changes in the order they happened. The first segment starts the clock.
+	segments []clockSegment
+	// When the clock was frozen because the bomb was defused or exploded
+	stoppedAt *time.Time
+	// Every time the clock was paused, in order. Only the last pause can still be open.
+	pauses []clockPause
+}
+
+type clockSegment struct {
+	startedAt time.Time
+	rate      float64
+}
+
+type clockPause struct {
+	pausedAt time.Time
+	// Nil while the clock is still paused
+	resumedAt *time.Time
+}
+
+func NewBombClock(duration time.Duration) *BombClock {
+	return &BombClock{
+		Duration: duration,
+	}
+}
+
+// Starts the clock at the normal rate. Does nothing if the clock is already running.
+func (c *BombClock) Start(now time.Time) {
+	if c.IsStarted() {
+		return
+	}
+
+	c.segments = append(c.segments, clockSegment{startedAt: now, rate: 1.0})
+}
+
+// Freezes the clock at the given moment. The time left stays at whatever it was when
+// the clock was stopped.
+func (c *BombClock) Stop(now time.Time) {
+	if !c.IsStarted() || c.IsStopped() {
+		return
+	}
+
+	c.stoppedAt = &now
+}
+
+func (c *BombClock) IsStopped() bool {
+	return c.stoppedAt != nil
+}
+
+// Returns when the clock was stopped, or nil if it's still counting.
+func (c *BombClock) StoppedAt() *time.Time {
+	if c.stoppedAt == nil {
+		return nil
+	}
+
+	stoppedAt := *c.stoppedAt
+	return &stoppedAt
+}
+
+// Holds the clock where it is until Resume is called. Does nothing unless the clock is
+// running.
+func (c *BombClock) Pause(now time.Time) {
+	if !c.IsStarted() || c.IsStopped() || c.IsPaused() {
+		return
+	}
+
+	c.pauses = append(c.pauses, clockPause{pausedAt: now})
+}
+
+// Carries on counting down from where the clock was paused.
+func (c *BombClock) Resume(now time.Time) {
+	if !c.IsPaused() {
+		return
+	}
+
+	c.pauses[len(c.pauses)-1].resumedAt = &now
+}
+
+func (c *BombClock) IsPaused() bool {
+	return len(c.pauses) > 0 && c.pauses[len(c.pauses)-1].resumedAt == nil
+}
+
+// Returns how much real time the clock spent paused between two moments.
+func (c *BombClock) PausedBetween(from time.Time, to time.Time) time.Duration {
+	var paused time.Duration
+
+	for _, pause := range c.pauses {
+		start := pause.pausedAt
+		end := to
+		if pause.resumedAt != nil && pause.resumedAt.Before(to) {
+			end = *pause.resumedAt
+		}
+		if start.Before(from) {
+			start = from
+		}
+
+		if end.After(start) {
+			paused += end.Sub(start)
+		}
+	}
+
+	return paused
+}
+
+func (c *BombClock) IsStarted() bool {
+	return len(c.segments) > 0
+}
+
+// Returns when the clock was started, or nil if it hasn't been started yet.
+func (c *BombClock) StartedAt() *time.Time {
+	if !c.IsStarted() {
+		return nil
+	}
+
+	startedAt := c.segments[0].startedAt
+	return &startedAt
+}
+
+// Returns the speed the clock is currently counting down at (1.0 is real time).
+func (c *BombClock) Rate() float64 {
+	if !c.IsStarted() {
+		return 1.0
+	}
+
+	return c.segments[len(c.segments)-1].rate
+}
+
+// Changes the countdown speed from now on. Time that has already elapsed keeps the rate
+// it was counted at.
+func (c *BombClock) SetRate(rate float64, now time.Time) {
+	if !c.IsStarted() || c.IsStopped() || rate == c.Rate() {
+		return
+	}
+
+	c.segments = append(c.segments, clockSegment{startedAt: now, rate: rate})
+}
+
+// Adds time to the clock, or takes it away if d is negative. Does nothing unless the clock
+// is running.
+func (c *BombClock) Adjust(d time.Duration) {
+	if !c.IsStarted() || c.IsStopped() {
+		return
+	}
+
+	c.adjustment += d
+}
+
+// Returns how much of the timer has been used up at the given moment, not counting time
+// spent paused.
+func (c *BombClock) ElapsedAt(t time.Time) time.Duration {
+	var elapsed float64
+
+	if c.stoppedAt != nil && t.After(*c.stoppedAt) {
+		t = *c.stoppedAt
+	}
+
+	for i, segment := range c.segments {
+		if !t.After(segment.startedAt) {
+			break
+		}
+
+		end := t
+		if i+1 < len(c.segments) && c.segments[i+1].startedAt.Before(t) {
+			end = c.segments[i+1].startedAt
+		}
+
+		running := end.Sub(segment.startedAt) - c.PausedBetween(segment.startedAt, end)
+		elapsed += float64(running) * segment.rate
+	}
+
+	return time.Duration(elapsed)
+}
+
+// Returns the time shown on the bomb's clock at the given moment. Never negative.
+func (c *BombClock) TimeLeftAt(t time.Time) time.Duration {
+	return max(c.Duration+c.adjustment-c.ElapsedAt(t), 0)
+}
+
+// Returns how much timer time passed between two moments, taking rate changes and pauses
+// into account.
+func (c *BombClock) ElapsedBetween(from time.Time, to time.Time) time.Duration {
+	if !c.IsStarted() {
+		return to.Sub(from)
+	}
+
+	return c.ElapsedAt(to) - c.ElapsedAt(from)
+}
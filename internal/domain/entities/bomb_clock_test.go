@@ -0,0 +1,110 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+var clockStart = time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+func TestBombClock_UnstartedClockShowsFullTime(t *testing.T) {
+	// Arrange
+	clock := entities.NewBombClock(5 * time.Minute)
+
+	// Act
+	timeLeft := clock.TimeLeftAt(clockStart.Add(time.Minute))
+
+	// Assert
+	assert.False(t, clock.IsStarted())
+	assert.Nil(t, clock.StartedAt())
+	assert.Equal(t, 1.0, clock.Rate())
+	assert.Equal(t, 5*time.Minute, timeLeft)
+}
+
+func TestBombClock_CountsDownOnceStarted(t *testing.T) {
+	// Arrange
+	clock := entities.NewBombClock(5 * time.Minute)
+
+	// Act
+	clock.Start(clockStart)
+	clock.Start(clockStart.Add(time.Minute))
+
+	// Assert
+	if assert.NotNil(t, clock.StartedAt()) {
+		assert.Equal(t, clockStart, *clock.StartedAt(), "Starting twice shouldn't restart the clock")
+	}
+	assert.Equal(t, 4*time.Minute, clock.TimeLeftAt(clockStart.Add(time.Minute)))
+	assert.Equal(t, time.Duration(0), clock.TimeLeftAt(clockStart.Add(time.Hour)), "Time left should never go negative")
+}
+
+func TestBombClock_StrikeRateOnlyAppliesFromTheChange(t *testing.T) {
+	// Arrange
+	clock := entities.NewBombClock(5 * time.Minute)
+	clock.Start(clockStart)
+
+	// Act
+	clock.SetRate(2.0, clockStart.Add(time.Minute))
+
+	// Assert
+	assert.Equal(t, 2.0, clock.Rate())
+	assert.Equal(t, 4*time.Minute, clock.TimeLeftAt(clockStart.Add(time.Minute)), "Time already elapsed keeps its rate")
+	assert.Equal(t, 2*time.Minute, clock.TimeLeftAt(clockStart.Add(2*time.Minute)))
+	assert.Equal(t, 2*time.Minute, clock.ElapsedBetween(clockStart.Add(time.Minute), clockStart.Add(2*time.Minute)))
+}
+
+func TestBombClock_StopFreezesTimeLeft(t *testing.T) {
+	// Arrange
+	clock := entities.NewBombClock(5 * time.Minute)
+	clock.Start(clockStart)
+
+	// Act
+	clock.Stop(clockStart.Add(time.Minute))
+	clock.SetRate(2.0, clockStart.Add(2*time.Minute))
+
+	// Assert
+	assert.True(t, clock.IsStopped())
+	if assert.NotNil(t, clock.StoppedAt()) {
+		assert.Equal(t, clockStart.Add(time.Minute), *clock.StoppedAt())
+	}
+	assert.Equal(t, 1.0, clock.Rate(), "A stopped clock shouldn't change rate")
+	assert.Equal(t, 4*time.Minute, clock.TimeLeftAt(clockStart.Add(time.Hour)))
+}
+
+func TestBombClock_PausedTimeIsLeftOut(t *testing.T) {
+	// Arrange
+	clock := entities.NewBombClock(5 * time.Minute)
+	clock.Start(clockStart)
+
+	// Act
+	clock.Pause(clockStart.Add(time.Minute))
+	paused := clock.TimeLeftAt(clockStart.Add(3 * time.Minute))
+	clock.Resume(clockStart.Add(3 * time.Minute))
+
+	// Assert
+	assert.Equal(t, 4*time.Minute, paused)
+	assert.Equal(t, 3*time.Minute, clock.TimeLeftAt(clockStart.Add(4*time.Minute)))
+	assert.Equal(t, 2*time.Minute, clock.PausedBetween(clockStart, clockStart.Add(4*time.Minute)))
+}
+
+func TestBombClock_AdjustOnlyChangesARunningClock(t *testing.T) {
+	// Arrange
+	clock := entities.NewBombClock(5 * time.Minute)
+
+	// Act
+	clock.Adjust(time.Minute)
+	unstarted := clock.TimeLeftAt(clockStart)
+	clock.Start(clockStart)
+	clock.Adjust(-2 * time.Minute)
+	clock.Adjust(30 * time.Second)
+	running := clock.TimeLeftAt(clockStart.Add(time.Minute))
+	clock.Stop(clockStart.Add(time.Minute))
+	clock.Adjust(time.Hour)
+
+	// Assert
+	assert.Equal(t, 5*time.Minute, unstarted, "An unstarted clock shouldn't be adjusted")
+	assert.Equal(t, 150*time.Second, running)
+	assert.Equal(t, 150*time.Second, clock.TimeLeftAt(clockStart.Add(time.Hour)), "A stopped clock shouldn't be adjusted")
+}
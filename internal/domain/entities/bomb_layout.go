@@ -0,0 +1,222 @@
+package entities
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/ports"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// Everything needed to rebuild a bomb exactly: its rules, edgework and the state every
+// module starts in. Field names are kept short because layouts are shared as codes.
+type BombLayout struct {
+	TimerSeconds     int                         `json:"t"`
+	MaxStrikes       int                         `json:"s"`
+	StrikeTimerRates []float64                   `json:"r,omitempty"`
+	SerialNumber     string                      `json:"sn"`
+	Indicators       []valueobject.Indicator     `json:"i,omitempty"`
+	BatteryHolders   []valueobject.BatteryHolder `json:"b,omitempty"`
+	PortPlates       []valueobject.PortPlate     `json:"p,omitempty"`
+	Modules          []ModuleLayout              `json:"m"`
+}
+
+// A module's type, position and starting state. Only the field for the module's type is set.
+type ModuleLayout struct {
+	Type     valueobject.ModuleType     `json:"t"`
+	Position valueobject.ModulePosition `json:"p"`
+
+	Wires        []valueobject.Wire  `json:"w,omitempty"`
+	Password     *PasswordLayout     `json:"pw,omitempty"`
+	BigButton    *BigButtonLayout    `json:"bb,omitempty"`
+	Keypad       *KeypadLayout       `json:"kp,omitempty"`
+	Simon        *SimonLayout        `json:"si,omitempty"`
+	WhosOnFirst  *WhosOnFirstLayout  `json:"wf,omitempty"`
+	Memory       *MemoryLayout       `json:"me,omitempty"`
+	Morse        *MorseLayout        `json:"mo,omitempty"`
+	Maze         *MazeLayout         `json:"mz,omitempty"`
+	NeedyVentGas *NeedyVentGasLayout `json:"vg,omitempty"`
+	NeedyKnob    *NeedyKnobLayout    `json:"kn,omitempty"`
+}
+
+type PasswordLayout struct {
+	Letters  [5][6]string `json:"l"`
+	Solution string       `json:"s"`
+}
+
+type BigButtonLayout struct {
+	Color valueobject.Color `json:"c"`
+	Label string            `json:"l"`
+}
+
+type KeypadLayout struct {
+	Symbols  []valueobject.Symbol `json:"s"`
+	Solution []valueobject.Symbol `json:"o"`
+}
+
+type SimonLayout struct {
+	// Every color the module flashes, one per stage
+	Sequence []valueobject.Color `json:"q"`
+}
+
+type WhosOnFirstLayout struct {
+	ScreenWord  string   `json:"s"`
+	ButtonWords []string `json:"b"`
+}
+
+type MemoryLayout struct {
+	ScreenNumber     int   `json:"s"`
+	DisplayedNumbers []int `json:"d"`
+}
+
+type MorseLayout struct {
+	Pattern  string  `json:"p"`
+	Solution float32 `json:"s"`
+	// Index of the frequency the module starts tuned to
+	StartIdx int `json:"i"`
+}
+
+type MazeLayout struct {
+	Goal    valueobject.Point2D `json:"g"`
+	Player  valueobject.Point2D `json:"p"`
+	Variant int                 `json:"v"`
+}
+
+type NeedyVentGasLayout struct {
+	QuestionIdx int8 `json:"q"`
+}
+
+type NeedyKnobLayout struct {
+	Pattern       [][]bool                      `json:"p"`
+	DialDirection valueobject.CardinalDirection `json:"d"`
+}
+
+// Captures the bomb's layout. Modules are captured as they are now, so export a bomb
+// before it's played to share its starting state. Each module type exports itself through
+// its registration.
+func ExportBombLayout(bomb *Bomb) (BombLayout, error) {
+	layout := BombLayout{
+		TimerSeconds:     int(bomb.Clock.Duration.Seconds()),
+		MaxStrikes:       bomb.MaxStrikes,
+		StrikeTimerRates: bomb.StrikeTimerRates,
+		SerialNumber:     bomb.SerialNumber,
+		BatteryHolders:   bomb.BatteryHolders,
+		PortPlates:       bomb.PortPlates,
+	}
+
+	for _, label := range valueobject.AVAILABLE_INDICATOR_LABELS {
+		if indicator, ok := bomb.Indicators[label]; ok {
+			layout.Indicators = append(layout.Indicators, indicator)
+		}
+	}
+
+	// In position order, as Simon modules can share a random generator
+	modules := slices.Collect(maps.Values(bomb.Modules))
+	sort.Slice(modules, func(i, j int) bool {
+		return positionLess(modules[i].GetPosition(), modules[j].GetPosition())
+	})
+	for _, module := range modules {
+		moduleLayout, err := exportModuleLayout(module)
+		if err != nil {
+			return BombLayout{}, err
+		}
+		layout.Modules = append(layout.Modules, moduleLayout)
+	}
+
+	return layout, nil
+}
+
+// Describes the layout as a bomb config, for reporting what an imported bomb looks like.
+func (l BombLayout) Config() valueobject.BombConfig {
+	config := valueobject.BombConfig{
+		Timer:            time.Duration(l.TimerSeconds) * time.Second,
+		MaxStrikes:       l.MaxStrikes,
+		StrikeTimerRates: l.StrikeTimerRates,
+		MinModules:       len(l.Modules),
+	}
+
+	perFace := make(map[int]int)
+	for _, module := range l.Modules {
+		perFace[module.Position.Face]++
+		config.NumFaces = max(config.NumFaces, module.Position.Face+1)
+		config.Rows = max(config.Rows, module.Position.Row+1)
+		config.Columns = max(config.Columns, module.Position.Column+1)
+	}
+	for _, count := range perFace {
+		config.MaxModulesPerFace = max(config.MaxModulesPerFace, count)
+	}
+
+	return config
+}
+
+func exportModuleLayout(module Module) (ModuleLayout, error) {
+	layout := ModuleLayout{
+		Type:     module.GetType(),
+		Position: module.GetPosition(),
+	}
+
+	registration, ok := moduleRegistry[layout.Type]
+	if !ok {
+		return ModuleLayout{}, fmt.Errorf("can't export module type %v", layout.Type)
+	}
+	if err := registration.export(module, &layout); err != nil {
+		return ModuleLayout{}, err
+	}
+
+	return layout, nil
+}
+
+// Rebuilds a bomb from its layout. The random generator only drives what modules generate
+// while they're played, such as the needy knob's later patterns. Layouts that don't match
+// anything the bomb factory could build are rejected with ValidationErrors.
+func NewBombFromLayout(rng ports.RandomGenerator, layout BombLayout) (*Bomb, error) {
+	if errs := ValidateBombLayout(layout); errs.HasErrors() {
+		return nil, errs
+	}
+
+	indicators := make(map[string]valueobject.Indicator, len(layout.Indicators))
+	for _, indicator := range layout.Indicators {
+		indicators[indicator.Label] = indicator
+	}
+
+	bomb := &Bomb{
+		ID:               uuid.New(),
+		SerialNumber:     layout.SerialNumber,
+		Clock:            NewBombClock(time.Duration(layout.TimerSeconds) * time.Second),
+		MaxStrikes:       layout.MaxStrikes,
+		StrikeTimerRates: layout.StrikeTimerRates,
+		Faces:            make(map[int]*BombFace),
+		Modules:          make(map[uuid.UUID]Module),
+		Indicators:       indicators,
+		Batteries:        countBatteries(layout.BatteryHolders),
+		BatteryHolders:   layout.BatteryHolders,
+		Ports:            flattenPortPlates(layout.PortPlates),
+		PortPlates:       layout.PortPlates,
+	}
+
+	for _, moduleLayout := range layout.Modules {
+		// Validation only passes layouts of registered types
+		module := moduleRegistry[moduleLayout.Type].rebuild(rng, moduleLayout)
+		module.SetBomb(bomb)
+		module.SetPosition(moduleLayout.Position)
+		if err := bomb.AddModule(module, moduleLayout.Position); err != nil {
+			return nil, fmt.Errorf("module at %v: %w", moduleLayout.Position, err)
+		}
+	}
+
+	return bomb, nil
+}
+
+func positionLess(a, b valueobject.ModulePosition) bool {
+	if a.Face != b.Face {
+		return a.Face < b.Face
+	}
+	if a.Row != b.Row {
+		return a.Row < b.Row
+	}
+	return a.Column < b.Column
+}
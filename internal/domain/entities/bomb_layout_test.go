@@ -0,0 +1,143 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+)
+
+// Exports a freshly generated bomb holding one of every module the factory can build
+func newEveryModuleLayout(t *testing.T) entities.BombLayout {
+	rng := services.NewSeededRNGFromString("layout")
+	var modules []valueobject.ModuleSpec
+	for _, moduleType := range entities.RegisteredModuleTypes() {
+		if moduleType != valueobject.ClockModule {
+			modules = append(modules, valueobject.ModuleSpec{Type: moduleType, Count: 1})
+		}
+	}
+	config := valueobject.NewBombConfigBuilder().FromMissionDefinition(valueobject.MissionDefinition{
+		Name:       "Everything",
+		Timer:      5 * time.Minute,
+		MaxStrikes: 3,
+		NumFaces:   2,
+		Rows:       2,
+		Columns:    4,
+		Modules:    modules,
+	})
+	bomb := services.NewBombFactory(services.NewModuleFactory(rng)).CreateBomb(rng, config)
+
+	layout, err := entities.ExportBombLayout(bomb)
+	assert.NoError(t, err)
+	return layout
+}
+
+func moduleOfType(layout entities.BombLayout, moduleType valueobject.ModuleType) *entities.ModuleLayout {
+	for i := range layout.Modules {
+		if layout.Modules[i].Type == moduleType {
+			return &layout.Modules[i]
+		}
+	}
+	return nil
+}
+
+func TestNewBombFromLayout_RejectsLayoutsTheFactoryCouldntBuild(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		tamper func(layout *entities.BombLayout)
+	}{
+		{"serial number", func(l *entities.BombLayout) { l.SerialNumber = "OOPS" }},
+		{"indicator", func(l *entities.BombLayout) {
+			l.Indicators = append(l.Indicators, valueobject.Indicator{Label: "XYZ"})
+		}},
+		{"port plate", func(l *entities.BombLayout) {
+			l.PortPlates = []valueobject.PortPlate{{Ports: []valueobject.Port{valueobject.PortSerial, valueobject.PortRJ45}}}
+		}},
+		{"shared position", func(l *entities.BombLayout) { l.Modules[1].Position = l.Modules[0].Position }},
+		{"negative position", func(l *entities.BombLayout) { l.Modules[0].Position.Row = -1 }},
+		{"wire color", func(l *entities.BombLayout) {
+			moduleOfType(*l, valueobject.WiresModule).Wires[0].WireColor = valueobject.Pink
+		}},
+		{"password solution", func(l *entities.BombLayout) {
+			moduleOfType(*l, valueobject.PasswordModule).Password.Solution = "zzzzz"
+		}},
+		{"password letters", func(l *entities.BombLayout) {
+			moduleOfType(*l, valueobject.PasswordModule).Password.Letters[2][0] = "?"
+		}},
+		{"button color", func(l *entities.BombLayout) {
+			moduleOfType(*l, valueobject.BigButtonModule).BigButton.Color = valueobject.Green
+		}},
+		{"button label", func(l *entities.BombLayout) {
+			moduleOfType(*l, valueobject.BigButtonModule).BigButton.Label = "Defuse"
+		}},
+		{"keypad symbol", func(l *entities.BombLayout) {
+			moduleOfType(*l, valueobject.KeypadModule).Keypad.Symbols[0] = valueobject.Teepee
+		}},
+		{"keypad solution", func(l *entities.BombLayout) {
+			keypad := moduleOfType(*l, valueobject.KeypadModule).Keypad
+			keypad.Solution[0], keypad.Solution[1] = keypad.Solution[1], keypad.Solution[0]
+		}},
+		{"simon color", func(l *entities.BombLayout) {
+			moduleOfType(*l, valueobject.SimonModule).Simon.Sequence[1] = valueobject.Black
+		}},
+		{"simon length", func(l *entities.BombLayout) {
+			moduleOfType(*l, valueobject.SimonModule).Simon.Sequence = []valueobject.Color{valueobject.Red}
+		}},
+		{"screen word", func(l *entities.BombLayout) {
+			moduleOfType(*l, valueobject.WhosOnFirstModule).WhosOnFirst.ScreenWord = "HELLO"
+		}},
+		{"memory numbers", func(l *entities.BombLayout) {
+			moduleOfType(*l, valueobject.MemoryModule).Memory.DisplayedNumbers = []int{1, 1, 2, 3}
+		}},
+		{"morse solution", func(l *entities.BombLayout) {
+			moduleOfType(*l, valueobject.MorseModule).Morse.Solution = 1
+		}},
+		{"maze variant", func(l *entities.BombLayout) {
+			moduleOfType(*l, valueobject.MazeModule).Maze.Variant = 9
+		}},
+		{"maze position", func(l *entities.BombLayout) {
+			moduleOfType(*l, valueobject.MazeModule).Maze.Player = valueobject.Point2D{X: 6, Y: 0}
+		}},
+		{"knob pattern", func(l *entities.BombLayout) {
+			moduleOfType(*l, valueobject.NeedyKnobModule).NeedyKnob.Pattern = [][]bool{{true}}
+		}},
+		{"knob direction", func(l *entities.BombLayout) {
+			moduleOfType(*l, valueobject.NeedyKnobModule).NeedyKnob.DialDirection = 7
+		}},
+		{"missing state", func(l *entities.BombLayout) {
+			moduleOfType(*l, valueobject.MemoryModule).Memory = nil
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			layout := newEveryModuleLayout(t)
+			tc.tamper(&layout)
+
+			// Act
+			bomb, err := entities.NewBombFromLayout(services.NewSeededRNGFromString("layout"), layout)
+
+			// Assert
+			var validationErrs valueobject.ValidationErrors
+			assert.ErrorAs(t, err, &validationErrs)
+			assert.Nil(t, bomb)
+		})
+	}
+}
+
+func TestNewBombFromLayout_SimonPlaysTheWholeExportedSequence(t *testing.T) {
+	// Arrange
+	layout := newEveryModuleLayout(t)
+	sequence := moduleOfType(layout, valueobject.SimonModule).Simon.Sequence
+
+	// Act
+	bomb, err := entities.NewBombFromLayout(services.NewSeededRNGFromString("other seed"), layout)
+	assert.NoError(t, err)
+	reexported, err := entities.ExportBombLayout(bomb)
+	assert.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, sequence, moduleOfType(reexported, valueobject.SimonModule).Simon.Sequence, "The seed shouldn't change the sequence")
+}
@@ -0,0 +1,172 @@
+package entities
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ZaneH/defuse.party-go/internal/application/common"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+)
+
+// Largest side of the maze grid, in cells
+const mazeSize = 6
+
+// Checks a layout against what the bomb factory can build. Layouts come from codes anyone
+// can edit, so every value a module reads is checked before the bomb is rebuilt.
+func ValidateBombLayout(layout BombLayout) valueobject.ValidationErrors {
+	var errs valueobject.ValidationErrors
+	add := func(field, format string, args ...any) {
+		errs = append(errs, valueobject.ValidationError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if len(layout.Modules) == 0 {
+		add("modules", "bomb layout has no modules")
+		return errs
+	}
+	errs = append(errs, valueobject.ValidateBombConfig(layout.Config())...)
+
+	if !isSerialNumber(layout.SerialNumber) {
+		add("serial_number", "%q isn't a serial number", layout.SerialNumber)
+	}
+
+	if len(layout.Indicators) > valueobject.MaxIndicatorsAllowed {
+		add("indicators", "cannot have more than %d", valueobject.MaxIndicatorsAllowed)
+	}
+	seenLabels := make(map[string]bool, len(layout.Indicators))
+	for _, indicator := range layout.Indicators {
+		if !slices.Contains(valueobject.AVAILABLE_INDICATOR_LABELS, indicator.Label) {
+			add("indicators", "unknown indicator %q", indicator.Label)
+		} else if seenLabels[indicator.Label] {
+			add("indicators", "%s appears more than once", indicator.Label)
+		}
+		seenLabels[indicator.Label] = true
+	}
+
+	for _, holder := range layout.BatteryHolders {
+		if holder.Type != valueobject.BatteryTypeAA && holder.Type != valueobject.BatteryTypeD {
+			add("battery_holders", "unknown battery type %d", holder.Type)
+		}
+	}
+	if batteries := countBatteries(layout.BatteryHolders); batteries > valueobject.MaxBatteriesAllowed {
+		add("battery_holders", "cannot hold more than %d batteries", valueobject.MaxBatteriesAllowed)
+	}
+
+	if len(layout.PortPlates) > valueobject.MaxPortPlatesAllowed {
+		add("port_plates", "cannot have more than %d plates", valueobject.MaxPortPlatesAllowed)
+	}
+	ports := 0
+	for _, plate := range layout.PortPlates {
+		ports += len(plate.Ports)
+		if !isPortPlate(plate) {
+			add("port_plates", "%v can't share a plate", plate.Ports)
+		}
+	}
+	if ports > valueobject.MaxPortsAllowed {
+		add("port_plates", "cannot have more than %d ports", valueobject.MaxPortsAllowed)
+	}
+
+	positions := make(map[valueobject.ModulePosition]bool, len(layout.Modules))
+	for i, module := range layout.Modules {
+		field := fmt.Sprintf("modules[%d]", i)
+		position := module.Position
+		if position.Face < 0 || position.Row < 0 || position.Column < 0 {
+			add(field+".position", "%v is off the bomb", position)
+		} else if positions[position] {
+			add(field+".position", "%v already holds a module", position)
+		}
+		positions[position] = true
+
+		errs = append(errs, validateModuleLayout(field, module)...)
+	}
+
+	return errs
+}
+
+func validateModuleLayout(field string, layout ModuleLayout) valueobject.ValidationErrors {
+	errs := &moduleLayoutErrors{field: field}
+
+	if registration, ok := moduleRegistry[layout.Type]; ok {
+		registration.validate(layout, errs)
+	} else {
+		errs.add(".type", "can't import module type %v", layout.Type)
+	}
+
+	return errs.errs
+}
+
+// Serial numbers are two letters or digits, a digit, two letters, then a digit
+func isSerialNumber(serial string) bool {
+	if len(serial) != 6 {
+		return false
+	}
+
+	letters, digits := serialNumberLetters, common.DIGITS
+	for i, allowed := range []string{letters + digits, letters + digits, digits, letters, letters, digits} {
+		if !strings.ContainsRune(allowed, rune(serial[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// A plate holds each port once, and only ports from one of the plate layouts
+func isPortPlate(plate valueobject.PortPlate) bool {
+	if hasDuplicates(plate.Ports) {
+		return false
+	}
+
+	for _, layout := range valueobject.PORT_PLATE_LAYOUTS {
+		fits := true
+		for _, port := range plate.Ports {
+			fits = fits && slices.Contains(layout, port)
+		}
+		if fits {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns the keypad column holding every symbol, or nil if there isn't one or a symbol
+// repeats
+func keypadColumnFor(symbols []valueobject.Symbol) []valueobject.Symbol {
+	if hasDuplicates(symbols) {
+		return nil
+	}
+
+	for _, column := range columns {
+		fits := true
+		for _, symbol := range symbols {
+			fits = fits && slices.Contains(column, symbol)
+		}
+		if fits {
+			return column
+		}
+	}
+	return nil
+}
+
+func morseWordForPattern(pattern string) (string, bool) {
+	for _, word := range morseWords {
+		if morseTranslations[word] == pattern {
+			return word, true
+		}
+	}
+	return "", false
+}
+
+func onMaze(p valueobject.Point2D) bool {
+	return p.X >= 0 && p.X < mazeSize && p.Y >= 0 && p.Y < mazeSize
+}
+
+func hasDuplicates[T comparable](values []T) bool {
+	seen := make(map[T]bool, len(values))
+	for _, value := range values {
+		if seen[value] {
+			return true
+		}
+		seen[value] = true
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// What a bomb explodes from
+type ExplosionCause int
+
+const (
+	ExplosionCauseStrikes ExplosionCause = iota
+	ExplosionCauseTimer
+)
+
+// A modifier's rules for a single bomb. The bomb runs every one of its modifiers at each
+// point of its lifecycle, in the order they were given, so a modifier only has to change
+// the hooks it cares about. Modifiers that only change what players are shown leave every
+// hook as it is.
+type BombModifier interface {
+	Type() valueobject.Modifier
+	// Runs when a module gives the bomb a strike, before it's counted. Returning false
+	// drops the strike.
+	AllowStrike(bomb *Bomb, moduleID uuid.UUID) bool
+	// Runs once a strike has been counted
+	OnStrike(bomb *Bomb, moduleID uuid.UUID)
+	// Runs when a module is solved
+	OnSolve(bomb *Bomb, moduleID uuid.UUID)
+	// Returns whether the bomb may explode from the given cause. The bomb explodes only if
+	// every modifier allows it.
+	CanExplode(cause ExplosionCause) bool
+	// Returns what the bomb's timer shows at the given moment, given what the modifiers
+	// before this one made it show
+	ShowTimer(bomb *Bomb, at time.Time, shown time.Duration) time.Duration
+}
+
+// Creates the rules for a modifier. Each bomb needs its own, as some keep track of the
+// bomb they're on.
+func NewBombModifier(modifier valueobject.Modifier) BombModifier {
+	base := baseModifier{modifier: modifier}
+
+	switch modifier {
+	case valueobject.ModifierOneStrikePerModule:
+		return &oneStrikePerModuleModifier{baseModifier: base, struck: make(map[uuid.UUID]bool)}
+	case valueobject.ModifierZen:
+		return zenModifier{baseModifier: base}
+	case valueobject.ModifierTimeMode:
+		return timeModeModifier{baseModifier: base}
+	default:
+		return base
+	}
+}
+
+// Leaves every hook as it is
+type baseModifier struct {
+	modifier valueobject.Modifier
+}
+
+func (m baseModifier) Type() valueobject.Modifier {
+	return m.modifier
+}
+
+func (m baseModifier) AllowStrike(bomb *Bomb, moduleID uuid.UUID) bool {
+	return true
+}
+
+func (m baseModifier) OnStrike(bomb *Bomb, moduleID uuid.UUID) {}
+
+func (m baseModifier) OnSolve(bomb *Bomb, moduleID uuid.UUID) {}
+
+func (m baseModifier) CanExplode(cause ExplosionCause) bool {
+	return true
+}
+
+func (m baseModifier) ShowTimer(bomb *Bomb, at time.Time, shown time.Duration) time.Duration {
+	return shown
+}
+
+type oneStrikePerModuleModifier struct {
+	baseModifier
+	// Modules that have already given the bomb a strike
+	struck map[uuid.UUID]bool
+}
+
+func (m *oneStrikePerModuleModifier) AllowStrike(bomb *Bomb, moduleID uuid.UUID) bool {
+	return !m.struck[moduleID]
+}
+
+func (m *oneStrikePerModuleModifier) OnStrike(bomb *Bomb, moduleID uuid.UUID) {
+	m.struck[moduleID] = true
+}
+
+type zenModifier struct {
+	baseModifier
+}
+
+func (m zenModifier) CanExplode(cause ExplosionCause) bool {
+	return false
+}
+
+func (m zenModifier) ShowTimer(bomb *Bomb, at time.Time, shown time.Duration) time.Duration {
+	return bomb.Clock.ElapsedAt(at)
+}
+
+type timeModeModifier struct {
+	baseModifier
+}
+
+func (m timeModeModifier) OnStrike(bomb *Bomb, moduleID uuid.UUID) {
+	bomb.Clock.Adjust(-valueobject.TimeModeStrikePenalty)
+}
+
+func (m timeModeModifier) OnSolve(bomb *Bomb, moduleID uuid.UUID) {
+	bomb.Clock.Adjust(valueobject.TimeModeSolveBonus)
+}
+
+func (m timeModeModifier) CanExplode(cause ExplosionCause) bool {
+	return cause != ExplosionCauseStrikes
+}
@@ -0,0 +1,89 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// Arms a bomb with the modifier at the given moment. The bomb holds a single unsolved wires
+// module and explodes on its first strike, unless the modifier says otherwise.
+func newModifiedBomb(t *testing.T, modifier valueobject.Modifier, armedAt time.Time) (*entities.Bomb, uuid.UUID) {
+	rng := services.NewSeededRNGFromString("modifier")
+	bomb := entities.NewBomb(rng, valueobject.NewDefaultBombConfig())
+	bomb.MaxStrikes = 1
+	bomb.StrikeTimerRates = nil
+	bomb.SetModifiers([]valueobject.Modifier{modifier})
+
+	wiresModule := entities.NewWiresModule(rng)
+	assert.NoError(t, bomb.AddModule(wiresModule, valueobject.ModulePosition{}))
+
+	bomb.StartTimer(armedAt)
+	return bomb, wiresModule.GetModuleID()
+}
+
+func TestBombModifier_ExplosionCauses(t *testing.T) {
+	for _, tc := range []struct {
+		modifier valueobject.Modifier
+		strikes  bool
+		timer    bool
+	}{
+		// Modifiers that only change what players see keep both causes
+		{modifier: valueobject.ModifierHiddenStrikes, strikes: true, timer: true},
+		{modifier: valueobject.ModifierZen, strikes: false, timer: false},
+		{modifier: valueobject.ModifierTimeMode, strikes: false, timer: true},
+	} {
+		t.Run(tc.modifier.String(), func(t *testing.T) {
+			// Arrange
+			modifier := entities.NewBombModifier(tc.modifier)
+			struckBomb, moduleID := newModifiedBomb(t, tc.modifier, time.Now())
+			timedOutBomb, _ := newModifiedBomb(t, tc.modifier, time.Now().Add(-time.Hour))
+
+			// Act
+			struckBomb.RecordStrike(moduleID)
+
+			// Assert
+			assert.Equal(t, tc.strikes, modifier.CanExplode(entities.ExplosionCauseStrikes))
+			assert.Equal(t, tc.timer, modifier.CanExplode(entities.ExplosionCauseTimer))
+			assert.Equal(t, tc.strikes, struckBomb.GetState() == valueobject.BombStateExploded, "Running out of strikes")
+			assert.Equal(t, tc.timer, timedOutBomb.GetState() == valueobject.BombStateExploded, "Running out of time")
+		})
+	}
+}
+
+func TestBombModifier_TimeModeAdjustsTheClock(t *testing.T) {
+	// Arrange
+	bomb, moduleID := newModifiedBomb(t, valueobject.ModifierTimeMode, clockStart)
+	timer := bomb.Clock.Duration
+
+	// Act
+	bomb.RecordStrike(moduleID)
+	struck := bomb.Clock.TimeLeftAt(clockStart)
+	bomb.RecordSolve(moduleID)
+	solved := bomb.Clock.TimeLeftAt(clockStart)
+
+	// Assert
+	assert.Equal(t, timer-valueobject.TimeModeStrikePenalty, struck)
+	assert.Equal(t, timer-valueobject.TimeModeStrikePenalty+valueobject.TimeModeSolveBonus, solved)
+}
+
+func TestBombModifier_ZenTimerShowsTimeElapsed(t *testing.T) {
+	// Arrange
+	bomb, _ := newModifiedBomb(t, valueobject.ModifierZen, clockStart)
+	timer := bomb.Clock.Duration
+
+	// Act
+	bomb.Clock.Pause(clockStart.Add(time.Minute))
+	bomb.Clock.Resume(clockStart.Add(3 * time.Minute))
+	shown := bomb.TimerAt(clockStart.Add(4 * time.Minute))
+
+	// Assert
+	assert.Equal(t, 2*time.Minute, shown, "The timer should count up, leaving out time spent paused")
+	assert.Equal(t, timer-2*time.Minute, bomb.Clock.TimeLeftAt(clockStart.Add(4*time.Minute)), "The clock itself still counts down")
+	assert.Equal(t, 2*time.Minute, bomb.Clock.ElapsedAt(clockStart.Add(4*time.Minute)))
+}
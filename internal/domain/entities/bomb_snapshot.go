@@ -0,0 +1,151 @@
+package entities
+
+import (
+	"maps"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// Copy of a bomb taken by the actor that owns it. Nothing in a snapshot is shared with the
+// live bomb, so it can be read from any goroutine while the game carries on.
+type BombSnapshot struct {
+	ID            uuid.UUID
+	SerialNumber  string
+	TimerDuration time.Duration
+	// When the bomb was armed, or nil if it's still waiting
+	StartedAt *time.Time
+	// When the clock was stopped because the bomb was defused or exploded
+	StoppedAt *time.Time
+	TimerRate float64
+	TimeLeft  time.Duration
+	// What the bomb's timer shows: the time left, unless a modifier shows something else
+	Timer         time.Duration
+	StrikeCount   int
+	MaxStrikes    int
+	State         valueobject.BombState
+	Practice      bool
+	ModulesSolved int
+	ModulesTotal  int
+	// In the order they were given
+	Modifiers        []valueobject.Modifier
+	EdgeworkRevealed bool
+
+	Indicators     map[string]valueobject.Indicator
+	Batteries      int
+	BatteryHolders []valueobject.BatteryHolder
+	Ports          []valueobject.Port
+	PortPlates     []valueobject.PortPlate
+
+	// Sorted by face, row then column
+	Modules []ModuleSnapshot
+}
+
+// Returns whether players are kept from the bomb's strikes. Hidden strikes are shown once
+// the bomb is finished.
+func (b BombSnapshot) StrikesHidden() bool {
+	return !b.State.IsFinished() && slices.Contains(b.Modifiers, valueobject.ModifierHiddenStrikes)
+}
+
+// Returns whether players are kept from the bomb's time left. A hidden timer is shown once
+// the bomb is finished.
+func (b BombSnapshot) TimerHidden() bool {
+	return !b.State.IsFinished() && slices.Contains(b.Modifiers, valueobject.ModifierHiddenTimer)
+}
+
+type ModuleSnapshot struct {
+	ModuleID uuid.UUID
+	Type     valueobject.ModuleType
+	Position valueobject.ModulePosition
+	Solved   bool
+	// Copy of the module's state. Nil for modules without state, such as the clock.
+	State ModuleState
+	// Time left on a needy module's countdown when the snapshot was taken
+	CountdownRemaining time.Duration
+	// Strikes the bomb counted from the module, including needy countdowns that ran out
+	Strikes int
+}
+
+// Copies the bomb and every module on it. Must only be called by the goroutine that owns
+// the bomb.
+func (b *Bomb) Snapshot(now time.Time) BombSnapshot {
+	solved, total := b.GetSolvedModuleCount()
+
+	snapshot := BombSnapshot{
+		ID:               b.ID,
+		SerialNumber:     b.SerialNumber,
+		TimerDuration:    b.Clock.Duration,
+		StartedAt:        b.Clock.StartedAt(),
+		StoppedAt:        b.Clock.StoppedAt(),
+		TimerRate:        b.Clock.Rate(),
+		TimeLeft:         b.Clock.TimeLeftAt(now),
+		Timer:            b.TimerAt(now),
+		StrikeCount:      b.StrikeCount,
+		MaxStrikes:       b.MaxStrikes,
+		State:            b.GetState(),
+		Practice:         b.Practice,
+		ModulesSolved:    solved,
+		ModulesTotal:     total,
+		Modifiers:        b.ModifierTypes(),
+		EdgeworkRevealed: b.EdgeworkRevealed,
+		Indicators:       maps.Clone(b.Indicators),
+		Batteries:        b.Batteries,
+		BatteryHolders:   slices.Clone(b.BatteryHolders),
+		Ports:            slices.Clone(b.Ports),
+		PortPlates:       clonePortPlates(b.PortPlates),
+		Modules:          make([]ModuleSnapshot, 0, len(b.Modules)),
+	}
+
+	for _, module := range b.Modules {
+		moduleSnapshot := snapshotModule(module, now)
+		moduleSnapshot.Strikes = b.ModuleStrikes(module.GetModuleID())
+		snapshot.Modules = append(snapshot.Modules, moduleSnapshot)
+	}
+
+	sort.Slice(snapshot.Modules, func(i, j int) bool {
+		a, b := snapshot.Modules[i].Position, snapshot.Modules[j].Position
+		if a.Face != b.Face {
+			return a.Face < b.Face
+		}
+		if a.Row != b.Row {
+			return a.Row < b.Row
+		}
+		return a.Column < b.Column
+	})
+
+	return snapshot
+}
+
+func clonePortPlates(plates []valueobject.PortPlate) []valueobject.PortPlate {
+	cloned := make([]valueobject.PortPlate, len(plates))
+	for i, plate := range plates {
+		cloned[i] = plate
+		cloned[i].Ports = slices.Clone(plate.Ports)
+	}
+	return cloned
+}
+
+func snapshotModule(module Module, now time.Time) ModuleSnapshot {
+	snapshot := ModuleSnapshot{
+		ModuleID: module.GetModuleID(),
+		Type:     module.GetType(),
+		Position: module.GetPosition(),
+	}
+
+	if registration, ok := moduleRegistry[snapshot.Type]; ok {
+		snapshot.State = registration.snapshot(module)
+	}
+
+	if needy, ok := module.(NeedyModule); ok {
+		snapshot.CountdownRemaining = needy.CountdownRemaining(now)
+	}
+
+	if snapshot.State != nil {
+		snapshot.Solved = snapshot.State.IsSolved()
+	}
+
+	return snapshot
+}
@@ -31,14 +31,10 @@ func (m *ClockModule) GetModuleState() ModuleState {
 func (m *ClockModule) String() string {
 	var result string
 
-	duration := m.GetBomb().TimerDuration
-	started_at := m.GetBomb().StartedAt
-	if started_at != nil {
-		remaining := duration - time.Since(*started_at)
-		if remaining < 0 {
-			remaining = 0
-		}
-		result = fmt.Sprintf("Clock Module: %s", remaining)
+	clock := m.GetBomb().Clock
+	if clock.IsStarted() {
+		remaining := clock.TimeLeftAt(time.Now())
+		result = fmt.Sprintf("Clock Module: %s (x%.2f)", remaining, clock.Rate())
 	} else {
 		result = "Clock Module: Not started"
 	}
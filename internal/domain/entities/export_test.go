@@ -0,0 +1,19 @@
+package entities
+
+import (
+	"fmt"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+)
+
+// Reports which part of a module type's registration is missing.
+func CheckModuleRegistration(moduleType valueobject.ModuleType) error {
+	registration, ok := moduleRegistry[moduleType]
+	if !ok {
+		return fmt.Errorf("not registered")
+	}
+	if part := registration.missingPart(); part != "" {
+		return fmt.Errorf("no %s", part)
+	}
+	return nil
+}
@@ -1,25 +1,337 @@
 package entities
 
 import (
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/ZaneH/defuse.party-go/internal/domain/ports"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
 	"github.com/google/uuid"
 )
 
 type GameSession struct {
-	SessionID     uuid.UUID
+	SessionID uuid.UUID
+	// Nil while the session is waiting in its lobby
 	GameStartedAt *time.Time
 	RandomService ports.RandomGenerator
+	// Seed the session's bombs were generated from
+	Seed string
+	// Leaderboard a completed session is ranked on, empty if the session isn't ranked
+	Leaderboard valueobject.LeaderboardKey
+	// Level for level games, section for missions and 0 for custom and practice games
+	Difficulty int
+	// Per-module interaction history used to build the end-of-game report
+	ModuleStats map[uuid.UUID]*ModuleStats
+	// Strikes each defuser caused, by player ID. Strikes from input sent without a player
+	// ID aren't attributed to anyone.
+	DefuserStrikes map[string]int
+	// Players in the order they joined the lobby
+	players []valueobject.LobbyPlayer
+	// Set for competitive sessions, whose clocks must never stop
+	PauseDisabled bool
+	paused        bool
+	// How far behind the game spectators are kept so they can't help the players
+	SpectatorDelay time.Duration
+	// Set for sessions in a versus match, which start together with the rest of the match
+	// rather than from their own lobby
+	StartedByMatch bool
+	// Faces that have modules on any of the session's bombs
+	faces map[int]bool
+}
+
+// Records how a player interacted with a single module.
+type ModuleStats struct {
+	FirstInteractionAt *time.Time
+	SolvedAt           *time.Time
+	Strikes            int
 }
 
 func NewGameSession(sessionID uuid.UUID) *GameSession {
 	return &GameSession{
-		SessionID:     sessionID,
-		GameStartedAt: nil,
+		SessionID:      sessionID,
+		GameStartedAt:  nil,
+		ModuleStats:    make(map[uuid.UUID]*ModuleStats),
+		DefuserStrikes: make(map[string]int),
+		faces:          make(map[int]bool),
 	}
 }
 
 func (g *GameSession) SetRandomGenerator(rng ports.RandomGenerator) {
 	g.RandomService = rng
 }
+
+// Records an input a player sent to a module. The first input marks the first interaction
+// and the first input that leaves the module solved marks its solve time. Strikes are only
+// recorded when the bomb counted them.
+func (g *GameSession) RecordModuleInput(moduleID uuid.UUID, playerID string, at time.Time, strike bool, solved bool) {
+	stats, exists := g.ModuleStats[moduleID]
+	if !exists {
+		stats = &ModuleStats{}
+		g.ModuleStats[moduleID] = stats
+	}
+
+	if stats.FirstInteractionAt == nil {
+		stats.FirstInteractionAt = &at
+	}
+
+	if strike {
+		stats.Strikes++
+		if playerID = strings.TrimSpace(playerID); playerID != "" {
+			g.DefuserStrikes[playerID]++
+		}
+	}
+
+	if solved && stats.SolvedAt == nil {
+		stats.SolvedAt = &at
+	}
+}
+
+func (g *GameSession) IsStarted() bool {
+	return g.GameStartedAt != nil
+}
+
+// Adds a player to the lobby. A player who joins again changes role, gives up their faces
+// and has to mark themselves ready again.
+func (g *GameSession) Join(playerID string, role valueobject.PlayerRole) error {
+	if errs := valueobject.ValidateLobbyPlayer(playerID, role); errs.HasErrors() {
+		return errs
+	}
+	if g.IsStarted() && role != valueobject.PlayerRoleSpectator {
+		return valueobject.ErrGameAlreadyStarted
+	}
+
+	playerID = strings.TrimSpace(playerID)
+	for i, player := range g.players {
+		if player.PlayerID == playerID {
+			// A player can't step out of a started game by becoming a spectator
+			if g.IsStarted() && player.Role != valueobject.PlayerRoleSpectator {
+				return valueobject.ErrGameAlreadyStarted
+			}
+			g.players[i] = valueobject.LobbyPlayer{PlayerID: playerID, Role: role}
+			return nil
+		}
+	}
+
+	g.players = append(g.players, valueobject.LobbyPlayer{PlayerID: playerID, Role: role})
+	return nil
+}
+
+// Returns the player who joined with the given ID.
+func (g *GameSession) Player(playerID string) (valueobject.LobbyPlayer, bool) {
+	playerID = strings.TrimSpace(playerID)
+	for _, player := range g.players {
+		if player.PlayerID == playerID {
+			return player, true
+		}
+	}
+	return valueobject.LobbyPlayer{}, false
+}
+
+func (g *GameSession) SetReady(playerID string, ready bool) error {
+	if g.IsStarted() {
+		return valueobject.ErrGameAlreadyStarted
+	}
+
+	playerID = strings.TrimSpace(playerID)
+	for i, player := range g.players {
+		if player.PlayerID == playerID {
+			g.players[i].Ready = ready
+			return nil
+		}
+	}
+
+	return valueobject.ErrPlayerNotInLobby
+}
+
+// Returns whether anyone playing has joined and every player who has is ready.
+func (g *GameSession) AllReady() bool {
+	return g.Lobby().AllReady()
+}
+
+// Ends the lobby phase. Players don't have to be ready for the game to be started.
+func (g *GameSession) Start(at time.Time) error {
+	if g.IsStarted() {
+		return valueobject.ErrGameAlreadyStarted
+	}
+
+	g.GameStartedAt = &at
+	return nil
+}
+
+func (g *GameSession) IsPaused() bool {
+	return g.paused
+}
+
+// Pauses a started game. The bombs' clocks have to be paused along with it.
+func (g *GameSession) Pause() error {
+	switch {
+	case g.PauseDisabled:
+		return valueobject.ErrPauseDisabled
+	case !g.IsStarted():
+		return valueobject.ErrGameNotStarted
+	case g.paused:
+		return valueobject.ErrGamePaused
+	}
+
+	g.paused = true
+	return nil
+}
+
+func (g *GameSession) Resume() error {
+	if !g.paused {
+		return valueobject.ErrGameNotPaused
+	}
+
+	g.paused = false
+	return nil
+}
+
+// Records the faces a bomb has modules on, so that defusers can be given them.
+func (g *GameSession) AddBombFaces(faces ...int) {
+	for _, face := range faces {
+		g.faces[face] = true
+	}
+}
+
+// Hands faces to a defuser, taking them from whoever owned them before. The defuser keeps
+// the faces they already owned. Faces can change hands at any point in the game, but only
+// the defuser themselves or the session's host can hand them out.
+func (g *GameSession) AssignFaces(callerID string, playerID string, faces []int) error {
+	var errs valueobject.ValidationErrors
+	for _, face := range faces {
+		if !g.faces[face] {
+			errs = append(errs, valueobject.ValidationError{Field: "faces", Message: fmt.Sprintf("no bomb in the session has face %d", face)})
+		}
+	}
+	if errs.HasErrors() {
+		return errs
+	}
+
+	playerID = strings.TrimSpace(playerID)
+	i := slices.IndexFunc(g.players, func(player valueobject.LobbyPlayer) bool {
+		return player.PlayerID == playerID
+	})
+	if i < 0 {
+		return valueobject.ErrPlayerNotInLobby
+	}
+	if g.players[i].Role != valueobject.PlayerRoleDefuser {
+		return valueobject.ErrNotDefuser
+	}
+
+	callerID = strings.TrimSpace(callerID)
+	if _, ok := g.Player(callerID); !ok {
+		return valueobject.ErrPlayerNotInLobby
+	}
+	if host, _ := g.Lobby().Host(); callerID != playerID && callerID != host.PlayerID {
+		return valueobject.ErrNotFaceAssigner
+	}
+
+	for j := range g.players {
+		g.players[j].Faces = slices.DeleteFunc(g.players[j].Faces, func(face int) bool {
+			return slices.Contains(faces, face)
+		})
+	}
+
+	owned := append(g.players[i].Faces, faces...)
+	slices.Sort(owned)
+	g.players[i].Faces = slices.Compact(owned)
+
+	for j := range g.players {
+		if len(g.players[j].Faces) == 0 {
+			g.players[j].Faces = nil
+		}
+	}
+	return nil
+}
+
+// Returns the defuser who owns the face.
+func (g *GameSession) FaceOwner(face int) (valueobject.LobbyPlayer, bool) {
+	for _, player := range g.players {
+		if slices.Contains(player.Faces, face) {
+			return player, true
+		}
+	}
+	return valueobject.LobbyPlayer{}, false
+}
+
+// Checks that a player can see the game's live state and pause it. Spectators only see the
+// game through their delayed watch. A session with a spectator delay also turns away callers
+// who don't say who they are, since a spectator could leave their ID out.
+func (g *GameSession) CheckPlaying(playerID string) error {
+	playerID = strings.TrimSpace(playerID)
+	if playerID == "" {
+		if g.SpectatorDelay > 0 {
+			return valueobject.ErrPlayerNotInLobby
+		}
+		return nil
+	}
+
+	player, ok := g.Player(playerID)
+	if !ok {
+		return valueobject.ErrPlayerNotInLobby
+	}
+	if player.Role == valueobject.PlayerRoleSpectator {
+		return valueobject.ErrSpectatorsOnlyWatch
+	}
+	return nil
+}
+
+// Checks that a player can send input to a module on the face. Faces nobody owns take
+// input from any defuser, so games without assigned faces play as before, while an owned
+// face only takes input from its owner.
+func (g *GameSession) CheckInput(playerID string, face int) error {
+	if err := g.CheckDefuser(playerID); err != nil {
+		return err
+	}
+
+	if owner, owned := g.FaceOwner(face); owned && owner.PlayerID != strings.TrimSpace(playerID) {
+		return valueobject.ErrFaceNotOwned
+	}
+	return nil
+}
+
+// Checks that a player can send input at all. A player ID, when given, has to belong to a
+// defuser. Sessions with a spectator delay or with assigned faces need one, since a
+// spectator could leave their ID out to get around either.
+func (g *GameSession) CheckDefuser(playerID string) error {
+	if err := g.CheckPlaying(playerID); err != nil {
+		return err
+	}
+
+	playerID = strings.TrimSpace(playerID)
+	if playerID == "" {
+		if g.facesAssigned() {
+			return valueobject.ErrPlayerNotInLobby
+		}
+		return nil
+	}
+	if player, _ := g.Player(playerID); player.Role != valueobject.PlayerRoleDefuser {
+		return valueobject.ErrNotDefuser
+	}
+	return nil
+}
+
+func (g *GameSession) facesAssigned() bool {
+	for _, player := range g.players {
+		if len(player.Faces) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Returns a copy of the lobby.
+func (g *GameSession) Lobby() valueobject.Lobby {
+	var players []valueobject.LobbyPlayer
+	for _, player := range g.players {
+		player.Faces = slices.Clone(player.Faces)
+		players = append(players, player)
+	}
+
+	return valueobject.Lobby{
+		Players: players,
+		Started: g.IsStarted(),
+	}
+}
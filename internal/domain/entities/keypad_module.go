@@ -84,6 +84,8 @@ func (m *KeypadModule) PressSymbol(sym valueobject.Symbol) (map[valueobject.Symb
 	m.State.ActivatedSymbols[sym] = true
 	idx := max(0, len(m.State.ActivatedSymbols)-1)
 
+	m.explain("keypad.column_order", fmt.Sprintf("find the column holding all four symbols → press them top to bottom: %v", m.State.solution))
+
 	// They hit the current symbol in the sequence
 	if sym == m.State.solution[idx] {
 		// They hit the last symbol in the sequence
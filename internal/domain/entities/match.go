@@ -0,0 +1,154 @@
+package entities
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// A versus match: teams racing each other on identical bombs, each in its own session
+type Match struct {
+	MatchID uuid.UUID
+	// Nil while the teams are waiting in their lobbies
+	StartedAt *time.Time
+	// In the order the teams were given
+	teams []valueobject.TeamProgress
+	// Names of the teams whose session has been handed out
+	claimed  map[string]bool
+	winner   string
+	finished bool
+}
+
+func NewMatch(matchID uuid.UUID, teams []valueobject.MatchTeam) *Match {
+	progress := make([]valueobject.TeamProgress, 0, len(teams))
+	for _, team := range teams {
+		progress = append(progress, valueobject.TeamProgress{
+			Team:      team.Name,
+			SessionID: team.SessionID,
+			State:     valueobject.SessionStateLobby,
+		})
+	}
+
+	return &Match{MatchID: matchID, teams: progress, claimed: make(map[string]bool)}
+}
+
+// Hands a team its session. Each team can only be claimed once, so only whoever joined the
+// team first knows its session and no team can play in or watch another's.
+func (m *Match) ClaimTeam(name string) (valueobject.MatchTeam, error) {
+	name = strings.TrimSpace(name)
+	i := slices.IndexFunc(m.teams, func(team valueobject.TeamProgress) bool {
+		return team.Team == name
+	})
+	if i < 0 {
+		return valueobject.MatchTeam{}, valueobject.ErrTeamNotInMatch
+	}
+	if m.claimed[name] {
+		return valueobject.MatchTeam{}, valueobject.ErrTeamClaimed
+	}
+
+	m.claimed[name] = true
+	return valueobject.MatchTeam{Name: name, SessionID: m.teams[i].SessionID}, nil
+}
+
+func (m *Match) IsStarted() bool {
+	return m.StartedAt != nil
+}
+
+func (m *Match) Start(at time.Time) error {
+	if m.IsStarted() {
+		return valueobject.ErrGameAlreadyStarted
+	}
+
+	m.StartedAt = &at
+	return nil
+}
+
+// Returns whether the match has been decided.
+func (m *Match) IsFinished() bool {
+	return m.finished
+}
+
+// Records a team's progress as of the given time. Returns whether the scoreboard changed.
+//
+// Progress can be reported out of order, so progress older than what the match already
+// has is ignored, as is anything reported once the match is decided or the team is
+// finished.
+func (m *Match) RecordProgress(progress valueobject.TeamProgress, at time.Time) bool {
+	if m.finished {
+		return false
+	}
+
+	i := slices.IndexFunc(m.teams, func(team valueobject.TeamProgress) bool {
+		return team.SessionID == progress.SessionID
+	})
+	if i < 0 {
+		return false
+	}
+
+	current := m.teams[i]
+	if current.IsFinished() || isStaleProgress(current, progress) {
+		return false
+	}
+
+	progress.Team = current.Team
+	progress.FinishedAt = nil
+	if progress.IsFinished() {
+		progress.FinishedAt = &at
+	}
+	if progress == current {
+		return false
+	}
+
+	m.teams[i] = progress
+	return true
+}
+
+// Returns whether a team has defused every bomb or every team's bombs have exploded.
+func (m *Match) CanDecide() bool {
+	return !slices.ContainsFunc(m.teams, func(team valueobject.TeamProgress) bool {
+		return team.State != valueobject.SessionStateFailed
+	}) || slices.ContainsFunc(m.teams, func(team valueobject.TeamProgress) bool {
+		return team.State == valueobject.SessionStateCompleted
+	})
+}
+
+// Ends the match once it can be decided. The team that defused every bomb first wins, and
+// nobody does if every team's bombs exploded.
+func (m *Match) Decide() {
+	if m.finished || !m.CanDecide() {
+		return
+	}
+
+	var first *valueobject.TeamProgress
+	for i, team := range m.teams {
+		if team.State == valueobject.SessionStateCompleted && (first == nil || team.FinishedAt.Before(*first.FinishedAt)) {
+			first = &m.teams[i]
+		}
+	}
+	if first != nil {
+		m.winner = first.Team
+	}
+	m.finished = true
+}
+
+// Solves and strikes only go up and a started session never goes back to its lobby, so
+// progress that goes backwards was taken before what the match already has.
+func isStaleProgress(current, next valueobject.TeamProgress) bool {
+	return next.ModulesSolved < current.ModulesSolved ||
+		next.Strikes < current.Strikes ||
+		(next.State == valueobject.SessionStateLobby && current.State != valueobject.SessionStateLobby)
+}
+
+// Returns a copy of every team's progress and the match's outcome.
+func (m *Match) Scoreboard() valueobject.Scoreboard {
+	return valueobject.Scoreboard{
+		MatchID:   m.MatchID,
+		Teams:     slices.Clone(m.teams),
+		StartedAt: m.StartedAt,
+		Winner:    m.winner,
+		Finished:  m.finished,
+	}
+}
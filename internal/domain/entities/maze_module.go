@@ -124,6 +124,7 @@ func (m *MazeModule) PressDirection(dir valueobject.CardinalDirection) (playerPo
 	}
 
 	if hasWall {
+		m.explain("maze.wall", fmt.Sprintf("maze identified by its markers has a wall %s of the player → take another path", dir))
 		return valueobject.Point2D{X: currentX, Y: currentY}, true, nil
 	}
 
@@ -89,6 +89,13 @@ func (m *MemoryModule) PressButton(btnIdx int) (strike bool, err error) {
 	n := m.State.DisplayedNumbers[btnIdx]
 	p := btnIdx + 1
 
+	if m.State.Stage >= 1 && m.State.Stage <= len(memoryRules) && m.State.ScreenNumber >= 1 && m.State.ScreenNumber <= 4 {
+		m.explain(
+			fmt.Sprintf("memory.stage_%d.display_%d", m.State.Stage, m.State.ScreenNumber),
+			fmt.Sprintf("stage %d, display %d → %s", m.State.Stage, m.State.ScreenNumber, memoryRules[m.State.Stage-1][m.State.ScreenNumber-1]),
+		)
+	}
+
 	switch m.State.Stage {
 	case 1:
 		// If the display is 1, press the button in the second position.
@@ -267,6 +274,15 @@ func (m *MemoryModule) PressButton(btnIdx int) (strike bool, err error) {
 	return true, nil
 }
 
+// What to press for each stage and display number, as the manual words it
+var memoryRules = [...][4]string{
+	{"press the second position", "press the second position", "press the third position", "press the fourth position"},
+	{"press the button labeled 4", "press the position pressed in stage 1", "press the first position", "press the position pressed in stage 1"},
+	{"press the label pressed in stage 2", "press the label pressed in stage 1", "press the third position", "press the button labeled 4"},
+	{"press the position pressed in stage 1", "press the first position", "press the position pressed in stage 2", "press the position pressed in stage 2"},
+	{"press the label pressed in stage 1", "press the label pressed in stage 2", "press the label pressed in stage 4", "press the label pressed in stage 3"},
+}
+
 func findButtonLabeled(number int, order []int) int {
 	for i, n := range order {
 		if n == number {
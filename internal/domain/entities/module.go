@@ -15,6 +15,8 @@ type Module interface {
 	// AddStrike()
 	SetPosition(position valueobject.ModulePosition)
 	SetBomb(bomb *Bomb)
+	// Returns the rule the most recent input was checked against, or nil if none was
+	GetLastRule() *valueobject.RuleExplanation
 }
 
 type ModuleState interface {
@@ -26,6 +28,7 @@ type BaseModule struct {
 	ModuleID uuid.UUID
 	Position valueobject.ModulePosition
 	bomb     *Bomb
+	lastRule *valueobject.RuleExplanation
 }
 
 func (m *BaseModule) SetBomb(bomb *Bomb) {
@@ -40,6 +43,18 @@ func (m *BaseModule) GetBomb() *Bomb {
 	return m.bomb
 }
 
+func (m *BaseModule) GetLastRule() *valueobject.RuleExplanation {
+	return m.lastRule
+}
+
+// Records the rule the current input is being checked against.
+func (m *BaseModule) explain(ruleID string, description string) {
+	m.lastRule = &valueobject.RuleExplanation{
+		RuleID:      ruleID,
+		Description: description,
+	}
+}
+
 func (m *BaseModule) GetPosition() valueobject.ModulePosition {
 	return m.Position
 }
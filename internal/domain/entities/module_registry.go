@@ -0,0 +1,497 @@
+package entities
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/ZaneH/defuse.party-go/internal/application/common"
+	"github.com/ZaneH/defuse.party-go/internal/domain/ports"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+)
+
+// Everything the domain needs to build, copy and share one module type. Implemented by
+// moduleKind so each part gets the concrete module type.
+type moduleRegistration interface {
+	create(rng ports.RandomGenerator) Module
+	snapshot(module Module) ModuleState
+	export(module Module, layout *ModuleLayout) error
+	validate(layout ModuleLayout, errs *moduleLayoutErrors)
+	rebuild(rng ports.RandomGenerator, layout ModuleLayout) Module
+	// Names the first part that isn't set, or returns "" if there are none
+	missingPart() string
+}
+
+// The parts of a module type's registration. Every field must be set.
+type moduleKind[M Module] struct {
+	// Builds the module, drawing its layout from the random generator
+	new func(rng ports.RandomGenerator) M
+	// Copies the module's state so the snapshot shares nothing with the live module. Returns
+	// nil for modules without state, such as the clock.
+	snapshotState func(module M) ModuleState
+	// Sets the module's field on its layout
+	exportLayout func(module M, layout *ModuleLayout) error
+	// Reports whether the layout has the module's field set
+	hasLayout func(layout ModuleLayout) bool
+	// Checks the module's field against what new could have built
+	validateLayout func(layout ModuleLayout, errs *moduleLayoutErrors)
+	// Rebuilds the module from a layout that passed validateLayout
+	importLayout func(rng ports.RandomGenerator, layout ModuleLayout) M
+}
+
+func (k moduleKind[M]) create(rng ports.RandomGenerator) Module {
+	return k.new(rng)
+}
+
+func (k moduleKind[M]) snapshot(module Module) ModuleState {
+	typed, ok := module.(M)
+	if !ok {
+		return nil
+	}
+	return k.snapshotState(typed)
+}
+
+func (k moduleKind[M]) export(module Module, layout *ModuleLayout) error {
+	typed, ok := module.(M)
+	if !ok {
+		return fmt.Errorf("expected %T but got %T", typed, module)
+	}
+	return k.exportLayout(typed, layout)
+}
+
+func (k moduleKind[M]) validate(layout ModuleLayout, errs *moduleLayoutErrors) {
+	if !k.hasLayout(layout) {
+		errs.add("", "%v module layout is missing its state", layout.Type)
+		return
+	}
+	k.validateLayout(layout, errs)
+}
+
+func (k moduleKind[M]) rebuild(rng ports.RandomGenerator, layout ModuleLayout) Module {
+	return k.importLayout(rng, layout)
+}
+
+func (k moduleKind[M]) missingPart() string {
+	switch {
+	case k.new == nil:
+		return "constructor"
+	case k.snapshotState == nil:
+		return "snapshot"
+	case k.exportLayout == nil:
+		return "layout export"
+	case k.hasLayout == nil || k.validateLayout == nil:
+		return "layout validation"
+	case k.importLayout == nil:
+		return "layout import"
+	}
+	return ""
+}
+
+// What's wrong with one module's layout, reported under its field in the bomb layout
+type moduleLayoutErrors struct {
+	field string
+	errs  valueobject.ValidationErrors
+}
+
+func (e *moduleLayoutErrors) add(subfield, format string, args ...any) {
+	e.errs = append(e.errs, valueobject.ValidationError{Field: e.field + subfield, Message: fmt.Sprintf(format, args...)})
+}
+
+// Every module type that can be placed on a bomb. A new module type is registered here
+// first; the module registry conformance tests then list the actor and gRPC registrations
+// it still needs.
+var moduleRegistry = map[valueobject.ModuleType]moduleRegistration{
+	valueobject.ClockModule: moduleKind[*ClockModule]{
+		new: func(ports.RandomGenerator) *ClockModule {
+			return NewClockModule()
+		},
+		snapshotState:  func(*ClockModule) ModuleState { return nil },
+		exportLayout:   func(*ClockModule, *ModuleLayout) error { return nil },
+		hasLayout:      func(ModuleLayout) bool { return true },
+		validateLayout: func(ModuleLayout, *moduleLayoutErrors) {},
+		importLayout: func(ports.RandomGenerator, ModuleLayout) *ClockModule {
+			return NewClockModule()
+		},
+	},
+	valueobject.WiresModule: moduleKind[*WiresModule]{
+		new: NewWiresModule,
+		snapshotState: func(m *WiresModule) ModuleState {
+			state := m.State
+			state.Wires = slices.Clone(state.Wires)
+			return &state
+		},
+		exportLayout: func(m *WiresModule, layout *ModuleLayout) error {
+			layout.Wires = m.State.Wires
+			return nil
+		},
+		hasLayout: func(layout ModuleLayout) bool { return len(layout.Wires) > 0 },
+		validateLayout: func(layout ModuleLayout, errs *moduleLayoutErrors) {
+			if len(layout.Wires) < minWires || len(layout.Wires) > maxWires {
+				errs.add(".wires", "must have between %d and %d wires", minWires, maxWires)
+			}
+			positions := make(map[int]bool, len(layout.Wires))
+			for _, wire := range layout.Wires {
+				if !slices.Contains(wireColors[:], wire.WireColor) {
+					errs.add(".wires", "%q isn't a wire color", wire.WireColor)
+				}
+				if wire.Position < 0 || wire.Position >= maxWires || positions[wire.Position] {
+					errs.add(".wires", "wire position %d is taken or off the module", wire.Position)
+				}
+				positions[wire.Position] = true
+				if wire.IsCut {
+					errs.add(".wires", "wires must start uncut")
+				}
+			}
+		},
+		importLayout: func(rng ports.RandomGenerator, layout ModuleLayout) *WiresModule {
+			module := NewWiresModule(rng)
+			module.SetState(WiresState{Wires: layout.Wires})
+			return module
+		},
+	},
+	valueobject.PasswordModule: moduleKind[*PasswordModule]{
+		new: func(rng ports.RandomGenerator) *PasswordModule {
+			return NewPasswordModule(rng, nil)
+		},
+		snapshotState: func(m *PasswordModule) ModuleState {
+			state := m.state
+			return &state
+		},
+		exportLayout: func(m *PasswordModule, layout *ModuleLayout) error {
+			layout.Password = &PasswordLayout{Letters: m.state.Letters, Solution: m.state.solution}
+			return nil
+		},
+		hasLayout: func(layout ModuleLayout) bool { return layout.Password != nil },
+		validateLayout: func(layout ModuleLayout, errs *moduleLayoutErrors) {
+			solution := layout.Password.Solution
+			if !slices.Contains(availablePasswordList[:], solution) {
+				errs.add(".password.solution", "%q isn't on the password list", solution)
+			}
+			for col, letters := range layout.Password.Letters {
+				seen := make(map[string]bool, len(letters))
+				for _, letter := range letters {
+					if len(letter) != 1 || !strings.Contains(common.ALPHABET, letter) || seen[letter] {
+						errs.add(".password.letters", "column %d must have six different letters", col)
+						break
+					}
+					seen[letter] = true
+				}
+				if col < len(solution) && !seen[solution[col:col+1]] {
+					errs.add(".password.letters", "column %d is missing %q from the solution", col, solution[col:col+1])
+				}
+			}
+		},
+		importLayout: func(rng ports.RandomGenerator, layout ModuleLayout) *PasswordModule {
+			module := NewPasswordModule(rng, &layout.Password.Solution)
+			module.state = PasswordState{Letters: layout.Password.Letters, solution: layout.Password.Solution}
+			return module
+		},
+	},
+	valueobject.BigButtonModule: moduleKind[*BigButtonModule]{
+		new: NewBigButtonModule,
+		snapshotState: func(m *BigButtonModule) ModuleState {
+			state := m.State
+			if state.ReleaseDigit != nil {
+				digit := *state.ReleaseDigit
+				state.ReleaseDigit = &digit
+			}
+			return &state
+		},
+		exportLayout: func(m *BigButtonModule, layout *ModuleLayout) error {
+			layout.BigButton = &BigButtonLayout{Color: m.State.ButtonColor, Label: m.State.Label}
+			return nil
+		},
+		hasLayout: func(layout ModuleLayout) bool { return layout.BigButton != nil },
+		validateLayout: func(layout ModuleLayout, errs *moduleLayoutErrors) {
+			if !slices.Contains(bigButtonColors[:], layout.BigButton.Color) {
+				errs.add(".big_button.color", "%q isn't a button color", layout.BigButton.Color)
+			}
+			if !slices.Contains(availableButtonWords[:], bigButtonWords(layout.BigButton.Label)) {
+				errs.add(".big_button.label", "%q isn't a button label", layout.BigButton.Label)
+			}
+		},
+		importLayout: func(rng ports.RandomGenerator, layout ModuleLayout) *BigButtonModule {
+			module := NewBigButtonModule(rng)
+			module.SetState(BigButtonState{ButtonColor: layout.BigButton.Color, Label: layout.BigButton.Label})
+			return module
+		},
+	},
+	valueobject.KeypadModule: moduleKind[*KeypadModule]{
+		new: NewKeypadModule,
+		snapshotState: func(m *KeypadModule) ModuleState {
+			state := m.State
+			state.DisplayedSymbols = slices.Clone(state.DisplayedSymbols)
+			state.ActivatedSymbols = maps.Clone(state.ActivatedSymbols)
+			state.solution = slices.Clone(state.solution)
+			return &state
+		},
+		exportLayout: func(m *KeypadModule, layout *ModuleLayout) error {
+			layout.Keypad = &KeypadLayout{Symbols: m.State.DisplayedSymbols, Solution: m.State.solution}
+			return nil
+		},
+		hasLayout: func(layout ModuleLayout) bool { return layout.Keypad != nil },
+		validateLayout: func(layout ModuleLayout, errs *moduleLayoutErrors) {
+			symbols := layout.Keypad.Symbols
+			column := keypadColumnFor(symbols)
+			if len(symbols) != nKeypadStages || column == nil {
+				errs.add(".keypad.symbols", "must be %d different symbols from the same column", nKeypadStages)
+			} else if !slices.Equal(layout.Keypad.Solution, generateKeypadSolution(symbols, column)) {
+				errs.add(".keypad.solution", "must be the symbols in column order")
+			}
+		},
+		importLayout: func(rng ports.RandomGenerator, layout ModuleLayout) *KeypadModule {
+			module := NewKeypadModule(rng)
+			module.SetState(KeypadState{
+				DisplayedSymbols: layout.Keypad.Symbols,
+				ActivatedSymbols: make(map[valueobject.Symbol]bool),
+				solution:         layout.Keypad.Solution,
+			})
+			return module
+		},
+	},
+	valueobject.SimonModule: moduleKind[*SimonModule]{
+		new: func(rng ports.RandomGenerator) *SimonModule {
+			return NewSimonModule(rng, nil)
+		},
+		snapshotState: func(m *SimonModule) ModuleState {
+			state := m.state
+			state.DisplaySequence = slices.Clone(state.DisplaySequence)
+			state.sequence = slices.Clone(state.sequence)
+			return &state
+		},
+		// Simon modules draw the rest of their sequence from their random generator, so the
+		// layout holds every color they'll flash
+		exportLayout: func(m *SimonModule, layout *ModuleLayout) error {
+			if m.state.nStages <= 0 {
+				return errors.New("simon module has no stages")
+			}
+			layout.Simon = &SimonLayout{Sequence: slices.Clone(m.plannedSequence(m.state.nStages))}
+			return nil
+		},
+		hasLayout: func(layout ModuleLayout) bool { return layout.Simon != nil },
+		validateLayout: func(layout ModuleLayout, errs *moduleLayoutErrors) {
+			sequence := layout.Simon.Sequence
+			if len(sequence) < minSimonStages || len(sequence) > minSimonStages+maxSimonStages {
+				errs.add(".simon.sequence", "must have between %d and %d colors", minSimonStages, minSimonStages+maxSimonStages)
+			}
+			for _, color := range sequence {
+				if !slices.Contains(simonColors[:], color) {
+					errs.add(".simon.sequence", "%q isn't a Simon color", color)
+				}
+			}
+		},
+		importLayout: func(rng ports.RandomGenerator, layout ModuleLayout) *SimonModule {
+			stages := len(layout.Simon.Sequence)
+			module := NewSimonModule(rng, &stages)
+			module.SetState(SimonState{
+				DisplaySequence: []valueobject.Color{layout.Simon.Sequence[0]},
+				nStages:         stages,
+				sequence:        slices.Clone(layout.Simon.Sequence),
+			})
+			return module
+		},
+	},
+	valueobject.WhosOnFirstModule: moduleKind[*WhosOnFirstModule]{
+		new: NewWhosOnFirstModule,
+		snapshotState: func(m *WhosOnFirstModule) ModuleState {
+			state := m.State
+			state.ButtonWords = slices.Clone(state.ButtonWords)
+			return &state
+		},
+		exportLayout: func(m *WhosOnFirstModule, layout *ModuleLayout) error {
+			layout.WhosOnFirst = &WhosOnFirstLayout{ScreenWord: m.State.ScreenWord, ButtonWords: m.State.ButtonWords}
+			return nil
+		},
+		hasLayout: func(layout ModuleLayout) bool { return layout.WhosOnFirst != nil },
+		validateLayout: func(layout ModuleLayout, errs *moduleLayoutErrors) {
+			if !slices.Contains(whosOnFirstScreenWords[:], layout.WhosOnFirst.ScreenWord) {
+				errs.add(".whos_on_first.screen_word", "%q isn't a screen word", layout.WhosOnFirst.ScreenWord)
+			}
+			words := layout.WhosOnFirst.ButtonWords
+			if len(words) != nWhosOnFirstWords || hasDuplicates(words) {
+				errs.add(".whos_on_first.button_words", "must be %d different words", nWhosOnFirstWords)
+			}
+			for _, word := range words {
+				if !slices.Contains(whosOnFirstButtonWords[:], word) {
+					errs.add(".whos_on_first.button_words", "%q isn't a button word", word)
+				}
+			}
+		},
+		importLayout: func(rng ports.RandomGenerator, layout ModuleLayout) *WhosOnFirstModule {
+			module := NewWhosOnFirstModule(rng)
+			module.SetState(WhosOnFirstState{
+				ScreenWord:  layout.WhosOnFirst.ScreenWord,
+				ButtonWords: layout.WhosOnFirst.ButtonWords,
+				Stage:       1,
+			})
+			return module
+		},
+	},
+	valueobject.MemoryModule: moduleKind[*MemoryModule]{
+		new: NewMemoryModule,
+		snapshotState: func(m *MemoryModule) ModuleState {
+			state := m.State
+			state.DisplayedNumbers = slices.Clone(state.DisplayedNumbers)
+			state.pastRounds = slices.Clone(state.pastRounds)
+			return &state
+		},
+		exportLayout: func(m *MemoryModule, layout *ModuleLayout) error {
+			layout.Memory = &MemoryLayout{ScreenNumber: m.State.ScreenNumber, DisplayedNumbers: m.State.DisplayedNumbers}
+			return nil
+		},
+		hasLayout: func(layout ModuleLayout) bool { return layout.Memory != nil },
+		validateLayout: func(layout ModuleLayout, errs *moduleLayoutErrors) {
+			if layout.Memory.ScreenNumber < 1 || layout.Memory.ScreenNumber > 4 {
+				errs.add(".memory.screen_number", "must be between 1 and 4")
+			}
+			displayed := slices.Sorted(slices.Values(layout.Memory.DisplayedNumbers))
+			if !slices.Equal(displayed, []int{1, 2, 3, 4}) {
+				errs.add(".memory.displayed_numbers", "must be 1 to 4 in any order")
+			}
+		},
+		importLayout: func(rng ports.RandomGenerator, layout ModuleLayout) *MemoryModule {
+			module := NewMemoryModule(rng)
+			module.SetState(MemoryState{
+				ScreenNumber:     layout.Memory.ScreenNumber,
+				DisplayedNumbers: layout.Memory.DisplayedNumbers,
+				Stage:            1,
+			})
+			return module
+		},
+	},
+	valueobject.MorseModule: moduleKind[*MorseModule]{
+		new: NewMorseModule,
+		snapshotState: func(m *MorseModule) ModuleState {
+			state := m.State
+			return &state
+		},
+		exportLayout: func(m *MorseModule, layout *ModuleLayout) error {
+			layout.Morse = &MorseLayout{Pattern: m.State.DisplayedPattern, Solution: m.State.solution, StartIdx: m.State.SelectedFrequencyIdx}
+			return nil
+		},
+		hasLayout: func(layout ModuleLayout) bool { return layout.Morse != nil },
+		validateLayout: func(layout ModuleLayout, errs *moduleLayoutErrors) {
+			if layout.Morse.StartIdx < 0 || layout.Morse.StartIdx >= len(morseWords) {
+				errs.add(".morse.start", "frequency %d doesn't exist", layout.Morse.StartIdx)
+			}
+			word, ok := morseWordForPattern(layout.Morse.Pattern)
+			if !ok {
+				errs.add(".morse.pattern", "%q doesn't spell a word from the list", layout.Morse.Pattern)
+			} else if layout.Morse.Solution != morseFrequencies[word] {
+				errs.add(".morse.solution", "must be the frequency for %q", word)
+			}
+		},
+		importLayout: func(rng ports.RandomGenerator, layout ModuleLayout) *MorseModule {
+			module := NewMorseModule(rng)
+			module.SetState(MorseState{
+				SelectedFrequencyIdx: layout.Morse.StartIdx,
+				DisplayedFrequency:   morseWordToFrequency(morseWords[layout.Morse.StartIdx]),
+				DisplayedPattern:     layout.Morse.Pattern,
+				solution:             layout.Morse.Solution,
+			})
+			return module
+		},
+	},
+	valueobject.MazeModule: moduleKind[*MazeModule]{
+		new: NewMazeModule,
+		snapshotState: func(m *MazeModule) ModuleState {
+			state := m.State
+			return &state
+		},
+		exportLayout: func(m *MazeModule, layout *ModuleLayout) error {
+			layout.Maze = &MazeLayout{Goal: m.State.GoalPosition, Player: m.State.PlayerPosition, Variant: m.State.Variant}
+			return nil
+		},
+		hasLayout: func(layout ModuleLayout) bool { return layout.Maze != nil },
+		validateLayout: func(layout ModuleLayout, errs *moduleLayoutErrors) {
+			if layout.Maze.Variant < 0 || layout.Maze.Variant >= len(mazes) {
+				errs.add(".maze.variant", "maze %d doesn't exist", layout.Maze.Variant)
+			}
+			if !onMaze(layout.Maze.Goal) || !onMaze(layout.Maze.Player) {
+				errs.add(".maze", "positions must be on the %dx%d grid", mazeSize, mazeSize)
+			} else if layout.Maze.Goal == layout.Maze.Player {
+				errs.add(".maze", "the player can't start on the goal")
+			}
+		},
+		importLayout: func(rng ports.RandomGenerator, layout ModuleLayout) *MazeModule {
+			module := NewMazeModule(rng)
+			module.SetState(MazeModuleState{
+				GoalPosition:   layout.Maze.Goal,
+				PlayerPosition: layout.Maze.Player,
+				Variant:        layout.Maze.Variant,
+			})
+			return module
+		},
+	},
+	valueobject.NeedyVentGasModule: moduleKind[*NeedyVentGasModule]{
+		new: NewNeedyVentGasModule,
+		snapshotState: func(m *NeedyVentGasModule) ModuleState {
+			state := m.State
+			return &state
+		},
+		exportLayout: func(m *NeedyVentGasModule, layout *ModuleLayout) error {
+			layout.NeedyVentGas = &NeedyVentGasLayout{QuestionIdx: m.State.questionIdx}
+			return nil
+		},
+		hasLayout: func(layout ModuleLayout) bool { return layout.NeedyVentGas != nil },
+		validateLayout: func(layout ModuleLayout, errs *moduleLayoutErrors) {
+			if idx := int(layout.NeedyVentGas.QuestionIdx); idx < 0 || idx >= len(ventGasQuestions) {
+				errs.add(".needy_vent_gas.question", "question %d doesn't exist", idx)
+			}
+		},
+		importLayout: func(rng ports.RandomGenerator, layout ModuleLayout) *NeedyVentGasModule {
+			idx := layout.NeedyVentGas.QuestionIdx
+			module := NewNeedyVentGasModule(rng)
+			module.State.DisplayedQuestion = ventGasQuestions[idx]
+			module.State.questionIdx = idx
+			return module
+		},
+	},
+	valueobject.NeedyKnobModule: moduleKind[*NeedyKnobModule]{
+		new: NewNeedyKnobModule,
+		snapshotState: func(m *NeedyKnobModule) ModuleState {
+			state := m.State
+			state.DisplayedPattern = make([][]bool, len(m.State.DisplayedPattern))
+			for i, row := range m.State.DisplayedPattern {
+				state.DisplayedPattern[i] = slices.Clone(row)
+			}
+			return &state
+		},
+		exportLayout: func(m *NeedyKnobModule, layout *ModuleLayout) error {
+			layout.NeedyKnob = &NeedyKnobLayout{Pattern: m.State.DisplayedPattern, DialDirection: m.State.DialDirection}
+			return nil
+		},
+		hasLayout: func(layout ModuleLayout) bool { return layout.NeedyKnob != nil },
+		validateLayout: func(layout ModuleLayout, errs *moduleLayoutErrors) {
+			if _, ok := knobSolution(layout.NeedyKnob.Pattern); !ok {
+				errs.add(".needy_knob.pattern", "isn't one of the knob's light patterns")
+			}
+			if direction := layout.NeedyKnob.DialDirection; direction < valueobject.North || direction > valueobject.West {
+				errs.add(".needy_knob.dial_direction", "unknown direction %d", direction)
+			}
+		},
+		importLayout: func(rng ports.RandomGenerator, layout ModuleLayout) *NeedyKnobModule {
+			module := NewNeedyKnobModule(rng)
+			module.State.DisplayedPattern = layout.NeedyKnob.Pattern
+			module.State.DialDirection = layout.NeedyKnob.DialDirection
+			return module
+		},
+	},
+}
+
+// Returns every registered module type, in a stable order.
+func RegisteredModuleTypes() []valueobject.ModuleType {
+	return slices.Sorted(maps.Keys(moduleRegistry))
+}
+
+// Builds a module of the given type. Returns an error for types that haven't been
+// implemented yet.
+func NewRegisteredModule(moduleType valueobject.ModuleType, rng ports.RandomGenerator) (Module, error) {
+	registration, ok := moduleRegistry[moduleType]
+	if !ok {
+		return nil, fmt.Errorf("unknown module type %v", moduleType)
+	}
+	return registration.create(rng), nil
+}
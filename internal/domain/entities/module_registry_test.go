@@ -0,0 +1,32 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+)
+
+// Fails when a registered module type is missing a part the domain needs.
+func TestModuleRegistry_EveryRegistrationIsComplete(t *testing.T) {
+	for _, moduleType := range entities.RegisteredModuleTypes() {
+		t.Run(moduleType.String(), func(t *testing.T) {
+			// Act
+			err := entities.CheckModuleRegistration(moduleType)
+
+			// Assert
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestModuleRegistry_UnregisteredTypeCantBeBuilt(t *testing.T) {
+	// Act
+	module, err := entities.NewRegisteredModule(valueobject.ComplicatedWiresModule, services.NewSeededRNGFromString("registry"))
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, module)
+}
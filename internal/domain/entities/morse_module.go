@@ -79,6 +79,8 @@ func (m *MorseModule) GetModuleState() ModuleState {
 func (m *MorseModule) PressTx() (strike bool, err error) {
 	selectedWord := morseWords[m.State.SelectedFrequencyIdx]
 
+	m.explain("morse.frequency", fmt.Sprintf("decode the flashing word → transmit on %.3f MHz", m.State.solution))
+
 	if m.State.solution != morseWordToFrequency(selectedWord) {
 		return true, nil
 	}
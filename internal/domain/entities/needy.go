@@ -0,0 +1,30 @@
+package entities
+
+import "time"
+
+// A module that can't be solved and needs attention for as long as the bomb is armed. When
+// its countdown runs out it checks whether it was dealt with, which may give the bomb a
+// strike, and then starts counting down again.
+type NeedyModule interface {
+	Module
+	CountdownRemaining(now time.Time) time.Duration
+	// Runs once the countdown has run out. Returns whether the module gives the bomb a
+	// strike and restarts the countdown from now.
+	TimeOut(now time.Time) bool
+}
+
+// Calculates the time left on a needy module's countdown using the bomb's clock. If the
+// module isn't attached to a bomb, the countdown runs in real time.
+func needyCountdownRemaining(bomb *Bomb, startedAt int64, durationSeconds int16, now time.Time) time.Duration {
+	duration := time.Duration(durationSeconds) * time.Second
+	start := time.Unix(startedAt, 0)
+
+	var elapsed time.Duration
+	if bomb != nil {
+		elapsed = bomb.Clock.ElapsedBetween(start, now)
+	} else {
+		elapsed = now.Sub(start)
+	}
+
+	return max(duration-elapsed, 0)
+}
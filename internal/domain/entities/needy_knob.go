@@ -2,6 +2,7 @@ package entities
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -101,6 +102,39 @@ func (m *NeedyKnobModule) RotateDial() (err error) {
 	return nil
 }
 
+// Returns how long is left on the needy countdown. The countdown runs on bomb time, so it
+// speeds up along with the bomb's clock after strikes.
+func (m *NeedyKnobModule) CountdownRemaining(now time.Time) time.Duration {
+	return needyCountdownRemaining(m.bomb, m.State.CountdownStartedAt, m.State.CountdownDuration, now)
+}
+
+// Checks the dial against the lights, then shows a new pattern and restarts the countdown.
+func (m *NeedyKnobModule) TimeOut(now time.Time) bool {
+	solution, found := knobSolution(m.State.DisplayedPattern)
+	strike := found && m.State.DialDirection != solution
+	m.explain("needy_knob.timeout", fmt.Sprintf("Countdown ended → dial must point %v", solution))
+
+	pattern := knobLightStates[m.rng.GetIntInRange(0, len(knobLightStates)-1)]
+	m.State.DisplayedPattern = [][]bool{pattern.firstRow, pattern.secondRow}
+	m.State.CountdownStartedAt = now.Unix()
+
+	return strike
+}
+
+// Returns the direction the dial must point for the displayed lights.
+func knobSolution(pattern [][]bool) (valueobject.CardinalDirection, bool) {
+	if len(pattern) != 2 {
+		return 0, false
+	}
+
+	for _, state := range knobLightStates {
+		if slices.Equal(state.firstRow, pattern[0]) && slices.Equal(state.secondRow, pattern[1]) {
+			return state.solution, true
+		}
+	}
+	return 0, false
+}
+
 type KnobLightState struct {
 	solution  valueobject.CardinalDirection
 	firstRow  []bool
@@ -0,0 +1,65 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+)
+
+func newNeedyBomb(t *testing.T, armedAt time.Time) (*entities.Bomb, *entities.NeedyVentGasModule) {
+	rng := services.NewSeededRNGFromString("needy")
+	bomb := entities.NewBomb(rng, valueobject.NewDefaultBombConfig())
+	bomb.StrikeTimerRates = nil
+
+	ventGas := entities.NewNeedyVentGasModule(rng)
+	ventGas.SetBomb(bomb)
+	state := ventGas.State
+	state.CountdownStartedAt = armedAt.Unix()
+	ventGas.SetState(state)
+	assert.NoError(t, bomb.AddModule(ventGas, valueobject.ModulePosition{}))
+	// A bomb with nothing left to solve counts as defused
+	assert.NoError(t, bomb.AddModule(entities.NewWiresModule(rng), valueobject.ModulePosition{Column: 1}))
+
+	bomb.Clock.Start(armedAt)
+	return bomb, ventGas
+}
+
+func TestBomb_NeedyCountdownRunningOutGivesStrike(t *testing.T) {
+	// Arrange
+	armedAt := time.Now().Truncate(time.Second)
+	bomb, ventGas := newNeedyBomb(t, armedAt)
+
+	// Act
+	bomb.TimeOutNeedyModules(armedAt.Add(10 * time.Second))
+	early := bomb.StrikeCount
+	bomb.TimeOutNeedyModules(armedAt.Add(31 * time.Second))
+
+	// Assert
+	assert.Equal(t, 0, early, "The countdown hadn't run out yet")
+	assert.Equal(t, 1, bomb.StrikeCount)
+	assert.Equal(t, 1, bomb.ModuleStrikes(ventGas.GetModuleID()))
+	assert.Equal(t, 30*time.Second, ventGas.CountdownRemaining(armedAt.Add(31*time.Second)), "The countdown should restart")
+	if assert.NotNil(t, ventGas.GetLastRule()) {
+		assert.Equal(t, "needy_vent_gas.timeout", ventGas.GetLastRule().RuleID)
+	}
+}
+
+func TestBomb_NeedyCountdownsOnlyRunOutWhileArmed(t *testing.T) {
+	// Arrange
+	armedAt := time.Now().Truncate(time.Second)
+	bomb, _ := newNeedyBomb(t, armedAt)
+	bomb.Clock.Pause(armedAt.Add(5 * time.Second))
+
+	// Act
+	next, found := bomb.NextNeedyTimeOut(armedAt.Add(time.Minute))
+	bomb.TimeOutNeedyModules(armedAt.Add(time.Minute))
+
+	// Assert
+	assert.True(t, found)
+	assert.Equal(t, 25*time.Second, next, "Time spent paused shouldn't count")
+	assert.Equal(t, 0, bomb.StrikeCount)
+}
@@ -1,6 +1,7 @@
 package entities
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -73,6 +74,11 @@ func (m *NeedyVentGasModule) PressButton(input bool) (strike bool, err error) {
 	// TODO: Factor in 2s delay
 
 	a := ventGasAnswers[m.State.questionIdx]
+	if a {
+		m.explain("needy_vent_gas.answer", fmt.Sprintf("%q → answer yes", ventGasQuestions[m.State.questionIdx]))
+	} else {
+		m.explain("needy_vent_gas.answer", fmt.Sprintf("%q → answer no", ventGasQuestions[m.State.questionIdx]))
+	}
 	i := m.rng.GetIntInRange(0, int(len(ventGasQuestions)-1))
 	m.State.DisplayedQuestion = ventGasQuestions[i]
 	m.State.questionIdx = int8(i)
@@ -85,6 +91,25 @@ func (m *NeedyVentGasModule) PressButton(input bool) (strike bool, err error) {
 	return true, nil
 }
 
+// Returns how long is left on the needy countdown. The countdown runs on bomb time, so it
+// speeds up along with the bomb's clock after strikes.
+func (m *NeedyVentGasModule) CountdownRemaining(now time.Time) time.Duration {
+	return needyCountdownRemaining(m.bomb, m.State.CountdownStartedAt, m.State.CountdownDuration, now)
+}
+
+// The question went unanswered, so the bomb gets a strike. Asks a new question and
+// restarts the countdown.
+func (m *NeedyVentGasModule) TimeOut(now time.Time) bool {
+	m.explain("needy_vent_gas.timeout", fmt.Sprintf("%q wasn't answered before the countdown ended", ventGasQuestions[m.State.questionIdx]))
+
+	i := m.rng.GetIntInRange(0, len(ventGasQuestions)-1)
+	m.State.DisplayedQuestion = ventGasQuestions[i]
+	m.State.questionIdx = int8(i)
+	m.State.CountdownStartedAt = now.Unix()
+
+	return true
+}
+
 func (m *NeedyVentGasModule) GetCurrentQuestion() string {
 	return ventGasQuestions[m.State.questionIdx]
 }
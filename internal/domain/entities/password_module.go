@@ -44,14 +44,20 @@ func NewPasswordModule(rng ports.RandomGenerator, providedSolution *string) *Pas
 }
 
 func (m *PasswordModule) GetCurrentGuess() string {
+	return m.state.CurrentGuess()
+}
+
+// Returns the word spelled by the letters currently showing in each column.
+func (s PasswordState) CurrentGuess() string {
 	var guess strings.Builder
-	for i, pos := range m.state.Positions {
-		guess.WriteString(string(m.state.Letters[i][pos]))
+	for i, pos := range s.Positions {
+		guess.WriteString(string(s.Letters[i][pos]))
 	}
 	return guess.String()
 }
 
 func (m *PasswordModule) CheckPassword() (strike bool) {
+	m.explain("password.word_list", "submit the only word from the password list the letter columns can spell")
 	if m.state.solution == m.GetCurrentGuess() {
 		m.state.MarkAsSolved()
 		return false
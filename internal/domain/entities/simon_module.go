@@ -5,7 +5,6 @@ import (
 	"slices"
 	"strings"
 
-	"github.com/ZaneH/defuse.party-go/internal/application/helpers"
 	"github.com/ZaneH/defuse.party-go/internal/domain/ports"
 	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
 	"github.com/google/uuid"
@@ -24,6 +23,9 @@ type SimonState struct {
 	InputCheckIdx int
 	// The number of sequences that the user will need to complete to solve the module.
 	nStages int
+	// Every color the module flashes, in order. Colors are drawn the first time they're
+	// needed, so DisplaySequence is always the start of it.
+	sequence []valueobject.Color
 }
 
 type SimonModule struct {
@@ -33,19 +35,23 @@ type SimonModule struct {
 }
 
 func NewSimonModule(rng ports.RandomGenerator, nStages *int) *SimonModule {
-	n := 0
+	var n int
 	if nStages == nil {
 		n = minSimonStages + rng.GetIntInRange(minSimonStages, maxSimonStages)
+	} else {
+		n = *nStages
 	}
 
+	first := generateRandomSimonColor(rng)
 	return &SimonModule{
 		BaseModule: BaseModule{
 			ModuleID: uuid.New(),
 		},
 		state: SimonState{
-			DisplaySequence: []valueobject.Color{generateRandomSimonColor(rng)},
+			DisplaySequence: []valueobject.Color{first},
 			InputCheckIdx:   0,
 			nStages:         n,
+			sequence:        []valueobject.Color{first},
 		},
 		rng: rng,
 	}
@@ -94,6 +100,15 @@ func (m *SimonModule) PressColor(c valueobject.Color) (finishedSeq bool, nextSeq
 		return false, nextSeq, false, fmt.Errorf("error translating color: %w", err)
 	}
 
+	vowel := "no vowel"
+	if m.bomb.Edgework().SerialContainsVowel() {
+		vowel = "vowel"
+	}
+	m.explain(
+		fmt.Sprintf("simon.%s.strikes_%d", strings.ReplaceAll(vowel, " ", "_"), min(m.bomb.StrikeCount, 2)),
+		fmt.Sprintf("serial has %s, %d strikes, %s flashed → press %s", vowel, m.bomb.StrikeCount, displayColor, correctColor),
+	)
+
 	if c == correctColor {
 		// They hit the last color in the current sequence
 		// solved if they have completed all stages
@@ -104,7 +119,7 @@ func (m *SimonModule) PressColor(c valueobject.Color) (finishedSeq bool, nextSeq
 				return true, nextSeq, false, nil
 			}
 
-			newColor := generateRandomSimonColor(m.rng)
+			newColor := m.plannedSequence(len(m.state.DisplaySequence) + 1)[len(m.state.DisplaySequence)]
 			m.state.InputCheckIdx = 0
 			m.state.DisplaySequence = append(m.state.DisplaySequence, newColor)
 			return true, m.state.DisplaySequence, false, nil
@@ -115,15 +130,15 @@ func (m *SimonModule) PressColor(c valueobject.Color) (finishedSeq bool, nextSeq
 			return false, nextSeq, false, nil
 		}
 	} else {
-		// Incorrect input, reset state
+		// Incorrect input, start the sequence over
 		m.state.InputCheckIdx = 0
-		m.state.DisplaySequence = []valueobject.Color{generateRandomSimonColor(m.rng)}
+		m.state.DisplaySequence = []valueobject.Color{m.plannedSequence(1)[0]}
 		return false, m.state.DisplaySequence, true, nil
 	}
 }
 
 func (m *SimonModule) translateColor(c valueobject.Color) (translated valueobject.Color, err error) {
-	if helpers.SerialNumberContainsVowel(m.bomb.SerialNumber) {
+	if m.bomb.Edgework().SerialContainsVowel() {
 		if m.bomb.StrikeCount == 0 {
 			switch c {
 			case valueobject.Red:
@@ -198,6 +213,18 @@ func (m *SimonModule) translateColor(c valueobject.Color) (translated valueobjec
 	return translated, nil
 }
 
+// Returns the first n colors the module flashes, drawing any it hasn't yet.
+func (m *SimonModule) plannedSequence(n int) []valueobject.Color {
+	if len(m.state.sequence) < len(m.state.DisplaySequence) {
+		// States set with SetState only give the colors shown so far
+		m.state.sequence = slices.Clone(m.state.DisplaySequence)
+	}
+	for len(m.state.sequence) < n {
+		m.state.sequence = append(m.state.sequence, generateRandomSimonColor(m.rng))
+	}
+	return m.state.sequence[:n]
+}
+
 var simonColors = [...]valueobject.Color{
 	valueobject.Red,
 	valueobject.Green,
@@ -99,6 +99,11 @@ func (m *WhosOnFirstModule) PressWord(word string) (strike bool, err error) {
 	}
 
 	earliestWord := validWords[earliestIdx]
+	m.explain("whos_on_first.word_list", fmt.Sprintf(
+		"display %q → read %s button %q; first of its list on a button → press %q",
+		m.State.ScreenWord, whosOnFirstButtonLocations[lookSpotIdx], lookWord, earliestWord,
+	))
+
 	if word != earliestWord {
 		m.State.ButtonWords = generateButtonWords(m.rng)
 		m.State.ScreenWord = generateScreenWord(m.rng)
@@ -145,6 +150,13 @@ var whosOnFirstScreenWords = [...]string{
 	"THEY ARE", "SEE", "C", "CEE",
 }
 
+// Button locations in the order of ButtonWords
+var whosOnFirstButtonLocations = [...]string{
+	"top left", "top right",
+	"middle left", "middle right",
+	"bottom left", "bottom right",
+}
+
 var whosOnFirstScreenWordsToLocation = map[string]int{
 	"YES":      2,
 	"FIRST":    1,
@@ -6,7 +6,6 @@ import (
 	"slices"
 	"strings"
 
-	"github.com/ZaneH/defuse.party-go/internal/application/helpers"
 	"github.com/ZaneH/defuse.party-go/internal/domain/ports"
 	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
 	"github.com/google/uuid"
@@ -136,152 +135,105 @@ func (m *WiresModule) CutWire(wirePos int) (strike bool, err error) {
 
 	wire.IsCut = true
 
-	if len(sorted) == 3 {
+	last := len(sorted) - 1
+	oddSerial := m.bomb.Edgework().SerialLastDigitOdd()
+
+	switch len(sorted) {
+	case 3:
 		// If there are no red wires, cut the second wire.
 		if !hasColor(sorted, valueobject.Red) {
-			if wireIdx == 1 {
-				return m.cutSucceed()
-			} else {
-				return true, nil
-			}
+			return m.applyRule(wireIdx, 1, "wires.3.no_red", "3 wires, no red → cut second wire")
 		}
 
 		// If the last wire is white, cut the last wire.
-		if sorted[len(sorted)-1].WireColor == valueobject.White {
-			if wireIdx == len(sorted)-1 {
-				return m.cutSucceed()
-			} else {
-				return true, nil
-			}
+		if sorted[last].WireColor == valueobject.White {
+			return m.applyRule(wireIdx, last, "wires.3.last_white", "3 wires, last wire white → cut last wire")
 		}
 
 		// If there is more than one blue wire, cut the last blue wire.
 		blueIdxs := colorIndices(sorted, valueobject.Blue)
 		if len(blueIdxs) > 1 {
-			if wireIdx == blueIdxs[len(blueIdxs)-1] {
-				return m.cutSucceed()
-			} else {
-				return true, nil
-			}
+			return m.applyRule(wireIdx, blueIdxs[len(blueIdxs)-1], "wires.3.multiple_blue", "3 wires, >1 blue → cut last blue")
 		}
 
 		// Otherwise cut the last wire.
-		if wireIdx == len(sorted)-1 {
-			return m.cutSucceed()
-		} else {
-			return true, nil
-		}
-	} else if len(sorted) == 4 {
+		return m.applyRule(wireIdx, last, "wires.3.otherwise", "3 wires, otherwise → cut last wire")
+	case 4:
 		// If there is more than one red wire and the last digit of the serial number is odd,
 		// cut the last red wire.
 		redIdxs := colorIndices(sorted, valueobject.Red)
-		if len(redIdxs) > 1 && helpers.SerialNumbersEndsWithOddDigit(m.bomb.SerialNumber) {
-			if wireIdx == redIdxs[len(redIdxs)-1] {
-				return m.cutSucceed()
-			} else {
-				return true, nil
-			}
+		if len(redIdxs) > 1 && oddSerial {
+			return m.applyRule(wireIdx, redIdxs[len(redIdxs)-1], "wires.4.multiple_red_serial_odd", "4 wires, >1 red, serial odd → cut last red")
 		}
 
 		// If the last wire is yellow and there are no red wires, cut the first wire.
-		if sorted[len(sorted)-1].WireColor == valueobject.Yellow && !hasColor(sorted, valueobject.Red) {
-			if wireIdx == 0 {
-				return m.cutSucceed()
-			} else {
-				return true, nil
-			}
+		if sorted[last].WireColor == valueobject.Yellow && !hasColor(sorted, valueobject.Red) {
+			return m.applyRule(wireIdx, 0, "wires.4.last_yellow_no_red", "4 wires, last wire yellow, no red → cut first wire")
 		}
 
 		// If there is exactly one blue wire, cut the first wire.
 		if len(colorIndices(sorted, valueobject.Blue)) == 1 {
-			if wireIdx == 0 {
-				return m.cutSucceed()
-			} else {
-				return true, nil
-			}
+			return m.applyRule(wireIdx, 0, "wires.4.one_blue", "4 wires, exactly 1 blue → cut first wire")
 		}
 
-		// If there is more than one yellow wire, cut the last wire.
+		// If there is more than one yellow wire, cut the last yellow wire.
 		yellowIdxs := colorIndices(sorted, valueobject.Yellow)
 		if len(yellowIdxs) > 1 {
-			if wireIdx == yellowIdxs[len(yellowIdxs)-1] {
-				return m.cutSucceed()
-			} else {
-				return true, nil
-			}
+			return m.applyRule(wireIdx, yellowIdxs[len(yellowIdxs)-1], "wires.4.multiple_yellow", "4 wires, >1 yellow → cut last yellow")
 		}
 
 		// Otherwise, cut the second wire.
-		if wireIdx == 1 {
-			return m.cutSucceed()
-		}
-	} else if len(sorted) == 5 {
+		return m.applyRule(wireIdx, 1, "wires.4.otherwise", "4 wires, otherwise → cut second wire")
+	case 5:
 		// If the last wire is black and the last digit of the serial number is odd,
 		// cut the fourth wire.
-		if sorted[len(sorted)-1].WireColor == valueobject.Black && helpers.SerialNumbersEndsWithOddDigit(m.bomb.SerialNumber) {
-			if wireIdx == 3 {
-				return m.cutSucceed()
-			} else {
-				return true, nil
-			}
+		if sorted[last].WireColor == valueobject.Black && oddSerial {
+			return m.applyRule(wireIdx, 3, "wires.5.last_black_serial_odd", "5 wires, last wire black, serial odd → cut fourth wire")
 		}
 
 		// If there is exactly one red wire and there are no yellow wires, cut the first wire.
 		if len(colorIndices(sorted, valueobject.Red)) == 1 && !hasColor(sorted, valueobject.Yellow) {
-			if wireIdx == 0 {
-				return m.cutSucceed()
-			} else {
-				return true, nil
-			}
+			return m.applyRule(wireIdx, 0, "wires.5.one_red_no_yellow", "5 wires, exactly 1 red, no yellow → cut first wire")
 		}
 
 		// If there are no black wires, cut the second wire.
 		if !hasColor(sorted, valueobject.Black) {
-			if wireIdx == 1 {
-				return m.cutSucceed()
-			} else {
-				return true, nil
-			}
+			return m.applyRule(wireIdx, 1, "wires.5.no_black", "5 wires, no black → cut second wire")
 		}
 
 		// Otherwise, cut the first wire
-		if wireIdx == 0 {
-			return m.cutSucceed()
-		}
-	} else if len(sorted) == 6 {
+		return m.applyRule(wireIdx, 0, "wires.5.otherwise", "5 wires, otherwise → cut first wire")
+	case 6:
 		// If there are no yellow wires and the last digit of the serial number is odd,
 		// cut the third wire.
-		if !hasColor(sorted, valueobject.Yellow) && helpers.SerialNumbersEndsWithOddDigit(m.bomb.SerialNumber) {
-			if wireIdx == 2 {
-				return m.cutSucceed()
-			} else {
-				return true, nil
-			}
+		if !hasColor(sorted, valueobject.Yellow) && oddSerial {
+			return m.applyRule(wireIdx, 2, "wires.6.no_yellow_serial_odd", "6 wires, no yellow, serial odd → cut third wire")
 		}
 
 		// If there is exactly one yellow wire and there is more than one white wire,
 		// cut the fourth wire.
 		if len(colorIndices(sorted, valueobject.Yellow)) == 1 && len(colorIndices(sorted, valueobject.White)) > 1 {
-			if wireIdx == 3 {
-				return m.cutSucceed()
-			} else {
-				return true, nil
-			}
+			return m.applyRule(wireIdx, 3, "wires.6.one_yellow_multiple_white", "6 wires, exactly 1 yellow, >1 white → cut fourth wire")
 		}
 
 		// If there are no red wires, cut the last wire.
 		if !hasColor(sorted, valueobject.Red) {
-			if wireIdx == len(sorted)-1 {
-				return m.cutSucceed()
-			} else {
-				return true, nil
-			}
+			return m.applyRule(wireIdx, last, "wires.6.no_red", "6 wires, no red → cut last wire")
 		}
 
 		// Otherwise, cut the fourth wire.
-		if wireIdx == 3 {
-			return m.cutSucceed()
-		}
+		return m.applyRule(wireIdx, 3, "wires.6.otherwise", "6 wires, otherwise → cut fourth wire")
+	}
+
+	return true, nil
+}
+
+// Records the rule that applies and checks the cut against the wire the rule says to cut.
+func (m *WiresModule) applyRule(wireIdx int, correctIdx int, ruleID string, description string) (strike bool, err error) {
+	m.explain(ruleID, description)
+
+	if wireIdx == correctIdx {
+		return m.cutSucceed()
 	}
 
 	return true, nil
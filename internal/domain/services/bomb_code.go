@@ -0,0 +1,90 @@
+package services
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+)
+
+// Version 2 names module types and holds Simon's whole sequence
+const bombCodeVersion = 2
+
+// Codes are capped so a malicious code can't decompress into something huge
+const maxBombCodeLayoutBytes = 64 << 10
+
+type bombCode struct {
+	Version int `json:"v"`
+	// Seeds the random generator modules use while being played, such as Simon's later
+	// colors
+	Seed string              `json:"s"`
+	Bomb entities.BombLayout `json:"b"`
+}
+
+// Encodes a bomb's layout as a compact base64url code that can be imported on any server.
+func EncodeBombCode(bomb *entities.Bomb, seed string) (string, error) {
+	layout, err := entities.ExportBombLayout(bomb)
+	if err != nil {
+		return "", err
+	}
+
+	encoded, err := json.Marshal(bombCode{Version: bombCodeVersion, Seed: seed, Bomb: layout})
+	if err != nil {
+		return "", err
+	}
+
+	var compressed bytes.Buffer
+	w, err := flate.NewWriter(&compressed, flate.BestCompression)
+	if err != nil {
+		return "", err
+	}
+	if _, err := w.Write(encoded); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(compressed.Bytes()), nil
+}
+
+// Rebuilds the bomb a code was exported from, along with the seed its modules play with.
+// Codes whose layout the bomb factory couldn't have built also match ValidationErrors.
+func DecodeBombCode(code string) (*entities.Bomb, string, error) {
+	compressed, err := base64.RawURLEncoding.DecodeString(code)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", valueobject.ErrInvalidBombCode, err)
+	}
+
+	r := flate.NewReader(bytes.NewReader(compressed))
+	defer r.Close()
+
+	encoded, err := io.ReadAll(io.LimitReader(r, maxBombCodeLayoutBytes+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %v", valueobject.ErrInvalidBombCode, err)
+	}
+	if len(encoded) > maxBombCodeLayoutBytes {
+		return nil, "", fmt.Errorf("%w: code is too large", valueobject.ErrInvalidBombCode)
+	}
+
+	var decoded bombCode
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, "", fmt.Errorf("%w: %v", valueobject.ErrInvalidBombCode, err)
+	}
+
+	if decoded.Version != bombCodeVersion {
+		return nil, "", fmt.Errorf("%w: unsupported version %d", valueobject.ErrInvalidBombCode, decoded.Version)
+	}
+
+	bomb, err := entities.NewBombFromLayout(NewSeededRNGFromString(decoded.Seed), decoded.Bomb)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %w", valueobject.ErrInvalidBombCode, err)
+	}
+
+	return bomb, decoded.Seed, nil
+}
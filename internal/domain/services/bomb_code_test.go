@@ -0,0 +1,73 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBombCode_RoundTripsEveryModule(t *testing.T) {
+	// Arrange: one of every module the factory can build
+	rng := services.NewSeededRNGFromString("bomb-code")
+	var modules []valueobject.ModuleSpec
+	for _, moduleType := range []valueobject.ModuleType{
+		valueobject.WiresModule,
+		valueobject.PasswordModule,
+		valueobject.BigButtonModule,
+		valueobject.KeypadModule,
+		valueobject.SimonModule,
+		valueobject.WhosOnFirstModule,
+		valueobject.MemoryModule,
+		valueobject.MorseModule,
+		valueobject.MazeModule,
+		valueobject.NeedyVentGasModule,
+		valueobject.NeedyKnobModule,
+	} {
+		modules = append(modules, valueobject.ModuleSpec{Type: moduleType, Count: 1})
+	}
+	config := valueobject.NewBombConfigBuilder().FromMissionDefinition(valueobject.MissionDefinition{
+		Name:       "Everything",
+		Timer:      5 * time.Minute,
+		MaxStrikes: 3,
+		NumFaces:   2,
+		Rows:       2,
+		Columns:    3,
+		Modules:    modules,
+	})
+	config.MaxBatteries = valueobject.MaxBatteriesAllowed
+	config.MaxIndicatorCount = valueobject.MaxIndicatorsAllowed
+	bomb := services.NewBombFactory(services.NewModuleFactory(rng)).CreateBomb(rng, config)
+
+	// Act
+	code, err := services.EncodeBombCode(bomb, "bomb-code")
+	assert.NoError(t, err)
+	imported, seed, err := services.DecodeBombCode(code)
+	assert.NoError(t, err)
+	reexported, err := services.EncodeBombCode(imported, seed)
+	assert.NoError(t, err)
+
+	// Assert
+	assert.Regexp(t, `^[A-Za-z0-9_-]+$`, code, "Codes should be base64url")
+	assert.Equal(t, "bomb-code", seed)
+	assert.Equal(t, code, reexported, "Importing a code should rebuild the same bomb")
+	assert.Equal(t, bomb.Edgework(), imported.Edgework())
+	assert.Equal(t, bomb.Clock.Duration, imported.Clock.Duration)
+	assert.Len(t, imported.Modules, len(bomb.Modules))
+
+	originalLayout, err := entities.ExportBombLayout(bomb)
+	assert.NoError(t, err)
+	importedLayout, err := entities.ExportBombLayout(imported)
+	assert.NoError(t, err)
+	assert.Equal(t, originalLayout, importedLayout)
+}
+
+func TestBombCode_RejectsInvalidCodes(t *testing.T) {
+	for _, code := range []string{"", "not a code!", "AAAA"} {
+		_, _, err := services.DecodeBombCode(code)
+		assert.ErrorIs(t, err, valueobject.ErrInvalidBombCode, "code %q", code)
+	}
+}
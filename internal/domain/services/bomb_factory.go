@@ -1,7 +1,10 @@
 package services
 
 import (
+	"fmt"
 	"log"
+	"math"
+	"slices"
 
 	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
 	"github.com/ZaneH/defuse.party-go/internal/domain/ports"
@@ -10,6 +13,10 @@ import (
 
 type BombFactoryImpl struct {
 	moduleFactory *ModuleFactory
+	// Give every bomb and module slot its own random stream, so changing one module can't
+	// reshuffle the rest of the bomb
+	isolateStreams bool
+	portPlates     entities.PortPlateStyle
 }
 
 func NewBombFactory(moduleFactory *ModuleFactory) *BombFactoryImpl {
@@ -18,22 +25,68 @@ func NewBombFactory(moduleFactory *ModuleFactory) *BombFactoryImpl {
 	}
 }
 
+// Creates a factory that draws every bomb and module slot from its own random stream. Each
+// bomb takes a single value from the shared generator, so adding a module or a random call
+// to a module constructor only changes that slot.
+func NewIsolatedBombFactory() *BombFactoryImpl {
+	return &BombFactoryImpl{
+		isolateStreams: true,
+	}
+}
+
+// Random streams used while building one bomb
+type bombStreams struct {
+	// Serial number, edgework and the rest of the bomb itself
+	bomb ports.RandomGenerator
+	// Which module types go on the bomb
+	selection ports.RandomGenerator
+	// Where the modules are placed
+	layout ports.RandomGenerator
+	// Builds the module placed at a position
+	moduleFactory func(position valueobject.ModulePosition) *ModuleFactory
+}
+
+func (f *BombFactoryImpl) newBombStreams(rng ports.RandomGenerator) bombStreams {
+	if !f.isolateStreams {
+		return bombStreams{
+			bomb:      rng,
+			selection: rng,
+			layout:    rng,
+			moduleFactory: func(valueobject.ModulePosition) *ModuleFactory {
+				return f.moduleFactory
+			},
+		}
+	}
+
+	bombSeed := deriveSeed(rng.GetSeed(), fmt.Sprintf("bomb:%d", rng.GetIntInRange(0, math.MaxInt32)))
+	return bombStreams{
+		bomb:      NewDerivedRNG(bombSeed, "bomb"),
+		selection: NewDerivedRNG(bombSeed, "selection"),
+		layout:    NewDerivedRNG(bombSeed, "layout"),
+		moduleFactory: func(position valueobject.ModulePosition) *ModuleFactory {
+			label := fmt.Sprintf("module:%d:%d:%d", position.Face, position.Row, position.Column)
+			return NewModuleFactory(NewDerivedRNG(bombSeed, label))
+		},
+	}
+}
+
 func (f *BombFactoryImpl) CreateBomb(rng ports.RandomGenerator, config valueobject.BombConfig) *entities.Bomb {
-	bomb := entities.NewBomb(rng, config)
+	streams := f.newBombStreams(rng)
+	bomb := entities.NewBombWithPortPlates(streams.bomb, config, f.portPlates)
 
 	var modulesToAdd []valueobject.ModuleType
 
 	// Check if we have explicit modules (from missions)
 	if len(config.ExplicitModules) > 0 {
-		modulesToAdd = f.expandExplicitModules(rng, config.ExplicitModules, config.MissionSection)
+		modulesToAdd = f.expandExplicitModules(streams.selection, config.ExplicitModules, config.MissionSection)
 	} else {
-		modulesToAdd = f.generateWeightedModules(rng, config)
+		modulesToAdd = f.generateWeightedModules(streams.selection, config)
 	}
 
 	// Always prepend clock
 	modulesToAdd = append([]valueobject.ModuleType{valueobject.ClockModule}, modulesToAdd...)
 
-	f.placeModulesOnBomb(rng, bomb, modulesToAdd, config)
+	f.placeModulesOnBomb(streams, bomb, modulesToAdd, config)
 
 	return bomb
 }
@@ -83,22 +136,27 @@ func (f *BombFactoryImpl) generateWeightedModules(rng ports.RandomGenerator, con
 	moduleTypes := make([]valueobject.ModuleType, 0)
 	weights := make([]float32, 0)
 
-	for moduleType, probability := range config.ModuleTypes {
+	for moduleType := range config.ModuleTypes {
 		if moduleType != valueobject.ClockModule { // Skip clock, it's added separately
 			moduleTypes = append(moduleTypes, moduleType)
-			weights = append(weights, probability)
 		}
 	}
 
+	// Map iteration order is random, so sort to get the same bomb from the same seed
+	slices.Sort(moduleTypes)
+	for _, moduleType := range moduleTypes {
+		weights = append(weights, config.ModuleTypes[moduleType])
+	}
+
 	modules := make([]valueobject.ModuleType, totalModules-1) // -1 for clock
 	for i := range modules {
-		modules[i] = selectWeightedModuleType(f.moduleFactory.rng, moduleTypes, weights)
+		modules[i] = selectWeightedModuleType(rng, moduleTypes, weights)
 	}
 
 	return modules
 }
 
-func (f *BombFactoryImpl) placeModulesOnBomb(rng ports.RandomGenerator, bomb *entities.Bomb, moduleTypes []valueobject.ModuleType, config valueobject.BombConfig) {
+func (f *BombFactoryImpl) placeModulesOnBomb(streams bombStreams, bomb *entities.Bomb, moduleTypes []valueobject.ModuleType, config valueobject.BombConfig) {
 	availablePositions := make([]valueobject.ModulePosition, 0)
 
 	for face := range config.NumFaces {
@@ -110,7 +168,7 @@ func (f *BombFactoryImpl) placeModulesOnBomb(rng ports.RandomGenerator, bomb *en
 		}
 	}
 
-	rng.Shuffle(len(availablePositions), func(i, j int) {
+	streams.layout.Shuffle(len(availablePositions), func(i, j int) {
 		availablePositions[i], availablePositions[j] = availablePositions[j], availablePositions[i]
 	})
 
@@ -120,7 +178,7 @@ func (f *BombFactoryImpl) placeModulesOnBomb(rng ports.RandomGenerator, bomb *en
 		}
 
 		position := availablePositions[i]
-		module := f.createModule(bomb, moduleType, position)
+		module := createModule(streams.moduleFactory(position), bomb, moduleType, position)
 		if module == nil {
 			log.Printf("TODO: implement missing module type %v (currently skipping)", moduleType)
 			continue
@@ -133,35 +191,10 @@ func (f *BombFactoryImpl) placeModulesOnBomb(rng ports.RandomGenerator, bomb *en
 	}
 }
 
-func (f *BombFactoryImpl) createModule(bomb *entities.Bomb, moduleType valueobject.ModuleType, position valueobject.ModulePosition) entities.Module {
-	var module entities.Module
-	switch moduleType {
-	case valueobject.ClockModule:
-		module = f.moduleFactory.CreateClockModule()
-	case valueobject.WiresModule:
-		module = f.moduleFactory.CreateWiresModule()
-	case valueobject.PasswordModule:
-		module = f.moduleFactory.CreatePasswordModule()
-	case valueobject.BigButtonModule:
-		module = f.moduleFactory.CreateBigButtonModule()
-	case valueobject.SimonModule:
-		module = f.moduleFactory.CreateSimonModule()
-	case valueobject.KeypadModule:
-		module = f.moduleFactory.CreateKeypadModule()
-	case valueobject.WhosOnFirstModule:
-		module = f.moduleFactory.CreateWhosOnFirstModule()
-	case valueobject.MemoryModule:
-		module = f.moduleFactory.CreateMemoryModule()
-	case valueobject.MorseModule:
-		module = f.moduleFactory.CreateMorseModule()
-	case valueobject.NeedyVentGasModule:
-		module = f.moduleFactory.CreateNeedyVentGasModule()
-	case valueobject.NeedyKnobModule:
-		module = f.moduleFactory.CreateNeedyKnobModule()
-	case valueobject.MazeModule:
-		module = f.moduleFactory.CreateMazeModule()
-	default:
-		log.Printf("unknown module type %v, skipping...", moduleType)
+func createModule(moduleFactory *ModuleFactory, bomb *entities.Bomb, moduleType valueobject.ModuleType, position valueobject.ModulePosition) entities.Module {
+	module, err := moduleFactory.CreateModule(moduleType)
+	if err != nil {
+		log.Printf("%v, skipping...", err)
 		return nil
 	}
 
@@ -1,6 +1,9 @@
 package services_test
 
 import (
+	"fmt"
+	"regexp"
+	"slices"
 	"testing"
 	"time"
 
@@ -44,10 +47,114 @@ func TestBombFactory_CreateBomb(t *testing.T) {
 		return
 	}
 
-	assert.Equal(t, bomb.TimerDuration, c.Timer, "Expected bomb timer to be %v, but got %v", c.Timer, bomb.TimerDuration)
+	assert.Equal(t, bomb.Clock.Duration, c.Timer, "Expected bomb timer to be %v, but got %v", c.Timer, bomb.Clock.Duration)
 	assert.Equal(t, bomb.MaxStrikes, c.MaxStrikes, "Expected bomb max strikes to be %d, but got %d", c.MaxStrikes, bomb.MaxStrikes)
 	assert.Equal(t, bomb.Batteries, c.MinBatteries, "Expected bomb batteries to be %d, but got %d", 0, bomb.Batteries)
 	assert.Equal(t, bomb.Ports, []valueobject.Port{}, "Expected bomb ports to be empty, but got %v", bomb.Ports)
 	assert.Equal(t, bomb.Indicators, map[string]valueobject.Indicator{}, "Expected bomb indicators to be empty, but got %v", bomb.Indicators)
 	assert.Equal(t, len(bomb.Faces), 1)
 }
+
+func TestBombFactory_CreateBombEdgework(t *testing.T) {
+	// Arrange
+	c := valueobject.NewDefaultBombConfig()
+	c.MinBatteries = 1
+	c.MaxBatteries = 6
+	c.MaxIndicatorCount = 5
+	c.PortCount = 6
+	serialFormat := regexp.MustCompile(`^[A-Z0-9]{2}[0-9][A-Z]{2}[0-9]$`)
+
+	for i := range 50 {
+		rng := services.NewSeededRNGFromString(fmt.Sprintf("edgework-%d", i))
+
+		// Act
+		bomb := services.NewBombFactory(services.NewModuleFactory(rng)).CreateBomb(rng, c)
+
+		// Assert
+		assert.Regexp(t, serialFormat, bomb.SerialNumber)
+		assert.NotContains(t, bomb.SerialNumber, "O")
+		assert.NotContains(t, bomb.SerialNumber, "Y")
+
+		assert.LessOrEqual(t, len(bomb.Indicators), c.MaxIndicatorCount)
+		for label, indicator := range bomb.Indicators {
+			assert.Equal(t, label, indicator.Label)
+			assert.Contains(t, valueobject.AVAILABLE_INDICATOR_LABELS, label)
+		}
+
+		batteries := 0
+		for _, holder := range bomb.BatteryHolders {
+			batteries += holder.BatteryCount()
+		}
+		assert.Equal(t, bomb.Batteries, batteries, "Battery count should match the holders")
+		assert.GreaterOrEqual(t, bomb.Batteries, c.MinBatteries)
+		assert.LessOrEqual(t, bomb.Batteries, c.MaxBatteries)
+
+		ports := 0
+		for _, plate := range bomb.PortPlates {
+			layoutFound := false
+			for _, layout := range valueobject.PORT_PLATE_LAYOUTS {
+				inLayout := true
+				for _, port := range plate.Ports {
+					inLayout = inLayout && slices.Contains(layout, port)
+				}
+				layoutFound = layoutFound || inLayout
+			}
+			assert.True(t, layoutFound, "Plate %v should only hold ports from one layout", plate.Ports)
+
+			seen := make(map[valueobject.Port]bool)
+			for _, port := range plate.Ports {
+				assert.False(t, seen[port], "Port %v appears twice on the same plate", port)
+				seen[port] = true
+			}
+			ports += len(plate.Ports)
+		}
+		assert.Len(t, bomb.Ports, ports)
+		assert.Equal(t, c.PortCount, ports, "The bomb should have exactly PortCount ports")
+	}
+}
+
+func TestBombFactory_IsolatedStreamsKeepOtherSlots(t *testing.T) {
+	// Arrange: two missions that only differ in their first module
+	definition := func(first valueobject.ModuleType) valueobject.MissionDefinition {
+		return valueobject.MissionDefinition{
+			Name:       "Isolated",
+			Timer:      5 * time.Minute,
+			MaxStrikes: 3,
+			NumFaces:   1,
+			Rows:       2,
+			Columns:    3,
+			Modules: []valueobject.ModuleSpec{
+				{Type: first, Count: 1},
+				{Type: valueobject.KeypadModule, Count: 1},
+				{Type: valueobject.MemoryModule, Count: 1},
+				{Type: valueobject.MazeModule, Count: 1},
+			},
+		}
+	}
+	build := func(first valueobject.ModuleType) entities.BombLayout {
+		config := valueobject.NewBombConfigBuilder().FromMissionDefinition(definition(first))
+		rng := services.NewSeededRNGFromString("isolated")
+		layout, err := entities.ExportBombLayout(services.NewIsolatedBombFactory().CreateBomb(rng, config))
+		assert.NoError(t, err)
+		return layout
+	}
+
+	// Act
+	withWires := build(valueobject.WiresModule)
+	withPassword := build(valueobject.PasswordModule)
+
+	// Assert
+	assert.Equal(t, withWires.SerialNumber, withPassword.SerialNumber)
+	assert.Len(t, withPassword.Modules, len(withWires.Modules))
+
+	changed := 0
+	for i, module := range withWires.Modules {
+		if module.Type == valueobject.WiresModule {
+			assert.Equal(t, valueobject.PasswordModule, withPassword.Modules[i].Type)
+			changed++
+			continue
+		}
+		assert.Equal(t, module, withPassword.Modules[i], "module at %v changed", module.Position)
+	}
+	assert.Equal(t, 1, changed)
+}
@@ -0,0 +1,36 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/ports"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+)
+
+type BombGenerator interface {
+	CreateBomb(rng ports.RandomGenerator, config valueobject.BombConfig) *entities.Bomb
+}
+
+// Returns the generator for a version. Released generators are frozen: when generation
+// has to change, the old code is kept here under its version and the change ships as a
+// new version. The golden tests lock what every version produces.
+func NewBombGenerator(version valueobject.GeneratorVersion, rng ports.RandomGenerator) (BombGenerator, error) {
+	version, err := valueobject.ResolveGeneratorVersion(version)
+	if err != nil {
+		return nil, err
+	}
+
+	switch version {
+	case valueobject.GeneratorVersion1:
+		return NewBombFactory(NewModuleFactory(rng)), nil
+	case valueobject.GeneratorVersion2:
+		return NewIsolatedBombFactory(), nil
+	case valueobject.GeneratorVersion3:
+		factory := NewIsolatedBombFactory()
+		factory.portPlates = entities.PortPlatesAnyCount
+		return factory, nil
+	default:
+		return nil, fmt.Errorf("no generator for version %d", version)
+	}
+}
@@ -0,0 +1,173 @@
+package services_test
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// Regenerates the golden files. Only use it when adding a new generator version; a
+// changed golden file for a released version means seeds stopped being stable.
+var updateGolden = flag.Bool("update", false, "rewrite the generator golden files")
+
+func TestBombGenerator_MatchesGoldenLayouts(t *testing.T) {
+	levelOne, err := valueobject.NewGameSessionConfigFromLevel("golden-level-1", 1)
+	assert.NoError(t, err)
+	levelTen, err := valueobject.NewGameSessionConfigFromLevel("golden-level-10", 10)
+	assert.NoError(t, err)
+	mission, err := valueobject.NewGameSessionConfigFromMission("golden-mission", valueobject.MissionTheFirstBomb)
+	assert.NoError(t, err)
+	threeBombs, err := levelOne.WithBombCount(3, valueobject.BombModeParallel)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		version valueobject.GeneratorVersion
+		config  valueobject.GameSessionConfig
+	}{
+		{name: "v1_level_1", version: valueobject.GeneratorVersion1, config: levelOne},
+		{name: "v1_level_10", version: valueobject.GeneratorVersion1, config: levelTen},
+		{name: "v1_mission_the_first_bomb", version: valueobject.GeneratorVersion1, config: mission},
+		{name: "v1_three_bombs", version: valueobject.GeneratorVersion1, config: threeBombs},
+		{name: "v2_level_1", version: valueobject.GeneratorVersion2, config: levelOne},
+		{name: "v2_level_10", version: valueobject.GeneratorVersion2, config: levelTen},
+		{name: "v2_mission_the_first_bomb", version: valueobject.GeneratorVersion2, config: mission},
+		{name: "v2_three_bombs", version: valueobject.GeneratorVersion2, config: threeBombs},
+		{name: "v3_level_1", version: valueobject.GeneratorVersion3, config: levelOne},
+		{name: "v3_level_10", version: valueobject.GeneratorVersion3, config: levelTen},
+		{name: "v3_mission_the_first_bomb", version: valueobject.GeneratorVersion3, config: mission},
+		{name: "v3_three_bombs", version: valueobject.GeneratorVersion3, config: threeBombs},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			rng := services.NewSeededRNGFromString(tt.config.Seed())
+
+			// Act
+			var bombs []goldenBomb
+			for _, bombConfig := range tt.config.BombConfigs {
+				generator, err := services.NewBombGenerator(tt.version, rng)
+				assert.NoError(t, err)
+				bombs = append(bombs, goldenBomb{bomb: generator.CreateBomb(rng, bombConfig)})
+			}
+			// Every snapshot is taken before any layout is exported, as exporting can draw
+			// from the shared stream
+			for i := range bombs {
+				bombs[i].Snapshot = normalizeSnapshot(bombs[i].bomb.Snapshot(goldenTime))
+			}
+			for i := range bombs {
+				layout, err := entities.ExportBombLayout(bombs[i].bomb)
+				assert.NoError(t, err)
+				bombs[i].Layout = layout
+			}
+			got, err := json.MarshalIndent(bombs, "", "  ")
+			assert.NoError(t, err)
+
+			// Assert
+			path := filepath.Join("testdata", "generator", tt.name+".json")
+			if *updateGolden {
+				assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+				assert.NoError(t, os.WriteFile(path, append(got, '\n'), 0o644))
+			}
+
+			want, err := os.ReadFile(path)
+			assert.NoError(t, err)
+			assert.JSONEq(t, string(want), string(got), "generator %d no longer reproduces %s", tt.version, tt.name)
+		})
+	}
+}
+
+var goldenTime = time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+// What the golden files hold for each bomb in the session
+type goldenBomb struct {
+	bomb     *entities.Bomb
+	Layout   entities.BombLayout
+	Snapshot entities.BombSnapshot
+}
+
+// Clears what changes between runs: IDs are random and needy countdowns start at the wall
+// clock
+func normalizeSnapshot(snapshot entities.BombSnapshot) entities.BombSnapshot {
+	snapshot.ID = uuid.Nil
+	for i := range snapshot.Modules {
+		module := &snapshot.Modules[i]
+		module.ModuleID = uuid.Nil
+		module.CountdownRemaining = 0
+		switch state := module.State.(type) {
+		case *entities.NeedyKnobState:
+			state.CountdownStartedAt = 0
+		case *entities.NeedyVentGasState:
+			state.CountdownStartedAt = 0
+		}
+	}
+	return snapshot
+}
+
+func TestBombGenerator_LatestResolvesToNewestVersion(t *testing.T) {
+	// Arrange
+	config, err := valueobject.NewGameSessionConfigFromLevel("latest", 5)
+	assert.NoError(t, err)
+	latestRNG := services.NewSeededRNGFromString(config.Seed())
+	pinnedRNG := services.NewSeededRNGFromString(config.Seed())
+
+	// Act
+	latest, err := services.NewBombGenerator(valueobject.GeneratorVersionLatest, latestRNG)
+	assert.NoError(t, err)
+	pinned, err := services.NewBombGenerator(valueobject.LatestGeneratorVersion, pinnedRNG)
+	assert.NoError(t, err)
+	latestLayout, err := entities.ExportBombLayout(latest.CreateBomb(latestRNG, config.BombConfigs[0]))
+	assert.NoError(t, err)
+	pinnedLayout, err := entities.ExportBombLayout(pinned.CreateBomb(pinnedRNG, config.BombConfigs[0]))
+	assert.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, pinnedLayout, latestLayout)
+}
+
+func TestBombGenerator_RejectsUnknownVersion(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("unknown")
+
+	// Act
+	_, err := services.NewBombGenerator(valueobject.LatestGeneratorVersion+1, rng)
+
+	// Assert
+	var validationErrs valueobject.ValidationErrors
+	assert.ErrorAs(t, err, &validationErrs)
+}
+
+func TestBombGenerator_PortPlatesCanBeEmpty(t *testing.T) {
+	for _, portCount := range []int{0, 3, valueobject.MaxPortsAllowed} {
+		// Arrange
+		config := valueobject.NewDefaultBombConfig()
+		config.PortCount = portCount
+		emptyPlate := false
+
+		for i := range 50 {
+			rng := services.NewSeededRNGFromString(fmt.Sprintf("plates-%d-%d", portCount, i))
+			generator, err := services.NewBombGenerator(valueobject.GeneratorVersion3, rng)
+			assert.NoError(t, err)
+
+			// Act
+			bomb := generator.CreateBomb(rng, config)
+
+			// Assert
+			assert.Len(t, bomb.Ports, portCount, "The bomb should have exactly PortCount ports")
+			assert.LessOrEqual(t, len(bomb.PortPlates), valueobject.MaxPortPlatesAllowed)
+			emptyPlate = emptyPlate || bomb.Edgework().HasEmptyPortPlate()
+		}
+		assert.True(t, emptyPlate, "Some bomb with %d ports should have an empty plate", portCount)
+	}
+}
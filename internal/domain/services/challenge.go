@@ -0,0 +1,58 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+)
+
+// Derives the challenge for the period containing t. The same secret, period and date
+// always give the same seed and level, and so the same bomb.
+func NewChallenge(secret []byte, period valueobject.ChallengePeriod, t time.Time) (valueobject.Challenge, error) {
+	id, startsAt, endsAt, err := valueobject.ChallengeWindow(period, t)
+	if err != nil {
+		return valueobject.Challenge{}, err
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(id))
+	seed := hex.EncodeToString(mac.Sum(nil)[:8])
+
+	minLevel, maxLevel := valueobject.ChallengeLevelRange(period)
+	rng := NewSeededRNGFromString(seed)
+
+	return valueobject.Challenge{
+		ID:       id,
+		Period:   period,
+		StartsAt: startsAt,
+		EndsAt:   endsAt,
+		Seed:     seed,
+		Level:    rng.GetIntInRange(minLevel, maxLevel),
+	}, nil
+}
+
+// Signs a player ID so challenges can tell it was issued with the same secret. The signed
+// ID is the player's ID from then on.
+func SignChallengePlayerID(secret []byte, playerID string) string {
+	return playerID + "." + challengePlayerSignature(secret, playerID)
+}
+
+// Reports whether the signed player ID was issued with the secret.
+func VerifyChallengePlayerID(secret []byte, signedID string) bool {
+	playerID, signature, ok := strings.Cut(signedID, ".")
+	if !ok || playerID == "" {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(challengePlayerSignature(secret, playerID)))
+}
+
+func challengePlayerSignature(secret []byte, playerID string) string {
+	mac := hmac.New(sha256.New, secret)
+	// Keeps player signatures apart from challenge seeds, which use the same secret
+	mac.Write([]byte("player:" + playerID))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+)
+
+const (
+	pointsPerSolvedModule = 100
+	pointsForDefusing     = 500
+	pointsPerSecondLeft   = 1
+	penaltyPerStrike      = 50
+	penaltyPerNeedyStrike = 25
+	// Every difficulty step adds 10% to a bomb's score
+	difficultyMultiplierStep = 0.1
+)
+
+// Builds the report for a session from its bombs (in play order) and the module stats the
+// session recorded. The state is the session's overall outcome. Strikes and time left that
+// a modifier hides are left out until their bomb is finished.
+func BuildGameReport(session *entities.GameSession, bombs []entities.BombSnapshot, state valueobject.SessionState) valueobject.GameReport {
+	report := valueobject.GameReport{
+		SessionID:   session.SessionID,
+		State:       state,
+		Seed:        session.Seed,
+		Leaderboard: session.Leaderboard,
+		Difficulty:  session.Difficulty,
+		Bombs:       make([]valueobject.BombReport, 0, len(bombs)),
+	}
+
+	strikesHidden := false
+	for _, bomb := range bombs {
+		bombReport := buildBombReport(session, bomb)
+		report.Score += bombReport.Score
+		report.Bombs = append(report.Bombs, bombReport)
+		strikesHidden = strikesHidden || (bomb.StrikesHidden() && state == valueobject.SessionStateInProgress)
+	}
+
+	for _, player := range session.Lobby().Players {
+		if player.Role == valueobject.PlayerRoleDefuser {
+			defuser := valueobject.DefuserReport{PlayerID: player.PlayerID}
+			// A defuser's strikes would give away the strikes of the bomb still in play
+			if !strikesHidden {
+				defuser.Strikes = session.DefuserStrikes[player.PlayerID]
+			}
+			report.Defusers = append(report.Defusers, defuser)
+		}
+	}
+
+	return report
+}
+
+// Scores a bomb: points for every solved module and every second left on a defused bomb,
+// a bonus for defusing, and penalties for strikes. Needy strikes are penalised on top of
+// the regular strike penalty. The result is scaled by difficulty and never negative.
+func ScoreBomb(bomb valueobject.BombReport, difficulty int) int {
+	score := bomb.ModulesSolved*pointsPerSolvedModule -
+		bomb.Strikes*penaltyPerStrike -
+		bomb.NeedyFailures*penaltyPerNeedyStrike
+
+	if bomb.State == valueobject.BombStateDefused {
+		score += pointsForDefusing + int(bomb.TimeRemaining.Seconds())*pointsPerSecondLeft
+	}
+
+	multiplier := 1 + difficultyMultiplierStep*float64(max(difficulty, 0))
+	return max(int(float64(score)*multiplier), 0)
+}
+
+func buildBombReport(session *entities.GameSession, bomb entities.BombSnapshot) valueobject.BombReport {
+	report := valueobject.BombReport{
+		BombID:        bomb.ID,
+		State:         bomb.State,
+		TimeRemaining: bomb.TimeLeft,
+		Strikes:       bomb.StrikeCount,
+		ModulesSolved: bomb.ModulesSolved,
+		ModulesTotal:  bomb.ModulesTotal,
+		Modules:       make([]valueobject.ModuleReport, 0, len(bomb.Modules)),
+	}
+
+	armedAt := bomb.StartedAt
+	sinceArmed := func(t *time.Time) *time.Duration {
+		if t == nil || armedAt == nil {
+			return nil
+		}
+		offset := t.Sub(*armedAt)
+		return &offset
+	}
+
+	for _, module := range bomb.Modules {
+		if module.Type == valueobject.ClockModule {
+			continue
+		}
+
+		// Strikes come from the bomb, which also counts needy countdowns that ran out
+		moduleReport := valueobject.ModuleReport{
+			ModuleID: module.ModuleID,
+			Type:     module.Type,
+			Position: module.Position,
+			Strikes:  module.Strikes,
+		}
+		if bomb.StrikesHidden() {
+			moduleReport.Strikes = 0
+		}
+		if module.Type.IsNeedy() {
+			report.NeedyFailures += moduleReport.Strikes
+		}
+
+		if stats, ok := session.ModuleStats[module.ModuleID]; ok {
+			moduleReport.FirstInteraction = sinceArmed(stats.FirstInteractionAt)
+			moduleReport.SolvedAt = sinceArmed(stats.SolvedAt)
+		}
+
+		report.Modules = append(report.Modules, moduleReport)
+	}
+
+	if bomb.StrikesHidden() {
+		report.Strikes = 0
+	}
+	if bomb.TimerHidden() {
+		report.TimeRemaining = 0
+	}
+	// Scored after hiding, as the strike penalty would give the strikes away
+	report.Score = ScoreBomb(report, session.Difficulty)
+
+	return report
+}
@@ -0,0 +1,100 @@
+package services_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreBomb(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		bomb       valueobject.BombReport
+		difficulty int
+		want       int
+	}{
+		{
+			name: "nothing solved",
+			bomb: valueobject.BombReport{State: valueobject.BombStateArmed},
+			want: 0,
+		},
+		{
+			name: "solved modules without defusing",
+			bomb: valueobject.BombReport{State: valueobject.BombStateExploded, ModulesSolved: 3, TimeRemaining: time.Minute},
+			want: 300,
+		},
+		{
+			name: "defused with time left",
+			bomb: valueobject.BombReport{State: valueobject.BombStateDefused, ModulesSolved: 3, TimeRemaining: 90*time.Second + 500*time.Millisecond},
+			want: 300 + 500 + 90,
+		},
+		{
+			name: "strikes",
+			bomb: valueobject.BombReport{State: valueobject.BombStateDefused, ModulesSolved: 2, Strikes: 2},
+			want: 200 + 500 - 100,
+		},
+		{
+			name: "needy strikes are penalised on top of the strike",
+			bomb: valueobject.BombReport{State: valueobject.BombStateDefused, ModulesSolved: 2, Strikes: 1, NeedyFailures: 1},
+			want: 200 + 500 - 50 - 25,
+		},
+		{
+			name:       "difficulty scales the score",
+			bomb:       valueobject.BombReport{State: valueobject.BombStateDefused, ModulesSolved: 5},
+			difficulty: 5,
+			want:       1500,
+		},
+		{
+			name:       "negative difficulty counts as none",
+			bomb:       valueobject.BombReport{State: valueobject.BombStateDefused, ModulesSolved: 5},
+			difficulty: -3,
+			want:       1000,
+		},
+		{
+			name: "never negative",
+			bomb: valueobject.BombReport{State: valueobject.BombStateExploded, Strikes: 3, NeedyFailures: 2},
+			want: 0,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			score := services.ScoreBomb(tc.bomb, tc.difficulty)
+
+			// Assert
+			assert.Equal(t, tc.want, score)
+		})
+	}
+}
+
+func TestBuildGameReport_CountsNeedyStrikesFromTheBomb(t *testing.T) {
+	// Arrange: the vent gas module was answered wrong once and timed out once
+	session := entities.NewGameSession(uuid.New())
+	ventGasID := uuid.New()
+	wiresID := uuid.New()
+	bomb := entities.BombSnapshot{
+		ID:          uuid.New(),
+		State:       valueobject.BombStateArmed,
+		StrikeCount: 3,
+		Modules: []entities.ModuleSnapshot{
+			{ModuleID: ventGasID, Type: valueobject.NeedyVentGasModule, Strikes: 2},
+			{ModuleID: wiresID, Type: valueobject.WiresModule, Strikes: 1},
+		},
+	}
+	session.RecordModuleInput(ventGasID, "", time.Now(), true, false)
+	session.RecordModuleInput(wiresID, "", time.Now(), true, false)
+
+	// Act
+	report := services.BuildGameReport(session, []entities.BombSnapshot{bomb}, valueobject.SessionStateInProgress)
+
+	// Assert
+	if assert.Len(t, report.Bombs, 1) {
+		assert.Equal(t, 2, report.Bombs[0].NeedyFailures, "The timeout strike should count as a needy failure")
+		assert.Equal(t, 2, report.Bombs[0].Modules[0].Strikes)
+		assert.Equal(t, 1, report.Bombs[0].Modules[1].Strikes)
+	}
+}
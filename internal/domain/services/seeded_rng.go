@@ -1,6 +1,7 @@
 package services
 
 import (
+	"encoding/binary"
 	"hash/fnv"
 	"math/rand/v2"
 )
@@ -22,6 +23,19 @@ func NewSeededRNGFromString(s string) *SeededRNG {
 	return NewSeededRNG(seed)
 }
 
+// Creates a generator whose stream is independent of the parent seed's stream. The label
+// names what the stream is for, so different labels never share draws.
+func NewDerivedRNG(seed uint64, label string) *SeededRNG {
+	return NewSeededRNG(deriveSeed(seed, label))
+}
+
+func deriveSeed(seed uint64, label string) uint64 {
+	h := fnv.New64a()
+	h.Write(binary.LittleEndian.AppendUint64(nil, seed))
+	h.Write([]byte(label))
+	return h.Sum64()
+}
+
 func stringToSeed(s string) uint64 {
 	h := fnv.New64a()
 	h.Write([]byte(s))
@@ -0,0 +1,21 @@
+package valueobject
+
+type BatteryType int
+
+const (
+	// Holds two AA batteries
+	BatteryTypeAA BatteryType = iota
+	// Holds a single D battery
+	BatteryTypeD
+)
+
+type BatteryHolder struct {
+	Type BatteryType
+}
+
+func (h BatteryHolder) BatteryCount() int {
+	if h.Type == BatteryTypeAA {
+		return 2
+	}
+	return 1
+}
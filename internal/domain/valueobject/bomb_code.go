@@ -0,0 +1,31 @@
+package valueobject
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+var ErrInvalidBombCode = errors.New("invalid bomb code")
+
+// Imported bombs are ranked on a leaderboard shared by everyone importing the same codes.
+func BombCodeLeaderboardKey(codes []string) LeaderboardKey {
+	sum := sha256.Sum256([]byte(strings.Join(codes, ".")))
+	return LeaderboardKey("code:" + hex.EncodeToString(sum[:8]))
+}
+
+// Creates config for bombs imported from bomb codes. The bomb configs only describe the
+// imported bombs; the bombs themselves are rebuilt from their codes.
+func NewGameSessionConfigFromBombCodes(seed string, codes []string, bombConfigs []BombConfig, mode BombMode) (GameSessionConfig, error) {
+	if err := ValidateBombCount(len(bombConfigs)); err != nil {
+		return GameSessionConfig{}, ValidationErrors{*err}
+	}
+
+	return GameSessionConfig{
+		seed:        ResolveSeed(seed),
+		BombConfigs: bombConfigs,
+		BombMode:    mode,
+		Leaderboard: BombCodeLeaderboardKey(codes),
+	}, nil
+}
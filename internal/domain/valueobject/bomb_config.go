@@ -2,11 +2,17 @@ package valueobject
 
 import "time"
 
+// Timer speeds used by the original game: each strike makes the clock tick 25% faster
+var DefaultStrikeTimerRates = []float64{1.0, 1.25, 1.5, 1.75, 2.0}
+
 type BombConfig struct {
 	// Duration of the bomb timer
 	Timer time.Duration
 	// Number of acceptable strikes before the bomb explodes
 	MaxStrikes int
+	// Timer speed multipliers indexed by strike count. The last entry is used for any
+	// strike count past the end. If empty, the timer never speeds up.
+	StrikeTimerRates []float64
 	// Number of faces on the bomb that hold modules
 	NumFaces int
 	// Minimum number of modules on the bomb
@@ -21,7 +27,7 @@ type BombConfig struct {
 	MaxBatteries int
 	// Max number of lit indicators that can appear on edgework
 	MaxIndicatorCount int
-	// Maximum number of ports on the bomb
+	// Number of ports on the bomb, spread across its port plates
 	PortCount int
 	// Max number of columns for modules
 	Columns int
@@ -33,12 +39,18 @@ type BombConfig struct {
 	// MissionSection - if set, indicates which mission section this is from
 	// Used for determining the random module pool
 	MissionSection int
+	// MissionName - if set, the name of the mission this config was built from
+	MissionName string
+	// Practice bombs never explode: strikes are only counted and running out of time is
+	// ignored
+	Practice bool
 }
 
 func NewDefaultBombConfig() BombConfig {
 	return BombConfig{
 		Timer:             5 * time.Minute,
 		MaxStrikes:        3,
+		StrikeTimerRates:  DefaultStrikeTimerRates,
 		NumFaces:          1,
 		MinModules:        4,
 		MaxModulesPerFace: 3,
@@ -0,0 +1,11 @@
+package valueobject
+
+// How the bombs in a multi-bomb session are played
+type BombMode int
+
+const (
+	// Every bomb is armed at the start and all of them must be defused
+	BombModeParallel BombMode = iota
+	// Bombs are armed one at a time. The next bomb arms when the previous is defused.
+	BombModeSequential
+)
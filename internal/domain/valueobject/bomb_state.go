@@ -0,0 +1,29 @@
+package valueobject
+
+type BombState int
+
+const (
+	// Waiting for an earlier bomb to be defused before arming
+	BombStateWaiting BombState = iota
+	BombStateArmed
+	BombStateDefused
+	BombStateExploded
+	// Armed, but with the clock held while the session is paused
+	BombStatePaused
+)
+
+func (s BombState) IsFinished() bool {
+	return s == BombStateDefused || s == BombStateExploded
+}
+
+type SessionState int
+
+const (
+	SessionStateInProgress SessionState = iota
+	// Every bomb in the session was defused
+	SessionStateCompleted
+	// At least one bomb in the session exploded
+	SessionStateFailed
+	// Waiting in the lobby for the game to start
+	SessionStateLobby
+)
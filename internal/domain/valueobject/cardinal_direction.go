@@ -8,3 +8,18 @@ const (
 	East
 	West
 )
+
+func (d CardinalDirection) String() string {
+	switch d {
+	case North:
+		return "north"
+	case South:
+		return "south"
+	case East:
+		return "east"
+	case West:
+		return "west"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,100 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrChallengeAlreadyAttempted = errors.New("challenge was already attempted by this player")
+	ErrUnknownChallengePeriod    = errors.New("unknown challenge period")
+	ErrChallengeNotStarted       = errors.New("challenge hasn't started yet")
+	// Challenge bombs are only shown to players attempting them
+	ErrChallengeNotDescribable = errors.New("challenge bombs can't be described ahead of an attempt")
+	// Free player IDs would let a player practise a challenge on throwaway IDs
+	ErrTooManyChallengePlayers = errors.New("too many player IDs were issued to this client, try again later")
+)
+
+type ChallengePeriod int
+
+const (
+	ChallengePeriodDaily ChallengePeriod = iota
+	ChallengePeriodWeekly
+)
+
+// Level range challenges are drawn from. Weekly challenges are harder than daily ones.
+var challengeLevels = map[ChallengePeriod][2]int{
+	ChallengePeriodDaily:  {3, 7},
+	ChallengePeriodWeekly: {6, 10},
+}
+
+// A bomb everyone plays during the same day or week. The seed is derived from the period
+// and a server secret, so players can't work out upcoming challenges.
+type Challenge struct {
+	// "daily:2006-01-02" or "weekly:2006-W01"
+	ID       string
+	Period   ChallengePeriod
+	StartsAt time.Time
+	EndsAt   time.Time
+	Seed     string
+	Level    int
+}
+
+// Returns the ID and start of the challenge period that contains t, in UTC. Weekly
+// challenges follow ISO weeks and start on Monday.
+func ChallengeWindow(period ChallengePeriod, t time.Time) (id string, startsAt time.Time, endsAt time.Time, err error) {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch period {
+	case ChallengePeriodDaily:
+		return "daily:" + day.Format(time.DateOnly), day, day.AddDate(0, 0, 1), nil
+	case ChallengePeriodWeekly:
+		// Monday is the first day of an ISO week
+		monday := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		year, week := monday.ISOWeek()
+		return fmt.Sprintf("weekly:%d-W%02d", year, week), monday, monday.AddDate(0, 0, 7), nil
+	default:
+		return "", time.Time{}, time.Time{}, fmt.Errorf("%w: %d", ErrUnknownChallengePeriod, period)
+	}
+}
+
+// Returns the lowest and highest level a challenge of the given period can use.
+func ChallengeLevelRange(period ChallengePeriod) (minLevel int, maxLevel int) {
+	levels := challengeLevels[period]
+	return levels[0], levels[1]
+}
+
+func ChallengeLeaderboardKey(challengeID string) LeaderboardKey {
+	return LeaderboardKey("challenge:" + challengeID)
+}
+
+// Longest player ID a challenge accepts. Issued IDs are well under it.
+const maxPlayerIDLength = 128
+
+func ValidatePlayerID(playerID string) *ValidationError {
+	if strings.TrimSpace(playerID) == "" {
+		return &ValidationError{Field: "player_id", Message: "player ID is required to attempt a challenge"}
+	}
+	if len(playerID) > maxPlayerIDLength {
+		return &ValidationError{Field: "player_id", Message: fmt.Sprintf("player ID can't be longer than %d characters", maxPlayerIDLength)}
+	}
+	return nil
+}
+
+// Creates config for a challenge. Challenges are ranked on their own leaderboard.
+func NewGameSessionConfigForChallenge(challenge Challenge) (GameSessionConfig, error) {
+	config, err := NewGameSessionConfigFromLevel(challenge.Seed, challenge.Level)
+	if err != nil {
+		return GameSessionConfig{}, err
+	}
+
+	config.Leaderboard = ChallengeLeaderboardKey(challenge.ID)
+	// Anyone with the seed could generate the bomb and solve it before their attempt
+	config.SeedHidden = true
+	// Every attempt at a challenge is ranked against the others, so none can be paused
+	config.PauseDisabled = true
+	return config, nil
+}
@@ -1,5 +1,7 @@
 package valueobject
 
+import "time"
+
 // Handles conversion from various config sources to BombConfig
 type BombConfigBuilder struct{}
 
@@ -13,8 +15,11 @@ func (b *BombConfigBuilder) FromMission(mission Mission) (BombConfig, error) {
 		return BombConfig{}, err
 	}
 
-	def := MissionDefinitions[mission]
+	return b.FromMissionDefinition(MissionDefinitions[mission]), nil
+}
 
+// Creates a BombConfig from a mission definition, either built-in or from a mission pack
+func (b *BombConfigBuilder) FromMissionDefinition(def MissionDefinition) BombConfig {
 	// Calculate total modules for grid sizing
 	totalModules := 0
 	for _, spec := range def.Modules {
@@ -29,6 +34,7 @@ func (b *BombConfigBuilder) FromMission(mission Mission) (BombConfig, error) {
 	config := BombConfig{
 		Timer:             def.Timer,
 		MaxStrikes:        def.MaxStrikes,
+		StrikeTimerRates:  DefaultStrikeTimerRates,
 		NumFaces:          def.NumFaces,
 		MinModules:        totalModules,
 		MaxModulesPerFace: (totalModules + def.NumFaces - 1) / def.NumFaces,
@@ -41,9 +47,10 @@ func (b *BombConfigBuilder) FromMission(mission Mission) (BombConfig, error) {
 		Rows:              def.Rows,
 		ExplicitModules:   def.Modules,
 		MissionSection:    def.Section,
+		MissionName:       def.Name,
 	}
 
-	return config, nil
+	return config
 }
 
 // Creates a BombConfig from a difficulty level (1-10)
@@ -54,6 +61,26 @@ func (b *BombConfigBuilder) FromLevel(level int) (BombConfig, error) {
 	return BombConfigFromLevel(level), nil
 }
 
+// Builds a practice bomb holding a single module of the given type next to the clock.
+func (b *BombConfigBuilder) FromPracticeModule(moduleType ModuleType) BombConfig {
+	return BombConfig{
+		Timer:             MaxTimerSeconds * time.Second,
+		MaxStrikes:        MaxStrikes,
+		StrikeTimerRates:  []float64{1.0},
+		NumFaces:          1,
+		MinModules:        2,
+		MaxModulesPerFace: 2,
+		MinBatteries:      0,
+		MaxBatteries:      MaxBatteriesAllowed,
+		MaxIndicatorCount: MaxIndicatorsAllowed,
+		PortCount:         MaxPortsAllowed,
+		Columns:           2,
+		Rows:              1,
+		ExplicitModules:   []ModuleSpec{{Type: moduleType, Count: 1}},
+		Practice:          true,
+	}
+}
+
 func DefaultModuleWeights() map[ModuleType]float32 {
 	return map[ModuleType]float32{
 		ClockModule:            0.0,
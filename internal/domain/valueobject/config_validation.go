@@ -1,7 +1,6 @@
 package valueobject
 
 import (
-	"errors"
 	"fmt"
 	"strings"
 )
@@ -12,6 +11,8 @@ const (
 	MaxTimerSeconds      = 3600 // 1 hour
 	MinStrikes           = 1
 	MaxStrikes           = 10
+	MinStrikeTimerRate   = 0.1
+	MaxStrikeTimerRate   = 10.0
 	MinFaces             = 1
 	MaxFaces             = 10
 	MinRows              = 1
@@ -25,6 +26,10 @@ const (
 	MaxBatteriesAllowed  = 6
 	MaxIndicatorsAllowed = 5
 	MaxPortsAllowed      = 6
+	// The first generator gave every port its own plate
+	MaxPortPlatesAllowed = MaxPortsAllowed
+	MinBombs             = 1
+	MaxBombs             = 10
 	MinLevel             = 1
 	MaxLevel             = 10
 )
@@ -88,6 +93,23 @@ func ValidateBombConfig(config BombConfig) ValidationErrors {
 		})
 	}
 
+	// Timer rate validation
+	if len(config.StrikeTimerRates) > MaxStrikes+1 {
+		errs = append(errs, ValidationError{
+			Field:   "strike_timer_rates",
+			Message: fmt.Sprintf("cannot have more than %d entries", MaxStrikes+1),
+		})
+	}
+	for _, rate := range config.StrikeTimerRates {
+		if rate < MinStrikeTimerRate || rate > MaxStrikeTimerRate {
+			errs = append(errs, ValidationError{
+				Field:   "strike_timer_rates",
+				Message: fmt.Sprintf("rates must be between %.1f and %.1f", MinStrikeTimerRate, MaxStrikeTimerRate),
+			})
+			break
+		}
+	}
+
 	// Faces validation
 	if config.NumFaces < MinFaces {
 		errs = append(errs, ValidationError{
@@ -179,6 +201,36 @@ func ValidateBombConfig(config BombConfig) ValidationErrors {
 	return errs
 }
 
+func ValidateBombCount(numBombs int) *ValidationError {
+	if numBombs < MinBombs || numBombs > MaxBombs {
+		return &ValidationError{
+			Field:   "num_bombs",
+			Message: fmt.Sprintf("must be between %d and %d", MinBombs, MaxBombs),
+		}
+	}
+	return nil
+}
+
+// Practice mode drills modules that can be solved, so the clock, needy modules and random
+// picks can't be practiced.
+func ValidatePracticeModule(moduleType ModuleType) *ValidationError {
+	if moduleType == ClockModule || moduleType == RandomModule || moduleType.IsNeedy() {
+		return &ValidationError{
+			Field:   "module_type",
+			Message: fmt.Sprintf("%s can't be practiced", moduleType),
+		}
+	}
+
+	if _, exists := moduleTypeNames[moduleType]; !exists {
+		return &ValidationError{
+			Field:   "module_type",
+			Message: "unknown module type",
+		}
+	}
+
+	return nil
+}
+
 func ValidateLevel(level int) error {
 	if level < MinLevel || level > MaxLevel {
 		return fmt.Errorf("level must be between %d and %d", MinLevel, MaxLevel)
@@ -188,7 +240,76 @@ func ValidateLevel(level int) error {
 
 func ValidateMission(mission Mission) error {
 	if _, exists := MissionDefinitions[mission]; !exists {
-		return errors.New("unknown mission")
+		return ErrUnknownMission
 	}
 	return nil
 }
+
+// Validates a mission definition, such as one loaded from a mission pack. The bomb it
+// produces must also pass ValidateBombConfig.
+func ValidateMissionDefinition(def MissionDefinition) ValidationErrors {
+	var errs ValidationErrors
+
+	if strings.TrimSpace(def.Name) == "" {
+		errs = append(errs, ValidationError{
+			Field:   "name",
+			Message: "must not be empty",
+		})
+	}
+
+	if _, exists := SectionModulePools[def.Section]; !exists {
+		errs = append(errs, ValidationError{
+			Field:   "section",
+			Message: fmt.Sprintf("must be between %d and %d", 1, len(SectionModulePools)),
+		})
+	}
+
+	// Checked up front since the module layout is divided across faces
+	if def.NumFaces < MinFaces {
+		errs = append(errs, ValidationError{
+			Field:   "num_faces",
+			Message: fmt.Sprintf("must be at least %d", MinFaces),
+		})
+	}
+
+	if def.NumBombs != 0 {
+		if err := ValidateBombCount(def.NumBombs); err != nil {
+			errs = append(errs, *err)
+		}
+	}
+
+	if len(def.Modules) == 0 {
+		errs = append(errs, ValidationError{
+			Field:   "modules",
+			Message: "must contain at least one module",
+		})
+	}
+
+	for i, spec := range def.Modules {
+		if spec.Count < 1 {
+			errs = append(errs, ValidationError{
+				Field:   fmt.Sprintf("modules[%d].count", i),
+				Message: "must be at least 1",
+			})
+		}
+
+		types := spec.PossibleTypes
+		if len(types) == 0 {
+			types = []ModuleType{spec.Type}
+		}
+		for _, moduleType := range types {
+			if moduleType == ClockModule {
+				errs = append(errs, ValidationError{
+					Field:   fmt.Sprintf("modules[%d].type", i),
+					Message: "clock is added to every bomb automatically",
+				})
+			}
+		}
+	}
+
+	if errs.HasErrors() {
+		return errs
+	}
+
+	return ValidateBombConfig(NewBombConfigBuilder().FromMissionDefinition(def))
+}
@@ -0,0 +1,121 @@
+package valueobject
+
+import "strings"
+
+// Everything on the outside of a bomb that module rules refer to: the serial number,
+// indicators, batteries and ports. Queries are named after the way the manual phrases them.
+type Edgework struct {
+	SerialNumber   string
+	Indicators     map[string]Indicator
+	Batteries      int
+	BatteryHolders []BatteryHolder
+	Ports          []Port
+	PortPlates     []PortPlate
+}
+
+// Returns the last digit in the serial number, or false if it doesn't contain one.
+func (e Edgework) SerialLastDigit() (int, bool) {
+	for i := len(e.SerialNumber) - 1; i >= 0; i-- {
+		char := e.SerialNumber[i]
+		if char >= '0' && char <= '9' {
+			return int(char - '0'), true
+		}
+	}
+
+	return 0, false
+}
+
+func (e Edgework) SerialLastDigitOdd() bool {
+	digit, ok := e.SerialLastDigit()
+	return ok && digit%2 != 0
+}
+
+func (e Edgework) SerialLastDigitEven() bool {
+	digit, ok := e.SerialLastDigit()
+	return ok && digit%2 == 0
+}
+
+func (e Edgework) SerialContainsVowel() bool {
+	return strings.ContainsAny(strings.ToUpper(e.SerialNumber), "AEIOU")
+}
+
+func (e Edgework) HasIndicator(label string) bool {
+	_, exists := e.Indicators[label]
+	return exists
+}
+
+func (e Edgework) HasLitIndicator(label string) bool {
+	indicator, exists := e.Indicators[label]
+	return exists && indicator.Lit
+}
+
+func (e Edgework) HasUnlitIndicator(label string) bool {
+	indicator, exists := e.Indicators[label]
+	return exists && !indicator.Lit
+}
+
+func (e Edgework) LitIndicatorCount() int {
+	count := 0
+	for _, indicator := range e.Indicators {
+		if indicator.Lit {
+			count++
+		}
+	}
+	return count
+}
+
+func (e Edgework) UnlitIndicatorCount() int {
+	return len(e.Indicators) - e.LitIndicatorCount()
+}
+
+func (e Edgework) BatteryCount() int {
+	return e.Batteries
+}
+
+func (e Edgework) BatteryHolderCount() int {
+	return len(e.BatteryHolders)
+}
+
+func (e Edgework) HasPort(port Port) bool {
+	return e.PortCount(port) > 0
+}
+
+// Returns how many ports of the given type are on the bomb.
+func (e Edgework) PortCount(port Port) int {
+	count := 0
+	for _, p := range e.Ports {
+		if p == port {
+			count++
+		}
+	}
+	return count
+}
+
+func (e Edgework) TotalPortCount() int {
+	return len(e.Ports)
+}
+
+// Returns whether any port type appears more than once across all plates.
+func (e Edgework) HasDuplicatePorts() bool {
+	seen := make(map[Port]bool, len(e.Ports))
+	for _, port := range e.Ports {
+		if seen[port] {
+			return true
+		}
+		seen[port] = true
+	}
+	return false
+}
+
+func (e Edgework) PortPlateCount() int {
+	return len(e.PortPlates)
+}
+
+func (e Edgework) HasEmptyPortPlate() bool {
+	for _, plate := range e.PortPlates {
+		if plate.IsEmpty() {
+			return true
+		}
+	}
+	return false
+}
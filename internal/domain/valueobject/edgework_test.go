@@ -0,0 +1,142 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEdgework_SerialNumber(t *testing.T) {
+	tests := []struct {
+		serial    string
+		lastDigit int
+		hasDigit  bool
+		odd       bool
+		even      bool
+		vowel     bool
+	}{
+		{serial: "AL5QF3", lastDigit: 3, hasDigit: true, odd: true, vowel: true},
+		{serial: "BX4ZK8", lastDigit: 8, hasDigit: true, even: true},
+		{serial: "9B0TE0", lastDigit: 0, hasDigit: true, even: true, vowel: true},
+		{serial: "1111", lastDigit: 1, hasDigit: true, odd: true},
+		// The last digit doesn't have to be the last character
+		{serial: "AB7CUD", lastDigit: 7, hasDigit: true, odd: true, vowel: true},
+		{serial: "aaa", vowel: true},
+		{serial: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.serial, func(t *testing.T) {
+			// Arrange
+			edgework := valueobject.Edgework{SerialNumber: tt.serial}
+
+			// Act
+			digit, ok := edgework.SerialLastDigit()
+
+			// Assert
+			assert.Equal(t, tt.hasDigit, ok)
+			assert.Equal(t, tt.lastDigit, digit)
+			assert.Equal(t, tt.odd, edgework.SerialLastDigitOdd())
+			assert.Equal(t, tt.even, edgework.SerialLastDigitEven())
+			assert.Equal(t, tt.vowel, edgework.SerialContainsVowel())
+		})
+	}
+}
+
+func TestEdgework_Indicators(t *testing.T) {
+	// Arrange
+	edgework := valueobject.Edgework{
+		Indicators: map[string]valueobject.Indicator{
+			"FRK": {Label: "FRK", Lit: true},
+			"CAR": {Label: "CAR", Lit: false},
+			"BOB": {Label: "BOB", Lit: true},
+		},
+	}
+
+	// Act & Assert
+	assert.True(t, edgework.HasIndicator("FRK"))
+	assert.True(t, edgework.HasIndicator("CAR"))
+	assert.False(t, edgework.HasIndicator("SND"))
+
+	assert.True(t, edgework.HasLitIndicator("FRK"))
+	assert.False(t, edgework.HasLitIndicator("CAR"))
+	assert.False(t, edgework.HasLitIndicator("SND"), "A missing indicator is neither lit nor unlit")
+
+	assert.True(t, edgework.HasUnlitIndicator("CAR"))
+	assert.False(t, edgework.HasUnlitIndicator("FRK"))
+	assert.False(t, edgework.HasUnlitIndicator("SND"), "A missing indicator is neither lit nor unlit")
+
+	assert.Equal(t, 2, edgework.LitIndicatorCount())
+	assert.Equal(t, 1, edgework.UnlitIndicatorCount())
+}
+
+func TestEdgework_Batteries(t *testing.T) {
+	// Arrange
+	edgework := valueobject.Edgework{
+		Batteries: 5,
+		BatteryHolders: []valueobject.BatteryHolder{
+			{Type: valueobject.BatteryTypeAA},
+			{Type: valueobject.BatteryTypeAA},
+			{Type: valueobject.BatteryTypeD},
+		},
+	}
+
+	// Act & Assert
+	assert.Equal(t, 5, edgework.BatteryCount())
+	assert.Equal(t, 3, edgework.BatteryHolderCount())
+	assert.Equal(t, 2, edgework.BatteryHolders[0].BatteryCount())
+	assert.Equal(t, 1, edgework.BatteryHolders[2].BatteryCount())
+}
+
+func TestEdgework_Ports(t *testing.T) {
+	// Arrange
+	plates := []valueobject.PortPlate{
+		{Ports: []valueobject.Port{valueobject.PortDVID, valueobject.PortRJ45}},
+		{Ports: []valueobject.Port{valueobject.PortSerial}},
+	}
+	edgework := valueobject.Edgework{
+		Ports:      []valueobject.Port{valueobject.PortDVID, valueobject.PortRJ45, valueobject.PortSerial},
+		PortPlates: plates,
+	}
+
+	// Act & Assert
+	assert.True(t, edgework.HasPort(valueobject.PortDVID))
+	assert.True(t, edgework.HasPort(valueobject.PortSerial))
+	assert.False(t, edgework.HasPort(valueobject.PortParallel))
+	assert.Equal(t, 1, edgework.PortCount(valueobject.PortRJ45))
+	assert.Equal(t, 3, edgework.TotalPortCount())
+	assert.Equal(t, 2, edgework.PortPlateCount())
+	assert.False(t, edgework.HasDuplicatePorts())
+	assert.False(t, edgework.HasEmptyPortPlate())
+}
+
+func TestEdgework_DuplicatePortsAndEmptyPlates(t *testing.T) {
+	// Arrange
+	edgework := valueobject.Edgework{
+		Ports: []valueobject.Port{valueobject.PortRCA, valueobject.PortRCA},
+		PortPlates: []valueobject.PortPlate{
+			{Ports: []valueobject.Port{valueobject.PortRCA}},
+			{Ports: []valueobject.Port{valueobject.PortRCA}},
+			{},
+		},
+	}
+
+	// Act & Assert
+	assert.True(t, edgework.HasDuplicatePorts())
+	assert.True(t, edgework.HasEmptyPortPlate())
+	assert.Equal(t, 2, edgework.PortCount(valueobject.PortRCA))
+}
+
+func TestEdgework_Empty(t *testing.T) {
+	// Arrange
+	edgework := valueobject.Edgework{}
+
+	// Act & Assert
+	assert.Equal(t, 0, edgework.BatteryCount())
+	assert.Equal(t, 0, edgework.BatteryHolderCount())
+	assert.Equal(t, 0, edgework.LitIndicatorCount())
+	assert.Equal(t, 0, edgework.TotalPortCount())
+	assert.False(t, edgework.HasEmptyPortPlate())
+	assert.False(t, edgework.HasDuplicatePorts())
+}
@@ -0,0 +1,58 @@
+package valueobject
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Summarises a finished (or in progress) session: its outcome, score and how every bomb
+// was played.
+type GameReport struct {
+	SessionID uuid.UUID
+	State     SessionState
+	Seed      string
+	// Empty for sessions that aren't ranked
+	Leaderboard LeaderboardKey
+	// Level for level games, section for missions and 0 for custom and practice games
+	Difficulty int
+	// Sum of every bomb's score
+	Score int
+	// Bombs in the order they are played
+	Bombs []BombReport
+	// Every defuser in the order they joined
+	Defusers []DefuserReport
+}
+
+// How a defuser played in a game shared with other defusers
+type DefuserReport struct {
+	PlayerID string
+	// Strikes caused by input the defuser sent
+	Strikes int
+}
+
+type BombReport struct {
+	BombID        uuid.UUID
+	State         BombState
+	TimeRemaining time.Duration
+	Strikes       int
+	ModulesSolved int
+	ModulesTotal  int
+	// Strikes given by needy modules, from wrong input or from countdowns that ran out
+	NeedyFailures int
+	Score         int
+	// Every module except the clock, ordered by face, row and column
+	Modules []ModuleReport
+}
+
+// Timeline of a single module. Times are measured from when the bomb was armed.
+type ModuleReport struct {
+	ModuleID uuid.UUID
+	Type     ModuleType
+	Position ModulePosition
+	// Nil when the module was never touched
+	FirstInteraction *time.Duration
+	// Nil when the module wasn't solved
+	SolvedAt *time.Duration
+	Strikes  int
+}
@@ -2,6 +2,7 @@ package valueobject
 
 import (
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -9,11 +10,33 @@ import (
 type GameSessionConfig struct {
 	seed        string
 	BombConfigs []BombConfig
+	// How the bombs are played when there is more than one
+	BombMode BombMode
+	// Level for level games, section for missions and 0 for custom and practice games.
+	// Scales the end-of-game score.
+	Difficulty int
+	// Leaderboard completed sessions are ranked on. Sessions that don't set one are ranked
+	// by their config hash.
+	Leaderboard LeaderboardKey
+	// Generator the bombs are built with. The same seed and config only give the same
+	// bombs with the same generator.
+	GeneratorVersion GeneratorVersion
+	// Stops players from pausing the game, for competitive play
+	PauseDisabled bool
+	// Keeps the seed and bomb codes from players, for bombs that mustn't be generated
+	// ahead of playing them
+	SeedHidden bool
+	// How far behind the game spectators are kept so they can't relay what they see
+	SpectatorDelay time.Duration
+	// Set for sessions in a versus match, which start when the match does
+	StartedByMatch bool
+	// Optional rules every bomb in the session is played with
+	Modifiers []Modifier
 }
 
 func NewEasyGameSessionConfig(seed string) GameSessionConfig {
 	return GameSessionConfig{
-		seed: nonEmptySeed(seed),
+		seed: ResolveSeed(seed),
 		BombConfigs: []BombConfig{
 			NewDefaultBombConfig(),
 		},
@@ -33,42 +56,125 @@ func NewGameSessionConfigFromLevel(seed string, level int) (GameSessionConfig, e
 	}
 
 	return GameSessionConfig{
-		seed:        nonEmptySeed(seed),
+		seed:        ResolveSeed(seed),
 		BombConfigs: []BombConfig{bombConfig},
+		Difficulty:  level,
+		Leaderboard: LevelLeaderboardKey(level),
 	}, nil
 }
 
 // Creates config for preset missions
 func NewGameSessionConfigFromMission(seed string, mission Mission) (GameSessionConfig, error) {
-	builder := NewBombConfigBuilder()
-	bombConfig, err := builder.FromMission(mission)
-	if err != nil {
+	if err := ValidateMission(mission); err != nil {
 		return GameSessionConfig{}, err
 	}
 
+	config := NewGameSessionConfigFromMissionDefinition(seed, MissionDefinitions[mission])
+	config.Leaderboard = MissionLeaderboardKey(mission)
+	return config, nil
+}
+
+// Creates config for a mission definition, either built-in or from a mission pack
+func NewGameSessionConfigFromMissionDefinition(seed string, def MissionDefinition) GameSessionConfig {
+	builder := NewBombConfigBuilder()
+	bombConfig := builder.FromMissionDefinition(def)
+
 	return GameSessionConfig{
-		seed:        nonEmptySeed(seed),
-		BombConfigs: []BombConfig{bombConfig},
-	}, nil
+		seed:        ResolveSeed(seed),
+		BombConfigs: repeatBombConfig(bombConfig, max(def.NumBombs, 1)),
+		BombMode:    def.BombMode,
+		Difficulty:  def.Section,
+	}
 }
 
-// Creates config from custom specification
-func NewGameSessionConfigFromCustom(seed string, bombConfig BombConfig) (GameSessionConfig, error) {
-	if errs := ValidateBombConfig(bombConfig); errs.HasErrors() {
+// Creates config from custom specification. Every bomb in the session uses the same spec.
+func NewGameSessionConfigFromCustom(seed string, bombConfig BombConfig, numBombs int, mode BombMode) (GameSessionConfig, error) {
+	errs := ValidateBombConfig(bombConfig)
+	if err := ValidateBombCount(numBombs); err != nil {
+		errs = append(errs, *err)
+	}
+	if errs.HasErrors() {
 		return GameSessionConfig{}, errs
 	}
 
 	return GameSessionConfig{
-		seed:        nonEmptySeed(seed),
-		BombConfigs: []BombConfig{bombConfig},
+		seed:        ResolveSeed(seed),
+		BombConfigs: repeatBombConfig(bombConfig, numBombs),
+		BombMode:    mode,
+	}, nil
+}
+
+// Creates config for practicing a single module type on an untimed bomb
+func NewGameSessionConfigForPractice(seed string, moduleType ModuleType) (GameSessionConfig, error) {
+	if err := ValidatePracticeModule(moduleType); err != nil {
+		return GameSessionConfig{}, ValidationErrors{*err}
+	}
+
+	builder := NewBombConfigBuilder()
+
+	return GameSessionConfig{
+		seed:        ResolveSeed(seed),
+		BombConfigs: []BombConfig{builder.FromPracticeModule(moduleType)},
 	}, nil
 }
 
+// Plays the session's bomb numBombs times in the given mode, for missions played with a
+// different number of bombs than they were made for. A session that no longer matches its
+// mission isn't ranked on the mission's leaderboard, only on its config hash.
+func (c GameSessionConfig) WithBombCount(numBombs int, mode BombMode) (GameSessionConfig, error) {
+	if err := ValidateBombCount(numBombs); err != nil {
+		return GameSessionConfig{}, ValidationErrors{*err}
+	}
+
+	if numBombs != len(c.BombConfigs) || mode != c.BombMode {
+		c.Leaderboard = ""
+	}
+	c.BombConfigs = repeatBombConfig(c.BombConfigs[0], numBombs)
+	c.BombMode = mode
+	return c, nil
+}
+
 func (c GameSessionConfig) Seed() string {
 	return c.seed
 }
 
-func nonEmptySeed(seed string) string {
+// Returns the hash identifying the settings the session's bombs are generated from.
+func (c GameSessionConfig) ConfigHash() string {
+	return HashBombConfigs(c.BombConfigs, c.BombMode, c.GeneratorVersion)
+}
+
+// Returns the leaderboard the session is ranked on. Practice sessions, sessions played
+// with modifiers and sessions that can be paused aren't ranked and return an empty key.
+// A paused game still shows every module, so a team could study them with the clock held.
+func (c GameSessionConfig) LeaderboardKey() LeaderboardKey {
+	if len(c.Modifiers) > 0 || !c.PauseDisabled {
+		return ""
+	}
+
+	for _, bombConfig := range c.BombConfigs {
+		if bombConfig.Practice {
+			return ""
+		}
+	}
+
+	if c.Leaderboard != "" {
+		return c.Leaderboard
+	}
+
+	return ConfigLeaderboardKey(c.ConfigHash())
+}
+
+func repeatBombConfig(config BombConfig, count int) []BombConfig {
+	configs := make([]BombConfig, count)
+	for i := range configs {
+		configs[i] = config
+	}
+	return configs
+}
+
+// Returns the seed a session is generated from: the given seed, or a new random one if it's
+// empty.
+func ResolveSeed(seed string) string {
 	if strings.TrimSpace(seed) == "" {
 		return uuid.NewString()
 	}
@@ -0,0 +1,38 @@
+package valueobject
+
+import "fmt"
+
+// Identifies the code that turns a seed and config into bombs. A released version must
+// keep producing the same bombs forever, so shared seeds and leaderboards stay valid. Any
+// change to the order or number of random calls made while generating a bomb needs a new
+// version.
+type GeneratorVersion int
+
+const (
+	// Picks the latest version
+	GeneratorVersionLatest GeneratorVersion = 0
+	// Every module drew from the one shared stream
+	GeneratorVersion1 GeneratorVersion = 1
+	// Every bomb and module slot draws from its own stream
+	GeneratorVersion2 GeneratorVersion = 2
+	// Port plates can be empty, and how many there are doesn't depend on the port count
+	GeneratorVersion3 GeneratorVersion = 3
+
+	LatestGeneratorVersion = GeneratorVersion3
+)
+
+// Resolves GeneratorVersionLatest to the current version and rejects unknown versions.
+func ResolveGeneratorVersion(version GeneratorVersion) (GeneratorVersion, error) {
+	if version == GeneratorVersionLatest {
+		return LatestGeneratorVersion, nil
+	}
+
+	if version < GeneratorVersion1 || version > LatestGeneratorVersion {
+		return 0, ValidationErrors{{
+			Field:   "generator_version",
+			Message: fmt.Sprintf("generator version must be between %d and %d", GeneratorVersion1, LatestGeneratorVersion),
+		}}
+	}
+
+	return version, nil
+}
@@ -5,17 +5,17 @@ type Indicator struct {
 	Lit   bool
 }
 
+// Every indicator label that can appear on a bomb. A label appears at most once.
 var AVAILABLE_INDICATOR_LABELS = []string{
-	"OCR",
+	"SND",
+	"CLR",
+	"CAR",
+	"IND",
+	"FRQ",
+	"SIG",
 	"NSA",
-	"CDN",
-	"ZIP",
-	"KEK",
-	"KPR",
-	"OSR",
-	"BPM",
+	"MSA",
+	"TRN",
+	"BOB",
 	"FRK",
-	"JFK",
-	"MTV",
-	"RKG",
 }
@@ -0,0 +1,163 @@
+package valueobject
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const MaxTeamNameLength = 32
+
+var (
+	ErrResultAlreadySubmitted = errors.New("result for this session was already submitted")
+	ErrSessionNotCompleted    = errors.New("only completed sessions can be submitted")
+	ErrSessionNotRanked       = errors.New("session is not ranked on a leaderboard")
+)
+
+// Identifies the leaderboard a session's result is ranked on: one per level, one per
+// mission and one per distinct custom config.
+type LeaderboardKey string
+
+func LevelLeaderboardKey(level int) LeaderboardKey {
+	return LeaderboardKey(fmt.Sprintf("level:%d", level))
+}
+
+func MissionLeaderboardKey(mission Mission) LeaderboardKey {
+	return LeaderboardKey(fmt.Sprintf("mission:%d", mission))
+}
+
+// Mission pack missions are keyed by their "<pack>/<mission>" ID.
+func MissionPackLeaderboardKey(missionID string) LeaderboardKey {
+	return LeaderboardKey("mission:" + missionID)
+}
+
+func ConfigLeaderboardKey(configHash string) LeaderboardKey {
+	return LeaderboardKey("config:" + configHash)
+}
+
+// A server-verified completion on a leaderboard.
+type LeaderboardEntry struct {
+	SessionID   uuid.UUID
+	Leaderboard LeaderboardKey
+	TeamName    string
+	// Time left across every bomb in the session
+	TimeRemaining time.Duration
+	// Strikes across every bomb in the session
+	Strikes     int
+	Seed        string
+	SubmittedAt time.Time
+}
+
+// Orders entries best first: most time remaining, then fewest strikes, then whoever
+// submitted first.
+func SortLeaderboardEntries(entries []LeaderboardEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.TimeRemaining != b.TimeRemaining {
+			return a.TimeRemaining > b.TimeRemaining
+		}
+		if a.Strikes != b.Strikes {
+			return a.Strikes < b.Strikes
+		}
+		return a.SubmittedAt.Before(b.SubmittedAt)
+	})
+}
+
+func ValidateTeamName(name string) *ValidationError {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return &ValidationError{Field: "team_name", Message: "team name must not be empty"}
+	}
+	if len(name) > MaxTeamNameLength {
+		return &ValidationError{Field: "team_name", Message: fmt.Sprintf("team name must be at most %d characters", MaxTeamNameLength)}
+	}
+	return nil
+}
+
+// Returns a short, stable hash of the bomb configs, bomb mode and generator version.
+// Sessions generated from identical settings share a hash, whatever their seed. The hash
+// ranks custom configs on the leaderboard, so it's taken over a canonical encoding that
+// only changes when one of the encoded settings does.
+func HashBombConfigs(configs []BombConfig, mode BombMode, version GeneratorVersion) string {
+	sum := sha256.Sum256([]byte(encodeBombConfigs(configs, mode, version)))
+	return hex.EncodeToString(sum[:8])
+}
+
+// Writes every setting that affects the bombs as "key=value" lines in a fixed order.
+// Module types are written by name and maps are sorted, so neither Go's formatting nor the
+// order of the ModuleType constants can change the result. A new BombConfig field only
+// changes hashes once it's added here.
+func encodeBombConfigs(configs []BombConfig, mode BombMode, version GeneratorVersion) string {
+	var sb strings.Builder
+	line := func(key string, value any) {
+		fmt.Fprintf(&sb, "%s=%v\n", key, value)
+	}
+
+	line("encoding", 1)
+	line("generator_version", int(version))
+	line("bomb_mode", bombModeKey(mode))
+
+	for i, config := range configs {
+		line("bomb", i)
+		line("timer_ms", config.Timer.Milliseconds())
+		line("max_strikes", config.MaxStrikes)
+		line("strike_timer_rates", joinFloats(config.StrikeTimerRates))
+		line("num_faces", config.NumFaces)
+		line("min_modules", config.MinModules)
+		line("max_modules_per_face", config.MaxModulesPerFace)
+		line("module_types", encodeModuleWeights(config.ModuleTypes))
+		line("min_batteries", config.MinBatteries)
+		line("max_batteries", config.MaxBatteries)
+		line("max_indicator_count", config.MaxIndicatorCount)
+		line("port_count", config.PortCount)
+		line("columns", config.Columns)
+		line("rows", config.Rows)
+		for _, spec := range config.ExplicitModules {
+			possible := make([]string, 0, len(spec.PossibleTypes))
+			for _, moduleType := range spec.PossibleTypes {
+				possible = append(possible, moduleType.String())
+			}
+			line("explicit_module", fmt.Sprintf("%s|%s|%d", spec.Type, strings.Join(possible, ","), spec.Count))
+		}
+		line("mission_section", config.MissionSection)
+		line("mission_name", strconv.Quote(config.MissionName))
+		line("practice", config.Practice)
+	}
+
+	return sb.String()
+}
+
+func bombModeKey(mode BombMode) string {
+	switch mode {
+	case BombModeParallel:
+		return "parallel"
+	case BombModeSequential:
+		return "sequential"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(mode))
+	}
+}
+
+func encodeModuleWeights(weights map[ModuleType]float32) string {
+	entries := make([]string, 0, len(weights))
+	for moduleType, weight := range weights {
+		entries = append(entries, moduleType.String()+":"+strconv.FormatFloat(float64(weight), 'g', -1, 32))
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ",")
+}
+
+func joinFloats(values []float64) string {
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		formatted = append(formatted, strconv.FormatFloat(value, 'g', -1, 64))
+	}
+	return strings.Join(formatted, ",")
+}
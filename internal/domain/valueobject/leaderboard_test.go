@@ -0,0 +1,74 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashBombConfigs_IsStable(t *testing.T) {
+	// Arrange
+	configs := []valueobject.BombConfig{valueobject.NewDefaultBombConfig()}
+
+	// Act
+	hash := valueobject.HashBombConfigs(configs, valueobject.BombModeParallel, valueobject.GeneratorVersion2)
+
+	// Assert: custom config leaderboards are keyed by this hash, so it must never change
+	assert.Equal(t, "d3947cfa17b87288", hash)
+}
+
+func TestHashBombConfigs_ChangesWithEverySetting(t *testing.T) {
+	base := valueobject.NewDefaultBombConfig()
+	baseHash := valueobject.HashBombConfigs([]valueobject.BombConfig{base}, valueobject.BombModeParallel, valueobject.GeneratorVersion2)
+
+	for _, tc := range []struct {
+		name    string
+		configs []valueobject.BombConfig
+		mode    valueobject.BombMode
+		version valueobject.GeneratorVersion
+	}{
+		{
+			name:    "generator version",
+			configs: []valueobject.BombConfig{base},
+			version: valueobject.GeneratorVersion1,
+		},
+		{
+			name:    "bomb mode",
+			configs: []valueobject.BombConfig{base},
+			mode:    valueobject.BombModeSequential,
+			version: valueobject.GeneratorVersion2,
+		},
+		{
+			name:    "number of bombs",
+			configs: []valueobject.BombConfig{base, base},
+			version: valueobject.GeneratorVersion2,
+		},
+		{
+			name: "module weights",
+			configs: func() []valueobject.BombConfig {
+				config := valueobject.NewDefaultBombConfig()
+				config.ModuleTypes[valueobject.WiresModule] = 0.5
+				return []valueobject.BombConfig{config}
+			}(),
+			version: valueobject.GeneratorVersion2,
+		},
+		{
+			name: "explicit modules",
+			configs: func() []valueobject.BombConfig {
+				config := valueobject.NewDefaultBombConfig()
+				config.ExplicitModules = []valueobject.ModuleSpec{{Type: valueobject.WiresModule, Count: 2}}
+				return []valueobject.BombConfig{config}
+			}(),
+			version: valueobject.GeneratorVersion2,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			hash := valueobject.HashBombConfigs(tc.configs, tc.mode, tc.version)
+
+			// Assert
+			assert.NotEqual(t, baseHash, hash)
+		})
+	}
+}
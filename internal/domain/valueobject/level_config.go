@@ -149,6 +149,7 @@ func BombConfigFromLevel(level int) BombConfig {
 	return BombConfig{
 		Timer:             params.Timer,
 		MaxStrikes:        params.MaxStrikes,
+		StrikeTimerRates:  DefaultStrikeTimerRates,
 		NumFaces:          params.NumFaces,
 		MinModules:        params.MinModules,
 		MaxModulesPerFace: params.MaxModulesPerFace,
@@ -0,0 +1,88 @@
+package valueobject
+
+import (
+	"errors"
+	"strings"
+)
+
+var (
+	ErrGameNotStarted      = errors.New("game hasn't started yet")
+	ErrGameAlreadyStarted  = errors.New("game has already started")
+	ErrPlayerNotInLobby    = errors.New("player hasn't joined the session")
+	ErrGamePaused          = errors.New("game is paused")
+	ErrGameNotPaused       = errors.New("game isn't paused")
+	ErrPauseDisabled       = errors.New("pausing is disabled for this session")
+	ErrNotSpectator        = errors.New("only spectators can watch a session")
+	ErrSpectatorsOnlyWatch = errors.New("spectators can only watch the game")
+	ErrNotDefuser          = errors.New("only defusers can own faces and send input")
+	ErrFaceNotOwned        = errors.New("module is on a face another defuser owns")
+	ErrNotFaceAssigner     = errors.New("only the defuser or the session's host can assign a defuser's faces")
+	ErrInputConflict       = errors.New("another defuser's input on this module is still being handled")
+)
+
+type PlayerRole int
+
+const (
+	// Sees the bomb and sends input
+	PlayerRoleDefuser PlayerRole = iota + 1
+	// Reads the manual to the defuser
+	PlayerRoleExpert
+	// Watches the game without taking part. Spectators can join after the game has started
+	// and don't hold it up by not being ready.
+	PlayerRoleSpectator
+)
+
+// A player waiting in a session's lobby
+type LobbyPlayer struct {
+	PlayerID string
+	Role     PlayerRole
+	Ready    bool
+	// Bomb faces the defuser owns, in ascending order. Only the owner can send input to
+	// modules on an owned face.
+	Faces []int
+}
+
+// Who has joined a session and whether its game has started
+type Lobby struct {
+	// In the order they joined
+	Players []LobbyPlayer
+	Started bool
+}
+
+// Returns the session's host: the first player who joined to play. Spectators are never
+// the host.
+func (l Lobby) Host() (LobbyPlayer, bool) {
+	for _, player := range l.Players {
+		if player.Role != PlayerRoleSpectator {
+			return player, true
+		}
+	}
+	return LobbyPlayer{}, false
+}
+
+// Returns whether anyone playing has joined and every player who has is ready. Spectators
+// aren't waited for.
+func (l Lobby) AllReady() bool {
+	playing := 0
+	for _, player := range l.Players {
+		if player.Role == PlayerRoleSpectator {
+			continue
+		}
+		if !player.Ready {
+			return false
+		}
+		playing++
+	}
+	return playing > 0
+}
+
+func ValidateLobbyPlayer(playerID string, role PlayerRole) ValidationErrors {
+	var errs ValidationErrors
+	if strings.TrimSpace(playerID) == "" {
+		errs = append(errs, ValidationError{Field: "player_id", Message: "player ID is required to join a session"})
+	}
+	if role != PlayerRoleDefuser && role != PlayerRoleExpert && role != PlayerRoleSpectator {
+		errs = append(errs, ValidationError{Field: "role", Message: "role must be defuser, expert or spectator"})
+	}
+	return errs
+}
@@ -0,0 +1,78 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Most teams a single match can race
+const MaxMatchTeams = 8
+
+var (
+	ErrMatchNotFound   = errors.New("match not found")
+	ErrStartedByMatch  = errors.New("game is started by its match")
+	ErrMatchChallenges = errors.New("challenges can't be played as a match")
+	ErrTeamNotInMatch  = errors.New("team isn't in the match")
+	ErrTeamClaimed     = errors.New("team has already been joined")
+)
+
+// A team in a versus match and the session it plays in
+type MatchTeam struct {
+	Name      string
+	SessionID uuid.UUID
+}
+
+// How far a team has got through its bombs
+type TeamProgress struct {
+	Team      string
+	SessionID uuid.UUID
+	State     SessionState
+	// Across every bomb in the team's session
+	ModulesSolved int
+	ModulesTotal  int
+	Strikes       int
+	// When the team defused its last bomb or one of its bombs exploded
+	FinishedAt *time.Time
+}
+
+func (p TeamProgress) IsFinished() bool {
+	return p.State == SessionStateCompleted || p.State == SessionStateFailed
+}
+
+// Every team's progress in a match, shared with all of them
+type Scoreboard struct {
+	MatchID uuid.UUID
+	// In the order the teams were given when the match was created
+	Teams []TeamProgress
+	// Nil while the teams are waiting in their lobbies
+	StartedAt *time.Time
+	// The team that defused its bombs first. Empty until then, or if every team's bombs
+	// exploded.
+	Winner   string
+	Finished bool
+}
+
+func ValidateMatchTeams(teams []string) ValidationErrors {
+	var errs ValidationErrors
+	if len(teams) < 2 || len(teams) > MaxMatchTeams {
+		errs = append(errs, ValidationError{Field: "teams", Message: fmt.Sprintf("a match needs between 2 and %d teams", MaxMatchTeams)})
+	}
+
+	seen := make(map[string]bool, len(teams))
+	for _, team := range teams {
+		name := strings.TrimSpace(team)
+		if name == "" {
+			errs = append(errs, ValidationError{Field: "teams", Message: "team names can't be empty"})
+			continue
+		}
+		if seen[name] {
+			errs = append(errs, ValidationError{Field: "teams", Message: fmt.Sprintf("team %q is given more than once", name)})
+		}
+		seen[name] = true
+	}
+	return errs
+}
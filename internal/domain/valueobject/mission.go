@@ -69,6 +69,10 @@ type MissionDefinition struct {
 	Rows       int
 	Columns    int
 	Section    int
+	// Number of bombs in the mission, each built from the same spec. Defaults to 1.
+	NumBombs int
+	// How the bombs are played when there is more than one
+	BombMode BombMode
 }
 
 // Section-based module pools - modules available at each section for random selection
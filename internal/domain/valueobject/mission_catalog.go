@@ -0,0 +1,156 @@
+package valueobject
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+var ErrUnknownMission = errors.New("unknown mission")
+
+// A mission that can be selected when creating a game. Every mission has a string ID that
+// Get resolves; built-in missions can also be selected by their Mission enum.
+type CatalogMission struct {
+	// "<pack>/<mission>" for mission pack missions, "builtin/<mission>" for built-in missions
+	ID string
+	// Mission pack the mission was loaded from, empty for built-in missions
+	PackID string
+	// Set for built-in missions only
+	Mission    Mission
+	Definition MissionDefinition
+}
+
+func (m CatalogMission) IsBuiltIn() bool {
+	return m.Mission != MissionUnspecified
+}
+
+// Holds the missions loaded from mission packs alongside the built-in missions.
+type MissionCatalog struct {
+	mu     sync.RWMutex
+	custom map[string]CatalogMission
+}
+
+func NewMissionCatalog() *MissionCatalog {
+	return &MissionCatalog{
+		custom: make(map[string]CatalogMission),
+	}
+}
+
+// Pack ID built-in missions are listed under. Mission packs can't use it.
+const BuiltInMissionPackID = "builtin"
+
+// Returns the ID a mission pack mission is selected by.
+func MissionPackMissionID(packID string, missionID string) string {
+	return packID + "/" + missionID
+}
+
+// Returns the ID a built-in mission is selected by: its name in lower case with words
+// joined by dashes, such as "builtin/pick-up-the-pace-ii".
+func BuiltInMissionID(mission Mission) string {
+	var slug strings.Builder
+	for _, word := range strings.FieldsFunc(MissionDefinitions[mission].Name, isMissionNameSeparator) {
+		if slug.Len() > 0 {
+			slug.WriteByte('-')
+		}
+		slug.WriteString(strings.ToLower(strings.ReplaceAll(word, "'", "")))
+	}
+	return MissionPackMissionID(BuiltInMissionPackID, slug.String())
+}
+
+// Apostrophes are dropped rather than splitting words, so "Who's" becomes "whos"
+func isMissionNameSeparator(r rune) bool {
+	return r != '\'' && !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+func builtInCatalogMission(mission Mission) CatalogMission {
+	return CatalogMission{
+		ID:         BuiltInMissionID(mission),
+		Mission:    mission,
+		Definition: MissionDefinitions[mission],
+	}
+}
+
+// Validates a mission pack mission and makes it available by ID. IDs must be unique across
+// all loaded packs.
+func (c *MissionCatalog) Register(packID string, missionID string, def MissionDefinition) error {
+	if strings.TrimSpace(packID) == "" || strings.TrimSpace(missionID) == "" {
+		return errors.New("mission pack and mission IDs must not be empty")
+	}
+	if packID == BuiltInMissionPackID {
+		return fmt.Errorf("mission pack ID %q is reserved for built-in missions", packID)
+	}
+
+	if errs := ValidateMissionDefinition(def); errs.HasErrors() {
+		return errs
+	}
+
+	id := MissionPackMissionID(packID, missionID)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.custom[id]; exists {
+		return fmt.Errorf("mission %q is already registered", id)
+	}
+
+	c.custom[id] = CatalogMission{
+		ID:         id,
+		PackID:     packID,
+		Definition: def,
+	}
+
+	return nil
+}
+
+// Looks up a mission by its ID, as listed by List.
+func (c *MissionCatalog) Get(id string) (CatalogMission, error) {
+	for mission := range MissionDefinitions {
+		if BuiltInMissionID(mission) == id {
+			return builtInCatalogMission(mission), nil
+		}
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	mission, exists := c.custom[id]
+	if !exists {
+		return CatalogMission{}, fmt.Errorf("%w: %q", ErrUnknownMission, id)
+	}
+
+	return mission, nil
+}
+
+// Returns every mission: built-in missions in game order, then mission pack missions
+// sorted by ID.
+func (c *MissionCatalog) List() []CatalogMission {
+	missions := make([]CatalogMission, 0, len(MissionDefinitions))
+
+	builtIn := make([]Mission, 0, len(MissionDefinitions))
+	for mission := range MissionDefinitions {
+		builtIn = append(builtIn, mission)
+	}
+	sort.Slice(builtIn, func(i, j int) bool { return builtIn[i] < builtIn[j] })
+
+	for _, mission := range builtIn {
+		missions = append(missions, builtInCatalogMission(mission))
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	ids := make([]string, 0, len(c.custom))
+	for id := range c.custom {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		missions = append(missions, c.custom[id])
+	}
+
+	return missions
+}
@@ -0,0 +1,85 @@
+package valueobject
+
+import (
+	"fmt"
+	"time"
+)
+
+// Optional rule that changes how a game plays. Any number can be combined, except where
+// ValidateModifiers says otherwise, and every bomb in the session plays with all of them.
+type Modifier int
+
+const (
+	// Players aren't told how many strikes the bomb has
+	ModifierHiddenStrikes Modifier = iota + 1
+	// Players aren't told how much time is left
+	ModifierHiddenTimer
+	// The serial number, indicators, batteries and ports stay hidden until a player asks
+	// to see them
+	ModifierEdgeworkOnRequest
+	// Each module can only give the bomb one strike. Later mistakes on the same module are
+	// still reported, but the bomb doesn't count them.
+	ModifierOneStrikePerModule
+	// The timer counts up instead of down and the bomb never explodes
+	ModifierZen
+	// Solving a module adds time to the clock and each strike takes time away. Strikes
+	// never explode the bomb; only running out of time does.
+	ModifierTimeMode
+)
+
+// Time mode's changes to the clock
+const (
+	TimeModeSolveBonus    = 30 * time.Second
+	TimeModeStrikePenalty = 60 * time.Second
+)
+
+func (m Modifier) String() string {
+	switch m {
+	case ModifierHiddenStrikes:
+		return "hidden strikes"
+	case ModifierHiddenTimer:
+		return "hidden timer"
+	case ModifierEdgeworkOnRequest:
+		return "edgework on request"
+	case ModifierOneStrikePerModule:
+		return "one strike per module"
+	case ModifierZen:
+		return "zen"
+	case ModifierTimeMode:
+		return "time mode"
+	default:
+		return fmt.Sprintf("modifier %d", int(m))
+	}
+}
+
+func (m Modifier) IsValid() bool {
+	return m >= ModifierHiddenStrikes && m <= ModifierTimeMode
+}
+
+// Modifiers that can't be played together: each changes what makes the bomb explode
+var conflictingModifiers = [][2]Modifier{
+	{ModifierZen, ModifierTimeMode},
+}
+
+func ValidateModifiers(modifiers []Modifier) ValidationErrors {
+	var errs ValidationErrors
+
+	seen := make(map[Modifier]bool, len(modifiers))
+	for _, modifier := range modifiers {
+		if !modifier.IsValid() {
+			errs = append(errs, ValidationError{Field: "modifiers", Message: fmt.Sprintf("unknown modifier %d", int(modifier))})
+			continue
+		}
+		if seen[modifier] {
+			errs = append(errs, ValidationError{Field: "modifiers", Message: fmt.Sprintf("%s is given more than once", modifier)})
+		}
+		seen[modifier] = true
+	}
+
+	for _, pair := range conflictingModifiers {
+		if seen[pair[0]] && seen[pair[1]] {
+			errs = append(errs, ValidationError{Field: "modifiers", Message: fmt.Sprintf("%s can't be combined with %s", pair[0], pair[1])})
+		}
+	}
+	return errs
+}
@@ -1,5 +1,10 @@
 package valueobject
 
+import (
+	"fmt"
+	"strings"
+)
+
 type ModuleType int32
 
 const (
@@ -18,3 +23,63 @@ const (
 	ClockModule
 	WiresModule
 )
+
+// Needy modules can't be solved and don't need to be for the bomb to be defused
+func (t ModuleType) IsNeedy() bool {
+	return t == NeedyVentGasModule || t == NeedyKnobModule
+}
+
+var moduleTypeNames = map[ModuleType]string{
+	ComplicatedWiresModule: "complicated_wires",
+	KeypadModule:           "keypad",
+	NeedyKnobModule:        "needy_knob",
+	MazeModule:             "maze",
+	MemoryModule:           "memory",
+	MorseModule:            "morse",
+	PasswordModule:         "password",
+	SimonModule:            "simon",
+	BigButtonModule:        "big_button",
+	NeedyVentGasModule:     "needy_vent_gas",
+	WhosOnFirstModule:      "whos_on_first",
+	WireSequenceModule:     "wire_sequence",
+	ClockModule:            "clock",
+	WiresModule:            "wires",
+	RandomModule:           "random",
+}
+
+func (t ModuleType) String() string {
+	if name, ok := moduleTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", int32(t))
+}
+
+// Parses a module type from its snake_case name, e.g. "big_button" or "random".
+func ParseModuleType(name string) (ModuleType, error) {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	for moduleType, moduleName := range moduleTypeNames {
+		if moduleName == normalized {
+			return moduleType, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown module type %q", name)
+}
+
+// Encodes the module type by name, so stored and shared data doesn't depend on the order
+// of the constants above.
+func (t ModuleType) MarshalText() ([]byte, error) {
+	name, ok := moduleTypeNames[t]
+	if !ok {
+		return nil, fmt.Errorf("unknown module type %d", int32(t))
+	}
+	return []byte(name), nil
+}
+
+func (t *ModuleType) UnmarshalText(text []byte) error {
+	moduleType, err := ParseModuleType(string(text))
+	if err != nil {
+		return err
+	}
+	*t = moduleType
+	return nil
+}
@@ -3,11 +3,12 @@ package valueobject
 type Port string
 
 const (
-	PortDVID   Port = "DVI-D"
-	PortRCA    Port = "Stereo RCA"
-	PortPS2    Port = "PS/2"
-	PortRJ45   Port = "RJ-45"
-	PortSerial Port = "Serial"
+	PortDVID     Port = "DVI-D"
+	PortRCA      Port = "Stereo RCA"
+	PortPS2      Port = "PS/2"
+	PortRJ45     Port = "RJ-45"
+	PortSerial   Port = "Serial"
+	PortParallel Port = "Parallel"
 )
 
 var AVAILABLE_PORTS = [...]Port{
@@ -16,4 +17,22 @@ var AVAILABLE_PORTS = [...]Port{
 	PortPS2,
 	PortRJ45,
 	PortSerial,
+	PortParallel,
+}
+
+// A plate holds each of its ports at most once and can be empty. Ports only share a plate
+// with ports from the same layout.
+type PortPlate struct {
+	Ports []Port
+}
+
+func (p PortPlate) IsEmpty() bool {
+	return len(p.Ports) == 0
+}
+
+// The two plate layouts found on bombs: one for DVI-D, PS/2, RJ-45 and Stereo RCA, and one
+// for Parallel and Serial.
+var PORT_PLATE_LAYOUTS = [...][]Port{
+	{PortDVID, PortPS2, PortRJ45, PortRCA},
+	{PortParallel, PortSerial},
 }
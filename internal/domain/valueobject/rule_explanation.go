@@ -0,0 +1,10 @@
+package valueobject
+
+// The manual rule that decided the outcome of a module input. Practice mode returns it with
+// every strike so players can see what they should have done.
+type RuleExplanation struct {
+	// Stable, machine-readable rule identifier (e.g. "wires.4.red_serial_odd")
+	RuleID string
+	// Short human-readable summary (e.g. "4 wires, >1 red, serial odd → cut last red")
+	Description string
+}
@@ -0,0 +1,57 @@
+package valueobject
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Longest a session can hold back what its spectators see
+const MaxSpectatorDelay = 10 * time.Minute
+
+type SessionEventType int
+
+const (
+	// First event a spectator receives, carrying the session as it was when they started
+	// watching
+	SessionEventWatching SessionEventType = iota + 1
+	SessionEventPlayerJoined
+	SessionEventPlayerReady
+	SessionEventGameStarted
+	SessionEventGamePaused
+	SessionEventGameResumed
+	// A module took an input. Turned away input isn't reported.
+	SessionEventModuleInput
+	// A defuser was handed bomb faces
+	SessionEventFacesAssigned
+	// Edgework hidden by ModifierEdgeworkOnRequest was shown to the players
+	SessionEventEdgeworkRevealed
+)
+
+// Something that happened in a session, in the order the session handled it
+type SessionEvent struct {
+	// Counts up from 1 for every event the session publishes. A spectator who falls behind
+	// misses events, which shows up as a gap.
+	Sequence uint64
+	Type     SessionEventType
+	At       time.Time
+
+	// Set for lobby events, and for module input sent by a defuser
+	Player LobbyPlayer
+
+	// Set for module input, and for the bomb whose edgework was revealed
+	BombID   uuid.UUID
+	ModuleID uuid.UUID
+	Strike   bool
+	Solved   bool
+	// The manual rule that decided the input, as the expert would read it
+	Rule *RuleExplanation
+}
+
+func ValidateSpectatorDelay(delay time.Duration) *ValidationError {
+	if delay < 0 || delay > MaxSpectatorDelay {
+		return &ValidationError{Field: "spectator_delay_seconds", Message: fmt.Sprintf("spectator delay must be between 0 and %d seconds", int(MaxSpectatorDelay.Seconds()))}
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package atomicfile
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// Writes data to a temporary file next to path and renames it over path, so a crash never
+// leaves a half-written file behind.
+func WriteFile(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
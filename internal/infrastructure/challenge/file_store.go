@@ -0,0 +1,114 @@
+package challenge
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/atomicfile"
+)
+
+// Tracks challenge attempts in memory and writes every claim to a JSON file, so a player
+// can't get another attempt by waiting for a restart.
+type FileAttemptStore struct {
+	mu   sync.Mutex
+	path string
+	// Players that attempted each challenge, by challenge ID
+	attempts map[string]map[string]bool
+}
+
+// Opens the attempts file at path, creating it on the first claim if it doesn't exist.
+func NewFileAttemptStore(path string) (*FileAttemptStore, error) {
+	store := &FileAttemptStore{
+		path:     path,
+		attempts: make(map[string]map[string]bool),
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading challenge attempts file: %w", err)
+	}
+
+	var saved map[string][]string
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, fmt.Errorf("parsing challenge attempts file %s: %w", path, err)
+	}
+
+	for challengeID, playerIDs := range saved {
+		players := make(map[string]bool, len(playerIDs))
+		for _, playerID := range playerIDs {
+			players[playerID] = true
+		}
+		store.attempts[challengeID] = players
+	}
+
+	return store, nil
+}
+
+func (s *FileAttemptStore) Claim(challengeID string, playerID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	players, exists := s.attempts[challengeID]
+	if !exists {
+		players = make(map[string]bool)
+		s.attempts[challengeID] = players
+	}
+
+	if players[playerID] {
+		return valueobject.ErrChallengeAlreadyAttempted
+	}
+
+	// Only kept once it's on disk, so a claim the file doesn't have can't block a player
+	players[playerID] = true
+	if err := s.save(); err != nil {
+		delete(players, playerID)
+		return err
+	}
+	return nil
+}
+
+func (s *FileAttemptStore) Release(challengeID string, playerID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.attempts[challengeID][playerID] {
+		return
+	}
+
+	delete(s.attempts[challengeID], playerID)
+	if err := s.save(); err != nil {
+		// Kept so memory matches the file. The player loses the attempt they couldn't start.
+		s.attempts[challengeID][playerID] = true
+		log.Printf("releasing challenge attempt: %v", err)
+	}
+}
+
+// Writes every attempt to the file in one go. Must be called with the lock held.
+func (s *FileAttemptStore) save() error {
+	saved := make(map[string][]string, len(s.attempts))
+	for challengeID, players := range s.attempts {
+		for playerID := range players {
+			saved[challengeID] = append(saved[challengeID], playerID)
+		}
+		slices.Sort(saved[challengeID])
+	}
+
+	data, err := json.MarshalIndent(saved, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding challenge attempts: %w", err)
+	}
+
+	if err := atomicfile.WriteFile(s.path, data); err != nil {
+		return fmt.Errorf("writing challenge attempts file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package challenge_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/challenge"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileAttemptStore_AttemptsSurviveRestarts(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "challenge_attempts.json")
+	store, err := challenge.NewFileAttemptStore(path)
+	assert.NoError(t, err)
+
+	// Act
+	assert.NoError(t, store.Claim("daily:2024-03-14", "alice"))
+	assert.NoError(t, store.Claim("daily:2024-03-14", "bob"))
+	store.Release("daily:2024-03-14", "bob")
+	reopened, err := challenge.NewFileAttemptStore(path)
+	assert.NoError(t, err)
+
+	// Assert
+	assert.ErrorIs(t, reopened.Claim("daily:2024-03-14", "alice"), valueobject.ErrChallengeAlreadyAttempted)
+	assert.NoError(t, reopened.Claim("daily:2024-03-14", "bob"), "Released attempts shouldn't be kept")
+	assert.NoError(t, reopened.Claim("daily:2024-03-15", "alice"))
+}
@@ -0,0 +1,45 @@
+package challenge
+
+import (
+	"sync"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+)
+
+// Tracks challenge attempts in memory. Attempts are forgotten when the server stops.
+type MemoryAttemptStore struct {
+	mu sync.Mutex
+	// Players that attempted each challenge, by challenge ID
+	attempts map[string]map[string]bool
+}
+
+func NewMemoryAttemptStore() *MemoryAttemptStore {
+	return &MemoryAttemptStore{
+		attempts: make(map[string]map[string]bool),
+	}
+}
+
+func (s *MemoryAttemptStore) Claim(challengeID string, playerID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	players, exists := s.attempts[challengeID]
+	if !exists {
+		players = make(map[string]bool)
+		s.attempts[challengeID] = players
+	}
+
+	if players[playerID] {
+		return valueobject.ErrChallengeAlreadyAttempted
+	}
+
+	players[playerID] = true
+	return nil
+}
+
+func (s *MemoryAttemptStore) Release(challengeID string, playerID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.attempts[challengeID], playerID)
+}
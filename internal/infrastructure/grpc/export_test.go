@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	pb "github.com/ZaneH/defuse.party-go/pkg/proto"
+)
+
+// Reports which part of a module type's gRPC mapping is missing, or why its state can't
+// be sent to clients.
+func CheckModuleMapping(moduleType valueobject.ModuleType, module entities.ModuleSnapshot) error {
+	mapping, ok := moduleMappings[moduleType]
+	switch {
+	case !ok:
+		return fmt.Errorf("no gRPC mapping")
+	case mapping.protoType == pb.Module_UNKNOWN:
+		return fmt.Errorf("no proto type")
+	case mapping.mapInput == nil:
+		return fmt.Errorf("no input mapper")
+	case mapping.projectState == nil:
+		return fmt.Errorf("no state projector")
+	case mapping.mapResult == nil:
+		return fmt.Errorf("no result mapper")
+	}
+
+	return mapping.projectState(module, &pb.Module{})
+}
+
+// Reports what's missing from a modifier's gRPC mapping, or that it doesn't survive being
+// sent to the server and back.
+func CheckModifierMapping(modifier valueobject.Modifier) error {
+	mapping, ok := modifierMappings[modifier]
+	switch {
+	case !ok:
+		return fmt.Errorf("no gRPC mapping")
+	case mapping.protoModifier == pb.Modifier_MODIFIER_UNSPECIFIED:
+		return fmt.Errorf("no proto modifier")
+	case mapping.project == nil:
+		return fmt.Errorf("no projection")
+	case protoModifierToDomain(mapping.protoModifier) != modifier:
+		return fmt.Errorf("proto modifier maps back to %v", protoModifierToDomain(mapping.protoModifier))
+	}
+	return nil
+}
+
+var ProjectBomb = projectBomb
+
+var MapSessionSnapshotToProto = mapSessionSnapshotToProto
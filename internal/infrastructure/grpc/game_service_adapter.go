@@ -5,24 +5,31 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net"
+	"strings"
 	"time"
 
+	"github.com/ZaneH/defuse.party-go/internal/actors"
 	"github.com/ZaneH/defuse.party-go/internal/application/command"
 	"github.com/ZaneH/defuse.party-go/internal/application/services"
 	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
 	pb "github.com/ZaneH/defuse.party-go/pkg/proto"
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
 type GameServiceAdapter struct {
 	pb.UnimplementedGameServiceServer
-	gameService *services.GameService
+	gameService      *services.GameService
+	challengeService *services.ChallengeService
+	matchService     *services.MatchService
 }
 
-func NewGameServiceAdapter(gameService *services.GameService) *GameServiceAdapter {
-	return &GameServiceAdapter{gameService: gameService}
+func NewGameServiceAdapter(gameService *services.GameService, challengeService *services.ChallengeService, matchService *services.MatchService) *GameServiceAdapter {
+	return &GameServiceAdapter{gameService: gameService, challengeService: challengeService, matchService: matchService}
 }
 
 func (s *GameServiceAdapter) CreateGame(ctx context.Context, req *pb.CreateGameRequest) (*pb.CreateGameResponse, error) {
@@ -31,19 +38,29 @@ func (s *GameServiceAdapter) CreateGame(ctx context.Context, req *pb.CreateGameR
 		return nil, status.Errorf(codes.InvalidArgument, "invalid config: %v", err)
 	}
 
-	session, _, err := s.gameService.CreateGameSession(cmd)
+	var session *actors.GameSessionActor
+	var result *command.CreateGameCommandResult
+	if cmd.ConfigType == command.ConfigTypeChallenge {
+		session, result, err = s.challengeService.StartChallenge(cmd)
+	} else {
+		session, result, err = s.gameService.CreateGameSession(cmd)
+	}
 	if err != nil {
 		var validationErrs valueobject.ValidationErrors
-		if errors.As(err, &validationErrs) {
+		if errors.As(err, &validationErrs) || errors.Is(err, valueobject.ErrUnknownMission) || errors.Is(err, valueobject.ErrInvalidBombCode) {
 			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 		}
+		if errors.Is(err, valueobject.ErrChallengeAlreadyAttempted) {
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		}
 		return nil, fmt.Errorf("failed to create game: %v", err)
 	}
 
 	log.Printf("Created game session with ID: %s\n", session.GetSessionID())
 
 	return &pb.CreateGameResponse{
-		SessionId: session.GetSessionID().String(),
+		SessionId:  session.GetSessionID().String(),
+		ConfigInfo: mapCreateGameResultToConfigInfo(result),
 	}, nil
 }
 
@@ -57,6 +74,10 @@ func (s *GameServiceAdapter) protoToCreateGameCommand(req *pb.CreateGameRequest)
 	}
 
 	cmd.Seed = cfg.GetSeed()
+	cmd.GeneratorVersion = valueobject.GeneratorVersion(cfg.GetGeneratorVersion())
+	cmd.DisablePause = cfg.GetDisablePause()
+	cmd.SpectatorDelay = time.Duration(cfg.GetSpectatorDelaySeconds()) * time.Second
+	cmd.Modifiers = mapProtoToModifiers(cfg.GetModifiers())
 
 	switch c := cfg.GetConfigType().(type) {
 	case *pb.GameConfig_Level:
@@ -66,6 +87,9 @@ func (s *GameServiceAdapter) protoToCreateGameCommand(req *pb.CreateGameRequest)
 	case *pb.GameConfig_Preset:
 		cmd.ConfigType = command.ConfigTypeMission
 		cmd.Mission = protoMissionToDomain(c.Preset.GetMission())
+		cmd.MissionID = c.Preset.GetMissionId()
+		cmd.NumBombs = int(c.Preset.GetNumBombs())
+		cmd.BombMode = mapProtoToBombMode(c.Preset.GetBombMode())
 
 	case *pb.GameConfig_Custom:
 		cmd.ConfigType = command.ConfigTypeCustom
@@ -74,6 +98,22 @@ func (s *GameServiceAdapter) protoToCreateGameCommand(req *pb.CreateGameRequest)
 			return nil, err
 		}
 		cmd.CustomConfig = &bombConfig
+		cmd.NumBombs = int(c.Custom.GetNumBombs())
+		cmd.BombMode = mapProtoToBombMode(c.Custom.GetBombMode())
+
+	case *pb.GameConfig_Practice:
+		cmd.ConfigType = command.ConfigTypePractice
+		cmd.PracticeModule = protoPracticeModuleTypeToDomain(c.Practice.GetModuleType())
+
+	case *pb.GameConfig_BombCodes:
+		cmd.ConfigType = command.ConfigTypeBombCode
+		cmd.BombCodes = c.BombCodes.GetCodes()
+		cmd.BombMode = mapProtoToBombMode(c.BombCodes.GetBombMode())
+
+	case *pb.GameConfig_Challenge:
+		cmd.ConfigType = command.ConfigTypeChallenge
+		cmd.ChallengePeriod = mapProtoToChallengePeriod(c.Challenge.GetPeriod())
+		cmd.PlayerID = c.Challenge.GetPlayerId()
 
 	default:
 		cmd.ConfigType = command.ConfigTypeDefault
@@ -82,6 +122,36 @@ func (s *GameServiceAdapter) protoToCreateGameCommand(req *pb.CreateGameRequest)
 	return cmd, nil
 }
 
+func (s *GameServiceAdapter) ListMissions(ctx context.Context, req *pb.ListMissionsRequest) (*pb.ListMissionsResponse, error) {
+	missions := s.gameService.ListMissions()
+
+	resp := &pb.ListMissionsResponse{
+		Missions: make([]*pb.MissionInfo, 0, len(missions)),
+	}
+	for _, mission := range missions {
+		resp.Missions = append(resp.Missions, mapCatalogMissionToProto(mission))
+	}
+
+	return resp, nil
+}
+
+func (s *GameServiceAdapter) DescribeConfig(ctx context.Context, req *pb.DescribeConfigRequest) (*pb.DescribeConfigResponse, error) {
+	cmd, err := s.protoToCreateGameCommand(&pb.CreateGameRequest{Config: req.GetConfig()})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid config: %v", err)
+	}
+
+	result, err := s.gameService.DescribeConfig(cmd)
+	if err != nil {
+		if errors.Is(err, valueobject.ErrChallengeNotDescribable) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	return mapDescribeConfigResultToProto(result), nil
+}
+
 func protoMissionToDomain(m pb.Mission) valueobject.Mission {
 	switch m {
 	case pb.Mission_THE_FIRST_BOMB:
@@ -166,6 +236,14 @@ func protoCustomToBombConfig(custom *pb.CustomBombConfig) (valueobject.BombConfi
 		MaxBatteries:      int(custom.GetMaxBatteries()),
 		MaxIndicatorCount: int(custom.GetMaxIndicatorCount()),
 		PortCount:         int(custom.GetPortCount()),
+		StrikeTimerRates:  valueobject.DefaultStrikeTimerRates,
+	}
+
+	if len(custom.GetStrikeTimerRates()) > 0 {
+		config.StrikeTimerRates = make([]float64, len(custom.GetStrikeTimerRates()))
+		for i, rate := range custom.GetStrikeTimerRates() {
+			config.StrikeTimerRates[i] = float64(rate)
+		}
 	}
 
 	// Handle explicit module list
@@ -176,7 +254,9 @@ func protoCustomToBombConfig(custom *pb.CustomBombConfig) (valueobject.BombConfi
 				Count: int(spec.GetCount()),
 			}
 
-			if len(spec.GetPossibleTypes()) > 0 {
+			if spec.GetRandom() {
+				moduleSpec.Type = valueobject.RandomModule
+			} else if len(spec.GetPossibleTypes()) > 0 {
 				moduleSpec.PossibleTypes = make([]valueobject.ModuleType, len(spec.GetPossibleTypes()))
 				for j, mt := range spec.GetPossibleTypes() {
 					moduleSpec.PossibleTypes[j] = protoModuleTypeToDomain(mt)
@@ -194,64 +274,25 @@ func protoCustomToBombConfig(custom *pb.CustomBombConfig) (valueobject.BombConfi
 	return config, nil
 }
 
+// Falls back to wires for types that can't be asked for, including the clock.
 func protoModuleTypeToDomain(mt pb.Module_ModuleType) valueobject.ModuleType {
-	switch mt {
-	case pb.Module_WIRES:
-		return valueobject.WiresModule
-	case pb.Module_PASSWORD:
-		return valueobject.PasswordModule
-	case pb.Module_BIG_BUTTON:
-		return valueobject.BigButtonModule
-	case pb.Module_SIMON:
-		return valueobject.SimonModule
-	case pb.Module_KEYPAD:
-		return valueobject.KeypadModule
-	case pb.Module_WHOS_ON_FIRST:
-		return valueobject.WhosOnFirstModule
-	case pb.Module_MEMORY:
-		return valueobject.MemoryModule
-	case pb.Module_MORSE:
-		return valueobject.MorseModule
-	case pb.Module_NEEDY_VENT_GAS:
-		return valueobject.NeedyVentGasModule
-	case pb.Module_NEEDY_KNOB:
-		return valueobject.NeedyKnobModule
-	case pb.Module_MAZE:
-		return valueobject.MazeModule
-	default:
-		return valueobject.WiresModule // fallback
-	}
-}
-
-func domainModuleTypeToProto(mt valueobject.ModuleType) pb.Module_ModuleType {
-	switch mt {
-	case valueobject.ClockModule:
-		return pb.Module_CLOCK
-	case valueobject.WiresModule:
-		return pb.Module_WIRES
-	case valueobject.PasswordModule:
-		return pb.Module_PASSWORD
-	case valueobject.BigButtonModule:
-		return pb.Module_BIG_BUTTON
-	case valueobject.SimonModule:
-		return pb.Module_SIMON
-	case valueobject.KeypadModule:
-		return pb.Module_KEYPAD
-	case valueobject.WhosOnFirstModule:
-		return pb.Module_WHOS_ON_FIRST
-	case valueobject.MemoryModule:
-		return pb.Module_MEMORY
-	case valueobject.MorseModule:
-		return pb.Module_MORSE
-	case valueobject.NeedyVentGasModule:
-		return pb.Module_NEEDY_VENT_GAS
-	case valueobject.NeedyKnobModule:
-		return pb.Module_NEEDY_KNOB
-	case valueobject.MazeModule:
-		return pb.Module_MAZE
-	default:
-		return pb.Module_UNKNOWN
+	for moduleType, mapping := range moduleMappings {
+		if mapping.protoType == mt && moduleType != valueobject.ClockModule {
+			return moduleType
+		}
+	}
+	return valueobject.WiresModule // fallback
+}
+
+// Unlike protoModuleTypeToDomain, doesn't fall back to wires. Types without a mapping,
+// including UNKNOWN, come back as RandomModule so that validation rejects them.
+func protoPracticeModuleTypeToDomain(mt pb.Module_ModuleType) valueobject.ModuleType {
+	for moduleType, mapping := range moduleMappings {
+		if mapping.protoType == mt {
+			return moduleType
+		}
 	}
+	return valueobject.RandomModule
 }
 
 func (s *GameServiceAdapter) SendInput(ctx context.Context, i *pb.PlayerInput) (*pb.PlayerInputResult, error) {
@@ -268,363 +309,476 @@ func (s *GameServiceAdapter) SendInput(ctx context.Context, i *pb.PlayerInput) (
 		return nil, fmt.Errorf("invalid module ID: %s", i.GetModuleId())
 	}
 
-	var cmd command.ModuleInputCommand
-
-	switch input := i.GetInput().(type) {
-	case *pb.PlayerInput_WiresInput:
-		cmd = &command.WiresInputCommand{
-			BaseModuleInputCommand: command.BaseModuleInputCommand{
-				SessionID: sessionID,
-				BombID:    bombID,
-				ModuleID:  moduleID,
-			},
-			WirePosition: int(input.WiresInput.WirePosition),
-		}
-	case *pb.PlayerInput_PasswordInput:
-		switch pi := input.PasswordInput.Input.(type) {
-		case *pb.PasswordInput_LetterChange:
-			cmd = &command.PasswordLetterChangeCommand{
-				BaseModuleInputCommand: command.BaseModuleInputCommand{
-					SessionID: sessionID,
-					BombID:    bombID,
-					ModuleID:  moduleID,
-				},
-				LetterIndex: int(pi.LetterChange.LetterIndex),
-				Direction:   valueobject.IncrementDecrement(pi.LetterChange.Direction),
-			}
-		case *pb.PasswordInput_Submit:
-			cmd = &command.PasswordSubmitCommand{
-				BaseModuleInputCommand: command.BaseModuleInputCommand{
-					SessionID: sessionID,
-					BombID:    bombID,
-					ModuleID:  moduleID,
-				},
-			}
-		default:
-			return nil, fmt.Errorf("unknown password input type: %T", pi)
-		}
-	case *pb.PlayerInput_BigButtonInput:
-		cmd = &command.BigButtonInputCommand{
-			BaseModuleInputCommand: command.BaseModuleInputCommand{
-				SessionID: sessionID,
-				BombID:    bombID,
-				ModuleID:  moduleID,
-			},
-			PressType:        mapProtoToPressType(input.BigButtonInput.PressType),
-			ReleaseTimestamp: input.BigButtonInput.ReleaseTimestamp,
-		}
-	case *pb.PlayerInput_SimonInput:
-		cmd = &command.SimonInputCommand{
-			BaseModuleInputCommand: command.BaseModuleInputCommand{
-				SessionID: sessionID,
-				BombID:    bombID,
-				ModuleID:  moduleID,
-			},
-			Color: mapProtoToColor(input.SimonInput.Color),
-		}
-	case *pb.PlayerInput_KeypadInput:
-		cmd = &command.KeypadInputCommand{
-			BaseModuleInputCommand: command.BaseModuleInputCommand{
-				SessionID: sessionID,
-				BombID:    bombID,
-				ModuleID:  moduleID,
-			},
-			Symbol: mapProtoToSymbol(input.KeypadInput.Symbol),
-		}
-	case *pb.PlayerInput_WhosOnFirstInput:
-		cmd = &command.WhosOnFirstInputCommand{
-			BaseModuleInputCommand: command.BaseModuleInputCommand{
-				SessionID: sessionID,
-				BombID:    bombID,
-				ModuleID:  moduleID,
-			},
-			Word: input.WhosOnFirstInput.Word,
-		}
-	case *pb.PlayerInput_MemoryInput:
-		cmd = &command.MemoryInputCommand{
-			BaseModuleInputCommand: command.BaseModuleInputCommand{
-				SessionID: sessionID,
-				BombID:    bombID,
-				ModuleID:  moduleID,
-			},
-			ButtonIndex: int(input.MemoryInput.ButtonIndex),
-		}
-	case *pb.PlayerInput_MorseInput:
-		switch mi := input.MorseInput.Input.(type) {
-		case *pb.MorseInput_FrequencyChange:
-			cmd = &command.MorseChangeFrequencyCommand{
-				BaseModuleInputCommand: command.BaseModuleInputCommand{
-					SessionID: sessionID,
-					BombID:    bombID,
-					ModuleID:  moduleID,
-				},
-				Direction: valueobject.IncrementDecrement(mi.FrequencyChange.Direction),
-			}
-		case *pb.MorseInput_Tx:
-			cmd = &command.MorseTxCommand{
-				BaseModuleInputCommand: command.BaseModuleInputCommand{
-					SessionID: sessionID,
-					BombID:    bombID,
-					ModuleID:  moduleID,
-				},
-			}
-		}
-	case *pb.PlayerInput_NeedyVentGasInput:
-		cmd = &command.NeedyVentGasCommand{
-			BaseModuleInputCommand: command.BaseModuleInputCommand{
-				SessionID: sessionID,
-				BombID:    bombID,
-				ModuleID:  moduleID,
-			},
-			Input: input.NeedyVentGasInput.Input,
-		}
-	case *pb.PlayerInput_NeedyKnobInput:
-		cmd = &command.NeedyKnobCommand{
-			BaseModuleInputCommand: command.BaseModuleInputCommand{
-				SessionID: sessionID,
-				BombID:    bombID,
-				ModuleID:  moduleID,
-			},
-		}
-	case *pb.PlayerInput_MazeInput:
-		cmd = &command.MazeCommand{
-			BaseModuleInputCommand: command.BaseModuleInputCommand{
-				SessionID: sessionID,
-				BombID:    bombID,
-				ModuleID:  moduleID,
-			},
-			Direction: valueobject.CardinalDirection(input.MazeInput.Direction),
-		}
-	default:
-		return nil, fmt.Errorf("unknown input type: %T", input)
+	cmd, mapping, err := mapInputToCommand(command.BaseModuleInputCommand{
+		SessionID: sessionID,
+		BombID:    bombID,
+		ModuleID:  moduleID,
+		PlayerID:  i.GetPlayerId(),
+	}, i)
+	if err != nil {
+		return nil, err
 	}
 
 	res, err := s.gameService.ProcessModuleInput(ctx, cmd)
 	if err != nil {
+		switch {
+		case errors.Is(err, valueobject.ErrGameNotStarted), errors.Is(err, valueobject.ErrGamePaused), errors.Is(err, actors.ErrBombPaused):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		case errors.Is(err, valueobject.ErrFaceNotOwned), errors.Is(err, valueobject.ErrNotDefuser), errors.Is(err, valueobject.ErrSpectatorsOnlyWatch), errors.Is(err, valueobject.ErrNotFaceAssigner):
+			return nil, status.Errorf(codes.PermissionDenied, "%v", err)
+		case errors.Is(err, valueobject.ErrPlayerNotInLobby):
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		case errors.Is(err, valueobject.ErrInputConflict):
+			return nil, status.Errorf(codes.Aborted, "%v", err)
+		}
 		return nil, fmt.Errorf("failed to process input: %v", err)
 	}
 
 	fmt.Printf("Processed input for session %s: %v\n", sessionID, res)
 
 	// Get the current bomb state to include in the response
-	session, err := s.gameService.GetGameSession(ctx, sessionID)
+	session, err := s.gameService.GetSessionSnapshot(ctx, sessionID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get game session after input: %v", err)
 	}
 
-	bombActors := session.GetBombActors()
-	bombActor, exists := bombActors[bombID]
+	bomb, exists := session.GetBomb(bombID)
 	if !exists {
 		return nil, fmt.Errorf("bomb not found in session")
 	}
 
-	bomb := bombActor.GetBomb()
-	bombStatus := &pb.BombStatus{
-		StrikeCount: int32(bomb.StrikeCount),
-		MaxStrikes:  int32(bomb.MaxStrikes),
-		Exploded:    bomb.StrikeCount >= bomb.MaxStrikes,
-	}
-
-	switch cmdResult := res.(type) {
-	case *command.WiresInputCommandResult:
-		return &pb.PlayerInputResult{
-			ModuleId:   i.GetModuleId(),
-			Strike:     res != nil && cmdResult.Strike,
-			Solved:     res != nil && cmdResult.Solved,
-			BombStatus: bombStatus,
-		}, nil
-	case *command.BigButtonInputCommandResult:
-		var color pb.Color
-		if cmdResult.StripColor != nil {
-			color = mapColorToProto(*cmdResult.StripColor)
+	bombStatus := mapBombStatusToProto(bomb, session.State)
+
+	if res == nil {
+		return nil, nil
+	}
+
+	result, ok := res.(command.ModuleInputCommandResult)
+	if !ok {
+		return nil, fmt.Errorf("unknown result type: %T", res)
+	}
+
+	resp := &pb.PlayerInputResult{
+		ModuleId:   i.GetModuleId(),
+		Strike:     result.HasStrike(),
+		Solved:     result.IsSolved(),
+		BombStatus: bombStatus,
+		Rule:       mapRuleExplanationToProto(result.GetRule()),
+	}
+	if err := mapping.mapResult(result, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *GameServiceAdapter) GetBombs(ctx context.Context, req *pb.GetBombsRequest) (*pb.GetBombsResponse, error) {
+	sessionID, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session ID: %v", err)
+	}
+	if err := s.gameService.CheckPlaying(ctx, sessionID, req.GetPlayerId()); err != nil {
+		return nil, mapLobbyError("failed to get game session", err)
+	}
+
+	session, err := s.gameService.GetSessionSnapshot(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game session: %v", err)
+	}
+
+	return mapSessionSnapshotToProto(session), nil
+}
+
+func (s *GameServiceAdapter) GetGameReport(ctx context.Context, req *pb.GetGameReportRequest) (*pb.GameReport, error) {
+	sessionID, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid session ID: %v", err)
+	}
+	if err := s.gameService.CheckPlaying(ctx, sessionID, req.GetPlayerId()); err != nil {
+		return nil, mapLobbyError("failed to get game report", err)
+	}
+
+	report, err := s.gameService.GetGameReport(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get game report: %v", err)
+	}
+
+	return mapGameReportToProto(report), nil
+}
+
+func (s *GameServiceAdapter) JoinSession(ctx context.Context, req *pb.JoinSessionRequest) (*pb.LobbyState, error) {
+	sessionID, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session ID: %v", err)
+	}
+
+	lobby, err := s.gameService.JoinLobby(ctx, sessionID, req.GetPlayerId(), mapProtoToPlayerRole(req.GetRole()))
+	if err != nil {
+		return nil, mapLobbyError("failed to join session", err)
+	}
+
+	return mapLobbyToProto(lobby), nil
+}
+
+func (s *GameServiceAdapter) SetReady(ctx context.Context, req *pb.SetReadyRequest) (*pb.LobbyState, error) {
+	sessionID, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session ID: %v", err)
+	}
+
+	lobby, err := s.gameService.SetPlayerReady(ctx, sessionID, req.GetPlayerId(), req.GetReady())
+	if err != nil {
+		return nil, mapLobbyError("failed to set ready", err)
+	}
+
+	return mapLobbyToProto(lobby), nil
+}
+
+func (s *GameServiceAdapter) AssignFaces(ctx context.Context, req *pb.AssignFacesRequest) (*pb.LobbyState, error) {
+	sessionID, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session ID: %v", err)
+	}
+
+	lobby, err := s.gameService.AssignFaces(ctx, sessionID, req.GetCallerId(), req.GetPlayerId(), mapProtoToInts(req.GetFaces()))
+	if err != nil {
+		return nil, mapLobbyError("failed to assign faces", err)
+	}
+
+	return mapLobbyToProto(lobby), nil
+}
+
+func (s *GameServiceAdapter) StartGame(ctx context.Context, req *pb.StartGameRequest) (*pb.LobbyState, error) {
+	sessionID, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session ID: %v", err)
+	}
+
+	lobby, err := s.gameService.StartGame(ctx, sessionID)
+	if err != nil {
+		return nil, mapLobbyError("failed to start game", err)
+	}
+
+	return mapLobbyToProto(lobby), nil
+}
+
+func (s *GameServiceAdapter) PauseGame(ctx context.Context, req *pb.PauseGameRequest) (*pb.GetBombsResponse, error) {
+	sessionID, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session ID: %v", err)
+	}
+
+	if err := s.gameService.CheckPlaying(ctx, sessionID, req.GetPlayerId()); err != nil {
+		return nil, mapLobbyError("failed to check player", err)
+	}
+
+	if err := s.gameService.PauseGame(ctx, sessionID); err != nil {
+		return nil, mapPauseError("failed to pause game", err)
+	}
+
+	return s.GetBombs(ctx, &pb.GetBombsRequest{SessionId: req.GetSessionId(), PlayerId: req.GetPlayerId()})
+}
+
+func (s *GameServiceAdapter) ResumeGame(ctx context.Context, req *pb.ResumeGameRequest) (*pb.GetBombsResponse, error) {
+	sessionID, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session ID: %v", err)
+	}
+
+	if err := s.gameService.CheckPlaying(ctx, sessionID, req.GetPlayerId()); err != nil {
+		return nil, mapLobbyError("failed to check player", err)
+	}
+
+	if err := s.gameService.ResumeGame(ctx, sessionID); err != nil {
+		return nil, mapPauseError("failed to resume game", err)
+	}
+
+	return s.GetBombs(ctx, &pb.GetBombsRequest{SessionId: req.GetSessionId(), PlayerId: req.GetPlayerId()})
+}
+
+func (s *GameServiceAdapter) RevealEdgework(ctx context.Context, req *pb.RevealEdgeworkRequest) (*pb.GetBombsResponse, error) {
+	sessionID, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session ID: %v", err)
+	}
+	bombID, err := uuid.Parse(req.GetBombId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid bomb ID: %v", err)
+	}
+
+	if err := s.gameService.CheckPlaying(ctx, sessionID, req.GetPlayerId()); err != nil {
+		return nil, mapLobbyError("failed to check player", err)
+	}
+
+	if err := s.gameService.RevealEdgework(ctx, sessionID, bombID); err != nil {
+		switch {
+		case errors.Is(err, actors.ErrBombNotFound):
+			return nil, status.Errorf(codes.NotFound, "%v", err)
+		case errors.Is(err, valueobject.ErrGameNotStarted):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, fmt.Errorf("failed to reveal edgework: %v", err)
+	}
+
+	return s.GetBombs(ctx, &pb.GetBombsRequest{SessionId: req.GetSessionId(), PlayerId: req.GetPlayerId()})
+}
+
+func mapPauseError(action string, err error) error {
+	switch {
+	case errors.Is(err, valueobject.ErrPauseDisabled):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	case errors.Is(err, valueobject.ErrGameNotStarted), errors.Is(err, valueobject.ErrGamePaused), errors.Is(err, valueobject.ErrGameNotPaused):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	case errors.Is(err, actors.ErrPauseChanging):
+		return status.Errorf(codes.Aborted, "%v", err)
+	}
+	return fmt.Errorf("%s: %v", action, err)
+}
+
+func (s *GameServiceAdapter) WatchSession(req *pb.WatchSessionRequest, stream pb.GameService_WatchSessionServer) error {
+	sessionID, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid session ID: %v", err)
+	}
+
+	ctx := stream.Context()
+	watch, err := s.gameService.WatchSession(ctx, sessionID, req.GetPlayerId())
+	if err != nil {
+		return mapLobbyError("failed to watch session", err)
+	}
+	defer watch.Close()
+
+	for {
+		select {
+		case update, ok := <-watch.Updates:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(mapSpectatorUpdateToProto(update)); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
 		}
+	}
+}
+
+func mapLobbyError(action string, err error) error {
+	var validationErrs valueobject.ValidationErrors
+	switch {
+	case errors.As(err, &validationErrs):
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	case errors.Is(err, valueobject.ErrPlayerNotInLobby):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, valueobject.ErrNotSpectator), errors.Is(err, valueobject.ErrNotDefuser), errors.Is(err, valueobject.ErrSpectatorsOnlyWatch):
+		return status.Errorf(codes.PermissionDenied, "%v", err)
+	case errors.Is(err, valueobject.ErrGameAlreadyStarted), errors.Is(err, valueobject.ErrStartedByMatch):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	return fmt.Errorf("%s: %v", action, err)
+}
+
+func (s *GameServiceAdapter) CreateMatch(ctx context.Context, req *pb.CreateMatchRequest) (*pb.CreateMatchResponse, error) {
+	gameCmd, err := s.protoToCreateGameCommand(&pb.CreateGameRequest{Config: req.Config})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid config: %v", err)
+	}
 
-		return &pb.PlayerInputResult{
-			ModuleId:   i.GetModuleId(),
-			Strike:     res != nil && cmdResult.Strike,
-			Solved:     res != nil && cmdResult.Solved,
-			BombStatus: bombStatus,
-			Result: &pb.PlayerInputResult_BigButtonInputResult{
-				BigButtonInputResult: &pb.BigButtonInputResult{
-					StripColor: color,
-				},
-			},
-		}, nil
-	case *command.SimonInputCommandResult:
-		sequence := make([]pb.Color, len(cmdResult.DisplaySequence))
-		for i, color := range cmdResult.DisplaySequence {
-			sequence[i] = mapColorToProto(color)
+	result, err := s.matchService.CreateMatch(&command.CreateMatchCommand{Game: *gameCmd, Teams: req.GetTeams()})
+	if err != nil {
+		var validationErrs valueobject.ValidationErrors
+		if errors.As(err, &validationErrs) || errors.Is(err, valueobject.ErrMatchChallenges) || errors.Is(err, valueobject.ErrUnknownMission) || errors.Is(err, valueobject.ErrInvalidBombCode) {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
 		}
+		return nil, fmt.Errorf("failed to create match: %v", err)
+	}
+
+	log.Printf("Created match with ID: %s\n", result.MatchID)
+
+	return mapCreateMatchResultToProto(result), nil
+}
+
+func (s *GameServiceAdapter) StartMatch(ctx context.Context, req *pb.StartMatchRequest) (*pb.Scoreboard, error) {
+	matchID, err := uuid.Parse(req.GetMatchId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid match ID: %v", err)
+	}
+
+	scoreboard, err := s.matchService.StartMatch(ctx, matchID)
+	if err != nil {
+		return nil, mapMatchError("failed to start match", err)
+	}
+
+	return mapScoreboardToProto(scoreboard), nil
+}
+
+func (s *GameServiceAdapter) GetScoreboard(ctx context.Context, req *pb.GetScoreboardRequest) (*pb.Scoreboard, error) {
+	matchID, err := uuid.Parse(req.GetMatchId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid match ID: %v", err)
+	}
+
+	scoreboard, err := s.matchService.GetScoreboard(ctx, matchID)
+	if err != nil {
+		return nil, mapMatchError("failed to get scoreboard", err)
+	}
 
-		return &pb.PlayerInputResult{
-			ModuleId:   i.GetModuleId(),
-			Strike:     res != nil && cmdResult.Strike,
-			Solved:     res != nil && cmdResult.Solved,
-			BombStatus: bombStatus,
-			Result: &pb.PlayerInputResult_SimonInputResult{
-				SimonInputResult: &pb.SimonInputResult{
-					DisplaySequence: sequence,
-					HasFinishedSeq:  cmdResult.HasFinishedSeq,
-				},
-			},
-		}, nil
-	case *command.PasswordCommandResult:
-		return &pb.PlayerInputResult{
-			ModuleId:   i.GetModuleId(),
-			BombStatus: bombStatus,
-			Strike:     res != nil && cmdResult.Strike,
-			Solved:     res != nil && cmdResult.Solved,
-			Result: &pb.PlayerInputResult_PasswordInputResult{
-				PasswordInputResult: &pb.PasswordInputResult{
-					PasswordState: &pb.PasswordState{
-						Letters: cmdResult.Letters,
-					},
-				},
-			},
-		}, nil
-	case *command.KeypadInputCommandResult:
-		activatedSymbols := make([]pb.Symbol, 0, len(cmdResult.ActivatedSymbols))
-		for sym, active := range cmdResult.ActivatedSymbols {
-			if active {
-				activatedSymbols = append(activatedSymbols, mapSymbolToProto(sym))
+	return mapScoreboardToProto(scoreboard), nil
+}
+
+func (s *GameServiceAdapter) JoinMatch(ctx context.Context, req *pb.JoinMatchRequest) (*pb.MatchTeam, error) {
+	matchID, err := uuid.Parse(req.GetMatchId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid match ID: %v", err)
+	}
+
+	team, err := s.matchService.JoinMatch(ctx, matchID, req.GetTeam())
+	if err != nil {
+		return nil, mapMatchError("failed to join match", err)
+	}
+
+	return &pb.MatchTeam{Name: team.Name, SessionId: team.SessionID.String()}, nil
+}
+
+func (s *GameServiceAdapter) WatchMatch(req *pb.WatchMatchRequest, stream pb.GameService_WatchMatchServer) error {
+	matchID, err := uuid.Parse(req.GetMatchId())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid match ID: %v", err)
+	}
+
+	ctx := stream.Context()
+	watch, err := s.matchService.WatchMatch(ctx, matchID)
+	if err != nil {
+		return mapMatchError("failed to watch match", err)
+	}
+	defer watch.Close()
+
+	for {
+		select {
+		case scoreboard, ok := <-watch.Updates:
+			if !ok {
+				return nil
 			}
+			if err := stream.Send(mapScoreboardToProto(scoreboard)); err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
 		}
+	}
+}
+
+func mapMatchError(action string, err error) error {
+	switch {
+	case errors.Is(err, valueobject.ErrMatchNotFound), errors.Is(err, valueobject.ErrTeamNotInMatch):
+		return status.Errorf(codes.NotFound, "%v", err)
+	case errors.Is(err, valueobject.ErrTeamClaimed):
+		return status.Errorf(codes.AlreadyExists, "%v", err)
+	case errors.Is(err, valueobject.ErrGameAlreadyStarted):
+		return status.Errorf(codes.FailedPrecondition, "%v", err)
+	}
+	return fmt.Errorf("%s: %v", action, err)
+}
+
+func (s *GameServiceAdapter) SubmitResult(ctx context.Context, req *pb.SubmitResultRequest) (*pb.SubmitResultResponse, error) {
+	sessionID, err := uuid.Parse(req.GetSessionId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid session ID: %v", err)
+	}
+
+	entry, err := s.gameService.SubmitResult(ctx, sessionID, req.GetTeamName())
+	if err != nil {
+		var validationErrs valueobject.ValidationErrors
+		switch {
+		case errors.As(err, &validationErrs):
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		case errors.Is(err, valueobject.ErrResultAlreadySubmitted):
+			return nil, status.Errorf(codes.AlreadyExists, "%v", err)
+		case errors.Is(err, valueobject.ErrSessionNotCompleted), errors.Is(err, valueobject.ErrSessionNotRanked):
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
+		}
+		return nil, fmt.Errorf("failed to submit result: %v", err)
+	}
 
-		displayedSymbols := make([]pb.Symbol, len(cmdResult.DisplayedSymbols))
-		for i, sym := range cmdResult.DisplayedSymbols {
-			displayedSymbols[i] = mapSymbolToProto(sym)
+	return &pb.SubmitResultResponse{Entry: mapLeaderboardEntryToProto(entry)}, nil
+}
+
+func (s *GameServiceAdapter) GetLeaderboard(ctx context.Context, req *pb.GetLeaderboardRequest) (*pb.GetLeaderboardResponse, error) {
+	key, err := mapProtoToLeaderboardKey(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	entries, err := s.gameService.GetLeaderboard(key, int(max(req.GetLimit(), 0)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get leaderboard: %v", err)
+	}
+
+	resp := &pb.GetLeaderboardResponse{
+		Entries: make([]*pb.LeaderboardEntry, len(entries)),
+	}
+	for i, entry := range entries {
+		resp.Entries[i] = mapLeaderboardEntryToProto(entry)
+		resp.Entries[i].Rank = int32(i + 1)
+	}
+
+	return resp, nil
+}
+
+func (s *GameServiceAdapter) GetDailyChallenge(ctx context.Context, req *pb.GetDailyChallengeRequest) (*pb.Challenge, error) {
+	day := time.Now()
+	if req.GetDate() != "" {
+		parsed, err := time.Parse(time.DateOnly, req.GetDate())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid date: %v", err)
 		}
+		day = parsed
+	}
 
-		return &pb.PlayerInputResult{
-			ModuleId:   i.GetModuleId(),
-			BombStatus: bombStatus,
-			Strike:     res != nil && cmdResult.Strike,
-			Solved:     res != nil && cmdResult.Solved,
-			Result: &pb.PlayerInputResult_KeypadInputResult{
-				KeypadInputResult: &pb.KeypadInputResult{
-					KeypadState: &pb.KeypadState{
-						ActivatedSymbols: activatedSymbols,
-						DisplayedSymbols: displayedSymbols,
-					},
-				},
-			},
-		}, nil
-	case *command.WhosOnFirstInputCommandResult:
-		return &pb.PlayerInputResult{
-			ModuleId:   i.GetModuleId(),
-			BombStatus: bombStatus,
-			Strike:     res != nil && cmdResult.Strike,
-			Solved:     res != nil && cmdResult.Solved,
-			Result: &pb.PlayerInputResult_WhosOnFirstInputResult{
-				WhosOnFirstInputResult: &pb.WhosOnFirstInputResult{
-					WhosOnFirstState: &pb.WhosOnFirstState{
-						ScreenWord:  cmdResult.ScreenWord,
-						ButtonWords: cmdResult.ButtonWords,
-						Stage:       int32(cmdResult.Stage),
-					},
-				},
-			},
-		}, nil
-	case *command.MemoryInputCommandResult:
-		int32Slice := make([]int32, len(cmdResult.DisplayedNumbers))
-		for i, num := range cmdResult.DisplayedNumbers {
-			int32Slice[i] = int32(num)
+	challenge, err := s.challengeService.GetChallenge(mapProtoToChallengePeriod(req.GetPeriod()), day)
+	if err != nil {
+		if errors.Is(err, valueobject.ErrChallengeNotStarted) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%v", err)
 		}
+		return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
 
-		return &pb.PlayerInputResult{
-			ModuleId:   i.GetModuleId(),
-			BombStatus: bombStatus,
-			Strike:     res != nil && cmdResult.Strike,
-			Solved:     res != nil && cmdResult.Solved,
-			Result: &pb.PlayerInputResult_MemoryInputResult{
-				MemoryInputResult: &pb.MemoryInputResult{
-					MemoryState: &pb.MemoryState{
-						ScreenNumber:     int32(cmdResult.ScreenNumber),
-						DisplayedNumbers: int32Slice,
-						Stage:            int32(cmdResult.Stage),
-					},
-				},
-			},
-		}, nil
-	case *command.MorseCommandResult:
-		return &pb.PlayerInputResult{
-			ModuleId:   i.GetModuleId(),
-			BombStatus: bombStatus,
-			Strike:     res != nil && cmdResult.Strike,
-			Solved:     res != nil && cmdResult.Solved,
-			Result: &pb.PlayerInputResult_MorseInputResult{
-				MorseInputResult: &pb.MorseInputResult{
-					MorseState: &pb.MorseState{
-						DisplayedPattern:       cmdResult.DisplayedPattern,
-						DisplayedFrequency:     cmdResult.DisplayedFrequency,
-						SelectedFrequencyIndex: int32(cmdResult.SelectedFrequencyIdx),
-					},
-				},
-			},
-		}, nil
-	case *command.NeedyVentGasCommandResult:
-		return &pb.PlayerInputResult{
-			ModuleId:   i.GetModuleId(),
-			BombStatus: bombStatus,
-			Strike:     res != nil && cmdResult.Strike,
-			Solved:     res != nil && cmdResult.Solved,
-			Result: &pb.PlayerInputResult_NeedyVentGasInputResult{
-				NeedyVentGasInputResult: &pb.NeedyVentGasInputResult{
-					NeedyVentGasState: &pb.NeedyVentGasState{
-						DisplayedQuestion:  cmdResult.DisplayedQuestion,
-						CountdownStartedAt: cmdResult.CountdownStartedAt,
-						CountdownDuration:  int32(cmdResult.CountdownDuration),
-					},
-				},
-			},
-		}, nil
-	case *command.NeedyKnobCommandResult:
-		return &pb.PlayerInputResult{
-			ModuleId:   i.GetModuleId(),
-			BombStatus: bombStatus,
-			Strike:     res != nil && cmdResult.Strike,
-			Solved:     res != nil && cmdResult.Solved,
-			Result: &pb.PlayerInputResult_NeedyKnobInputResult{
-				NeedyKnobInputResult: &pb.NeedyKnobInputResult{
-					NeedyKnobState: &pb.NeedyKnobState{
-						DisplayedPatternFirstRow:  cmdResult.DisplayedPattern[0],
-						DisplayedPatternSecondRow: cmdResult.DisplayedPattern[1],
-						DialDirection:             pb.CardinalDirection(cmdResult.DialDirection),
-						CountdownStartedAt:        cmdResult.CoundownStartedAt,
-						CountdownDuration:         int32(cmdResult.CountdownDuration),
-					},
-				},
-			},
-		}, nil
-	case *command.MazeInputCommandResult:
-		return &pb.PlayerInputResult{
-			ModuleId:   i.GetModuleId(),
-			BombStatus: bombStatus,
-			Strike:     res != nil && cmdResult.Strike,
-			Solved:     res != nil && cmdResult.Solved,
-			Result: &pb.PlayerInputResult_MazeInputResult{
-				MazeInputResult: &pb.MazeInputResult{
-					MazeState: &pb.MazeState{
-						// Marker_1: *pb.Point2D,
-						// Marker_2: *pb.Point2D,
-						PlayerPosition: mapPoint2DToProto(cmdResult.PlayerPosition),
-						GoalPosition:   mapPoint2DToProto(cmdResult.GoalPosition),
-					},
-				},
-			},
-		}, nil
-	case nil:
-		return nil, nil
-	default:
-		return nil, fmt.Errorf("unknown result type: %T", res)
+	return mapChallengeToProto(challenge), nil
+}
+
+func (s *GameServiceAdapter) RegisterChallengePlayer(ctx context.Context, req *pb.RegisterChallengePlayerRequest) (*pb.ChallengePlayer, error) {
+	playerID, err := s.challengeService.RegisterPlayer(clientAddress(ctx))
+	if err != nil {
+		if errors.Is(err, valueobject.ErrTooManyChallengePlayers) {
+			return nil, status.Errorf(codes.ResourceExhausted, "%v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "%v", err)
 	}
+
+	return &pb.ChallengePlayer{PlayerId: playerID}, nil
 }
 
-func (s *GameServiceAdapter) GetBombs(ctx context.Context, req *pb.GetBombsRequest) (*pb.GetBombsResponse, error) {
-	gameState, err := s.gameService.GetGameSession(ctx, uuid.MustParse(req.GetSessionId()))
+// Returns the address of the client making the call. Calls through the REST gateway come
+// from loopback, so they're taken from the address the gateway forwards instead. Other
+// callers could forward any address, so theirs is ignored.
+func clientAddress(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
 	if err != nil {
-		return nil, fmt.Errorf("failed to get game session: %v", err)
+		host = p.Addr.String()
 	}
 
-	return mapGameSessionActorToProto(gameState), nil
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+			// The gateway appends the address it saw last
+			addresses := strings.Split(forwarded[len(forwarded)-1], ",")
+			return strings.TrimSpace(addresses[len(addresses)-1])
+		}
+	}
+	return host
 }
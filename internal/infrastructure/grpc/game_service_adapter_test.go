@@ -0,0 +1,270 @@
+package grpc_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/actors"
+	"github.com/ZaneH/defuse.party-go/internal/application/command"
+	"github.com/ZaneH/defuse.party-go/internal/application/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/adapters"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/challenge"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/grpc"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/leaderboard"
+	pb "github.com/ZaneH/defuse.party-go/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Run with -race: SendInput and GetBombs are served from many goroutines at once and must
+// only read the session through its actors.
+func TestGameServiceAdapter_ConcurrentSendInputAndGetBombs(t *testing.T) {
+	// Arrange: a practice bomb so strikes never end the game
+	actorSystem := actors.NewActorSystem()
+	bombService := services.NewBombService(adapters.NewActorSystemAdapter(actorSystem))
+	gameService := services.NewGameService(actorSystem, bombService, valueobject.NewMissionCatalog(), leaderboard.NewMemoryStore())
+	adapter := grpc.NewGameServiceAdapter(gameService, services.NewChallengeService(gameService, []byte("secret"), challenge.NewMemoryAttemptStore()), services.NewMatchService(gameService))
+
+	session, result, err := gameService.CreateGameSession(&command.CreateGameCommand{
+		Seed:           "stress",
+		ConfigType:     command.ConfigTypePractice,
+		PracticeModule: valueobject.SimonModule,
+	})
+	assert.NoError(t, err)
+	defer session.Stop()
+
+	ctx := context.Background()
+	_, err = adapter.StartGame(ctx, &pb.StartGameRequest{SessionId: result.SessionID.String()})
+	assert.NoError(t, err)
+	bombs, err := adapter.GetBombs(ctx, &pb.GetBombsRequest{SessionId: result.SessionID.String()})
+	assert.NoError(t, err)
+	if !assert.Len(t, bombs.GetBombs(), 1) {
+		return
+	}
+
+	bomb := bombs.GetBombs()[0]
+	var simonID string
+	for moduleID, module := range bomb.GetModules() {
+		if module.GetType() == pb.Module_SIMON {
+			simonID = moduleID
+		}
+	}
+	colors := []pb.Color{pb.Color_RED, pb.Color_BLUE, pb.Color_GREEN, pb.Color_YELLOW}
+
+	// Act
+	var wg sync.WaitGroup
+	for worker := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range 50 {
+				if worker%2 == 0 {
+					resp, err := adapter.GetBombs(ctx, &pb.GetBombsRequest{SessionId: result.SessionID.String()})
+					assert.NoError(t, err)
+					assert.Len(t, resp.GetBombs(), 1)
+					continue
+				}
+
+				resp, err := adapter.SendInput(ctx, &pb.PlayerInput{
+					SessionId: result.SessionID.String(),
+					BombId:    bomb.GetId(),
+					ModuleId:  simonID,
+					Input: &pb.PlayerInput_SimonInput{
+						SimonInput: &pb.SimonInput{Color: colors[i%len(colors)]},
+					},
+				})
+				if assert.NoError(t, err) {
+					assert.NotNil(t, resp.GetBombStatus())
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Assert
+	resp, err := adapter.GetBombs(ctx, &pb.GetBombsRequest{SessionId: result.SessionID.String()})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.BombState_ARMED, resp.GetBombs()[0].GetState(), "Practice bombs shouldn't explode")
+	assert.Positive(t, resp.GetBombs()[0].GetStrikeCount(), "Some of the presses should have been wrong")
+}
+
+func TestGameServiceAdapter_LobbyRejectsInputUntilStarted(t *testing.T) {
+	// Arrange
+	actorSystem := actors.NewActorSystem()
+	bombService := services.NewBombService(adapters.NewActorSystemAdapter(actorSystem))
+	gameService := services.NewGameService(actorSystem, bombService, valueobject.NewMissionCatalog(), leaderboard.NewMemoryStore())
+	adapter := grpc.NewGameServiceAdapter(gameService, services.NewChallengeService(gameService, []byte("secret"), challenge.NewMemoryAttemptStore()), services.NewMatchService(gameService))
+
+	session, result, err := gameService.CreateGameSession(&command.CreateGameCommand{
+		Seed:           "lobby",
+		ConfigType:     command.ConfigTypePractice,
+		PracticeModule: valueobject.SimonModule,
+	})
+	assert.NoError(t, err)
+	defer session.Stop()
+
+	ctx := context.Background()
+	sessionID := result.SessionID.String()
+	_, err = adapter.JoinSession(ctx, &pb.JoinSessionRequest{SessionId: sessionID, PlayerId: "defuser", Role: pb.PlayerRole_DEFUSER})
+	assert.NoError(t, err)
+
+	bombs, err := adapter.GetBombs(ctx, &pb.GetBombsRequest{SessionId: sessionID})
+	assert.NoError(t, err)
+	if !assert.Len(t, bombs.GetBombs(), 1) {
+		return
+	}
+	bomb := bombs.GetBombs()[0]
+	var simonID string
+	for moduleID, module := range bomb.GetModules() {
+		if module.GetType() == pb.Module_SIMON {
+			simonID = moduleID
+		}
+	}
+	input := &pb.PlayerInput{
+		SessionId: sessionID,
+		BombId:    bomb.GetId(),
+		ModuleId:  simonID,
+		Input: &pb.PlayerInput_SimonInput{
+			SimonInput: &pb.SimonInput{Color: pb.Color_RED},
+		},
+	}
+
+	// Act
+	_, lobbyErr := adapter.SendInput(ctx, input)
+	_, roleErr := adapter.JoinSession(ctx, &pb.JoinSessionRequest{SessionId: sessionID, PlayerId: "expert"})
+	_, readyErr := adapter.SetReady(ctx, &pb.SetReadyRequest{SessionId: sessionID, PlayerId: "nobody", Ready: true})
+	lobby, startErr := adapter.StartGame(ctx, &pb.StartGameRequest{SessionId: sessionID})
+	_, inputErr := adapter.SendInput(ctx, input)
+
+	// Assert
+	assert.Equal(t, pb.SessionState_LOBBY, bombs.GetState())
+	assert.Equal(t, int32(0), bomb.GetStartedAt(), "Bombs shouldn't be armed in the lobby")
+	if assert.Len(t, bombs.GetPlayers(), 1) {
+		assert.Equal(t, "defuser", bombs.GetPlayers()[0].GetPlayerId())
+		assert.Equal(t, pb.PlayerRole_DEFUSER, bombs.GetPlayers()[0].GetRole())
+	}
+	assert.Equal(t, codes.FailedPrecondition, status.Code(lobbyErr), "Input should wait for the game to start")
+	assert.Equal(t, codes.InvalidArgument, status.Code(roleErr), "Players need a role")
+	assert.Equal(t, codes.NotFound, status.Code(readyErr), "Only players in the lobby can be ready")
+	assert.NoError(t, startErr)
+	assert.True(t, lobby.GetStarted())
+	assert.NoError(t, inputErr)
+}
+
+func TestGameServiceAdapter_SpectatorsOnlyWatch(t *testing.T) {
+	// Arrange
+	actorSystem := actors.NewActorSystem()
+	bombService := services.NewBombService(adapters.NewActorSystemAdapter(actorSystem))
+	gameService := services.NewGameService(actorSystem, bombService, valueobject.NewMissionCatalog(), leaderboard.NewMemoryStore())
+	adapter := grpc.NewGameServiceAdapter(gameService, services.NewChallengeService(gameService, []byte("secret"), challenge.NewMemoryAttemptStore()), services.NewMatchService(gameService))
+
+	session, result, err := gameService.CreateGameSession(&command.CreateGameCommand{
+		Seed:           "stream",
+		ConfigType:     command.ConfigTypePractice,
+		PracticeModule: valueobject.SimonModule,
+		SpectatorDelay: time.Minute,
+	})
+	assert.NoError(t, err)
+	defer session.Stop()
+
+	ctx := context.Background()
+	sessionID := result.SessionID.String()
+	for playerID, role := range map[string]pb.PlayerRole{"defuser": pb.PlayerRole_DEFUSER, "stream": pb.PlayerRole_SPECTATOR} {
+		_, err = adapter.JoinSession(ctx, &pb.JoinSessionRequest{SessionId: sessionID, PlayerId: playerID, Role: role})
+		assert.NoError(t, err)
+	}
+	_, err = adapter.StartGame(ctx, &pb.StartGameRequest{SessionId: sessionID})
+	assert.NoError(t, err)
+
+	bombs, err := adapter.GetBombs(ctx, &pb.GetBombsRequest{SessionId: sessionID, PlayerId: "defuser"})
+	assert.NoError(t, err)
+	if !assert.Len(t, bombs.GetBombs(), 1) {
+		return
+	}
+	bomb := bombs.GetBombs()[0]
+	var simonID string
+	for moduleID, module := range bomb.GetModules() {
+		if module.GetType() == pb.Module_SIMON {
+			simonID = moduleID
+		}
+	}
+	input := func(playerID string) error {
+		_, err := adapter.SendInput(ctx, &pb.PlayerInput{
+			SessionId: sessionID,
+			BombId:    bomb.GetId(),
+			ModuleId:  simonID,
+			PlayerId:  playerID,
+			Input: &pb.PlayerInput_SimonInput{
+				SimonInput: &pb.SimonInput{Color: pb.Color_RED},
+			},
+		})
+		return err
+	}
+
+	// Act
+	_, spectatorBombsErr := adapter.GetBombs(ctx, &pb.GetBombsRequest{SessionId: sessionID, PlayerId: "stream"})
+	_, anonymousBombsErr := adapter.GetBombs(ctx, &pb.GetBombsRequest{SessionId: sessionID})
+	_, spectatorReportErr := adapter.GetGameReport(ctx, &pb.GetGameReportRequest{SessionId: sessionID, PlayerId: "stream"})
+	_, spectatorPauseErr := adapter.PauseGame(ctx, &pb.PauseGameRequest{SessionId: sessionID, PlayerId: "stream"})
+	spectatorInputErr := input("stream")
+	anonymousInputErr := input("")
+	defuserInputErr := input("defuser")
+	_, defuserReportErr := adapter.GetGameReport(ctx, &pb.GetGameReportRequest{SessionId: sessionID, PlayerId: "defuser"})
+
+	// Assert
+	assert.Equal(t, codes.PermissionDenied, status.Code(spectatorBombsErr), "Spectators should only see the delayed watch")
+	assert.Equal(t, codes.NotFound, status.Code(anonymousBombsErr), "Delayed sessions need to know who's asking")
+	assert.Equal(t, codes.PermissionDenied, status.Code(spectatorReportErr))
+	assert.Equal(t, codes.PermissionDenied, status.Code(spectatorPauseErr))
+	assert.Equal(t, codes.PermissionDenied, status.Code(spectatorInputErr), "Spectators shouldn't affect play")
+	assert.Equal(t, codes.NotFound, status.Code(anonymousInputErr))
+	assert.NoError(t, defuserInputErr)
+	assert.NoError(t, defuserReportErr)
+}
+
+func TestGameServiceAdapter_PausedGamesHideModules(t *testing.T) {
+	// Arrange
+	actorSystem := actors.NewActorSystem()
+	bombService := services.NewBombService(adapters.NewActorSystemAdapter(actorSystem))
+	gameService := services.NewGameService(actorSystem, bombService, valueobject.NewMissionCatalog(), leaderboard.NewMemoryStore())
+	adapter := grpc.NewGameServiceAdapter(gameService, services.NewChallengeService(gameService, []byte("secret"), challenge.NewMemoryAttemptStore()), services.NewMatchService(gameService))
+
+	session, result, err := gameService.CreateGameSession(&command.CreateGameCommand{
+		Seed:           "paused",
+		ConfigType:     command.ConfigTypePractice,
+		PracticeModule: valueobject.SimonModule,
+	})
+	assert.NoError(t, err)
+	defer session.Stop()
+
+	ctx := context.Background()
+	sessionID := result.SessionID.String()
+	_, err = adapter.StartGame(ctx, &pb.StartGameRequest{SessionId: sessionID})
+	assert.NoError(t, err)
+	simonState := func() *pb.SimonState {
+		bombs, err := adapter.GetBombs(ctx, &pb.GetBombsRequest{SessionId: sessionID})
+		assert.NoError(t, err)
+		for _, module := range bombs.GetBombs()[0].GetModules() {
+			if module.GetType() == pb.Module_SIMON {
+				return module.GetSimonState()
+			}
+		}
+		return nil
+	}
+
+	// Act
+	_, err = adapter.PauseGame(ctx, &pb.PauseGameRequest{SessionId: sessionID})
+	assert.NoError(t, err)
+	paused := simonState()
+	_, err = adapter.ResumeGame(ctx, &pb.ResumeGameRequest{SessionId: sessionID})
+	assert.NoError(t, err)
+	resumed := simonState()
+
+	// Assert
+	assert.Nil(t, paused, "Modules shouldn't be studied with the clock held")
+	assert.NotNil(t, resumed)
+}
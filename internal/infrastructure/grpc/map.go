@@ -1,312 +1,206 @@
 package grpc
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"sort"
+	"time"
 
 	"github.com/ZaneH/defuse.party-go/internal/actors"
+	"github.com/ZaneH/defuse.party-go/internal/application/command"
 	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
 	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
 	pb "github.com/ZaneH/defuse.party-go/pkg/proto"
-	"github.com/google/uuid"
 )
 
+// Returns UNKNOWN for module types without a gRPC mapping, such as RandomModule.
 func mapTypeToProto(moduleType valueobject.ModuleType) pb.Module_ModuleType {
-	switch moduleType {
-	case valueobject.WiresModule:
-		return pb.Module_WIRES
-	case valueobject.PasswordModule:
-		return pb.Module_PASSWORD
-	case valueobject.BigButtonModule:
-		return pb.Module_BIG_BUTTON
-	case valueobject.ClockModule:
-		return pb.Module_CLOCK
-	case valueobject.SimonModule:
-		return pb.Module_SIMON
-	case valueobject.KeypadModule:
-		return pb.Module_KEYPAD
-	case valueobject.WhosOnFirstModule:
-		return pb.Module_WHOS_ON_FIRST
-	case valueobject.MemoryModule:
-		return pb.Module_MEMORY
-	case valueobject.MorseModule:
-		return pb.Module_MORSE
-	case valueobject.NeedyVentGasModule:
-		return pb.Module_NEEDY_VENT_GAS
-	case valueobject.NeedyKnobModule:
-		return pb.Module_NEEDY_KNOB
-	case valueobject.MazeModule:
-		return pb.Module_MAZE
-	default:
-		log.Fatalf("Unknown module type: %v. Couldn't map type to proto.", moduleType)
+	mapping, ok := moduleMappings[moduleType]
+	if !ok {
 		return pb.Module_UNKNOWN
 	}
+	return mapping.protoType
 }
 
-func mapColorToProto(color valueobject.Color) pb.Color {
+func mapColorToProto(color valueobject.Color) (pb.Color, error) {
 	switch color {
 	case valueobject.Red:
-		return pb.Color_RED
+		return pb.Color_RED, nil
 	case valueobject.Blue:
-		return pb.Color_BLUE
+		return pb.Color_BLUE, nil
 	case valueobject.White:
-		return pb.Color_WHITE
+		return pb.Color_WHITE, nil
 	case valueobject.Yellow:
-		return pb.Color_YELLOW
+		return pb.Color_YELLOW, nil
 	case valueobject.Green:
-		return pb.Color_GREEN
+		return pb.Color_GREEN, nil
 	case valueobject.Black:
-		return pb.Color_BLACK
+		return pb.Color_BLACK, nil
 	case valueobject.Orange:
-		return pb.Color_ORANGE
+		return pb.Color_ORANGE, nil
 	case valueobject.Pink:
-		return pb.Color_PINK
+		return pb.Color_PINK, nil
 	default:
-		log.Fatalf("Unknown color: %v. Couldn't provide state.", color)
-		return pb.Color_UNKNOWN
+		return pb.Color_UNKNOWN, fmt.Errorf("unknown color %q", color)
 	}
 }
 
-func mapGameSessionActorToProto(game *actors.GameSessionActor) *pb.GetBombsResponse {
+func mapSessionSnapshotToProto(session actors.SessionSnapshot) *pb.GetBombsResponse {
 	protoGameState := pb.GetBombsResponse{}
 
 	var bombs []*pb.Bomb
-	for _, bombActor := range game.GetBombActors() {
-		bomb := bombActor.GetBomb()
-
-		started_at := bomb.StartedAt
-		var started_at_ts int32
-		if started_at != nil {
-			started_at_ts = int32(bomb.StartedAt.Unix())
+	for order, bomb := range session.Bombs {
+		protoBomb := mapBombToProto(projectBomb(bomb), order)
+		// Modules can't be studied while the clock is held
+		if session.Paused {
+			for _, module := range protoBomb.Modules {
+				module.State = nil
+			}
 		}
-
-		bombs = append(bombs, &pb.Bomb{
-			Id:            bomb.ID.String(),
-			SerialNumber:  bomb.SerialNumber,
-			TimerDuration: int32(bomb.TimerDuration.Seconds()),
-			StartedAt:     started_at_ts,
-			StrikeCount:   int32(bomb.StrikeCount),
-			MaxStrikes:    int32(bomb.MaxStrikes),
-			Modules:       mapModulesToProto(bombActor.GetModuleActors()),
-			Indicators:    mapIndicatorsToProto(bomb.Indicators),
-			Batteries:     int32(bomb.Batteries),
-			Ports:         mapPortsToProto(bomb.Ports),
-		})
+		bombs = append(bombs, protoBomb)
 	}
 
 	protoGameState.Bombs = bombs
+	protoGameState.State = mapSessionStateToProto(session.State)
+	protoGameState.Players = mapLobbyPlayersToProto(session.Lobby.Players)
+	protoGameState.Paused = session.Paused
+	protoGameState.PauseDisabled = session.PauseDisabled
 
 	return &protoGameState
 }
 
-func mapModulesToProto(modules map[uuid.UUID]actors.ModuleActor) map[string]*pb.Module {
+// Maps a bomb that has already been through projectBomb.
+func mapBombToProto(bomb entities.BombSnapshot, order int) *pb.Bomb {
+	var started_at_ts int32
+	if bomb.StartedAt != nil {
+		started_at_ts = int32(bomb.StartedAt.Unix())
+	}
+
+	return &pb.Bomb{
+		Id:             bomb.ID.String(),
+		SerialNumber:   bomb.SerialNumber,
+		TimerDuration:  int32(bomb.TimerDuration.Seconds()),
+		StartedAt:      started_at_ts,
+		StrikeCount:    int32(bomb.StrikeCount),
+		MaxStrikes:     int32(bomb.MaxStrikes),
+		Modules:        mapModulesToProto(bomb.Modules),
+		Indicators:     mapIndicatorsToProto(bomb.Indicators),
+		Batteries:      int32(bomb.Batteries),
+		Ports:          mapPortsToProto(bomb.Ports),
+		PortPlates:     mapPortPlatesToProto(bomb.PortPlates),
+		BatteryHolders: mapBatteryHoldersToProto(bomb.BatteryHolders),
+		TimerRate:      float32(bomb.TimerRate),
+		TimeLeftMs:     bomb.TimeLeft.Milliseconds(),
+		TimerMs:        bomb.Timer.Milliseconds(),
+		State:          mapBombStateToProto(bomb.State),
+		Order:          int32(order),
+		ModulesSolved:  int32(bomb.ModulesSolved),
+		ModulesTotal:   int32(bomb.ModulesTotal),
+		Modifiers:      mapModifiersToProto(bomb.Modifiers),
+	}
+}
+
+// Maps the bomb's status after an input. Projects the bomb itself.
+func mapBombStatusToProto(bomb entities.BombSnapshot, sessionState valueobject.SessionState) *pb.BombStatus {
+	bomb = projectBomb(bomb)
+
+	return &pb.BombStatus{
+		StrikeCount:  int32(bomb.StrikeCount),
+		MaxStrikes:   int32(bomb.MaxStrikes),
+		Exploded:     bomb.State == valueobject.BombStateExploded,
+		TimerRate:    float32(bomb.TimerRate),
+		TimeLeftMs:   bomb.TimeLeft.Milliseconds(),
+		TimerMs:      bomb.Timer.Milliseconds(),
+		State:        mapBombStateToProto(bomb.State),
+		SessionState: mapSessionStateToProto(sessionState),
+	}
+}
+
+func mapModulesToProto(modules []entities.ModuleSnapshot) map[string]*pb.Module {
 	protoModules := make(map[string]*pb.Module)
-	for _, actor := range modules {
+	for _, module := range modules {
+		mapping, ok := moduleMappings[module.Type]
+		if !ok {
+			log.Printf("No gRPC mapping for module type %v, skipping...", module.Type)
+			continue
+		}
+
 		protoModule := &pb.Module{
-			Id:   actor.GetModuleID().String(),
-			Type: mapTypeToProto(actor.GetModule().GetType()),
+			Id:   module.ModuleID.String(),
+			Type: mapping.protoType,
 			Position: &pb.ModulePosition{
-				Row:  int32(actor.GetModule().GetPosition().Row),
-				Col:  int32(actor.GetModule().GetPosition().Column),
-				Face: int32(actor.GetModule().GetPosition().Face),
+				Row:  int32(module.Position.Row),
+				Col:  int32(module.Position.Column),
+				Face: int32(module.Position.Face),
 			},
+			Solved: module.Solved,
 		}
 
-		moduleState := actor.GetModule().GetModuleState()
-		if moduleState != nil {
-			protoModule.Solved = moduleState.IsSolved()
+		if err := mapping.projectState(module, protoModule); err != nil {
+			log.Printf("Couldn't map state of module %s: %v", module.ModuleID, err)
+			continue
 		}
 
-		switch actor.GetModule().GetType() {
-		case valueobject.WiresModule:
-			wiresState, ok := actor.GetModule().GetModuleState().(*entities.WiresState)
-			if !ok {
-				log.Printf("Expected *WiresState but got different type: %T", actor.GetModule().GetModuleState())
-				continue
-			}
-
-			wires := make([]*pb.Wire, 0, len(wiresState.Wires))
-			for _, wire := range wiresState.Wires {
-				wires = append(wires, &pb.Wire{
-					WireColor: mapColorToProto(wire.WireColor),
-					IsCut:     wire.IsCut,
-					Position:  int32(wire.Position),
-				})
-			}
-
-			protoModule.State = &pb.Module_WiresState{
-				WiresState: &pb.WiresState{
-					Wires: wires,
-				},
-			}
-		case valueobject.BigButtonModule:
-			bigButtonState, ok := actor.GetModule().GetModuleState().(*entities.BigButtonState)
-			if !ok {
-				log.Printf("Expected *BigButtonState but got different type: %T", actor.GetModule().GetModuleState())
-				continue
-			}
-
-			protoModule.State = &pb.Module_BigButtonState{
-				BigButtonState: &pb.BigButtonState{
-					ButtonColor: mapColorToProto(bigButtonState.ButtonColor),
-					Label:       bigButtonState.Label,
-				},
-			}
-		case valueobject.ClockModule:
-		case valueobject.SimonModule:
-			simonState, ok := actor.GetModule().GetModuleState().(*entities.SimonState)
-			if !ok {
-				log.Printf("Expected *SimonState but got different type: %T", actor.GetModule().GetModuleState())
-				continue
-			}
-
-			seq := simonState.DisplaySequence
-			seqProto := make([]pb.Color, len(seq))
-			for i, color := range seq {
-				seqProto[i] = mapColorToProto(color)
-			}
-
-			protoModule.State = &pb.Module_SimonState{
-				SimonState: &pb.SimonState{
-					CurrentSequence: seqProto,
-				},
-			}
-		case valueobject.PasswordModule:
-			passwordModule, ok := actor.GetModule().(*entities.PasswordModule)
-			if !ok {
-				log.Printf("Expected *PasswordModule but got different type: %T", actor.GetModule().GetModuleState())
-				continue
-			}
-
-			protoModule.State = &pb.Module_PasswordState{
-				PasswordState: &pb.PasswordState{
-					Letters: passwordModule.GetCurrentGuess(),
-				},
-			}
-		case valueobject.KeypadModule:
-			keypadState, ok := actor.GetModule().GetModuleState().(*entities.KeypadState)
-			if !ok {
-				log.Printf("Expected *KeypadState but got different type: %T", actor.GetModule().GetModuleState())
-				continue
-			}
-
-			displayed_symbols := make([]pb.Symbol, 0, len(keypadState.DisplayedSymbols))
-			for _, symbol := range keypadState.DisplayedSymbols {
-				displayed_symbols = append(displayed_symbols, mapSymbolToProto(symbol))
-			}
-
-			activated_symbols := make([]pb.Symbol, 0, len(keypadState.ActivatedSymbols))
-			for symbol := range keypadState.ActivatedSymbols {
-				if keypadState.ActivatedSymbols[symbol] {
-					activated_symbols = append(activated_symbols, mapSymbolToProto(symbol))
-				}
-			}
-
-			protoModule.State = &pb.Module_KeypadState{
-				KeypadState: &pb.KeypadState{
-					DisplayedSymbols: displayed_symbols,
-					ActivatedSymbols: activated_symbols,
-				},
-			}
-		case valueobject.WhosOnFirstModule:
-			whosOnFirstState, ok := actor.GetModule().GetModuleState().(*entities.WhosOnFirstState)
-			if !ok {
-				log.Printf("Expected *WhosOnFirstState but got different type: %T", actor.GetModule().GetModuleState())
-				continue
-			}
-
-			protoModule.State = &pb.Module_WhosOnFirstState{
-				WhosOnFirstState: &pb.WhosOnFirstState{
-					ScreenWord:  whosOnFirstState.ScreenWord,
-					ButtonWords: whosOnFirstState.ButtonWords,
-					Stage:       int32(whosOnFirstState.Stage),
-				},
-			}
-		case valueobject.MemoryModule:
-			memoryState, ok := actor.GetModule().GetModuleState().(*entities.MemoryState)
-			if !ok {
-				log.Printf("Expected *MemoryState but got different type: %T", actor.GetModule().GetModuleState())
-				continue
-			}
+		protoModules[module.ModuleID.String()] = protoModule
+	}
 
-			displayedNumbers := make([]int32, len(memoryState.DisplayedNumbers))
-			for i, num := range memoryState.DisplayedNumbers {
-				displayedNumbers[i] = int32(num)
-			}
+	return protoModules
+}
 
-			protoModule.State = &pb.Module_MemoryState{
-				MemoryState: &pb.MemoryState{
-					ScreenNumber:     int32(memoryState.ScreenNumber),
-					DisplayedNumbers: displayedNumbers,
-					Stage:            int32(memoryState.Stage),
-				},
-			}
-		case valueobject.MorseModule:
-			morseState, ok := actor.GetModule().GetModuleState().(*entities.MorseState)
-			if !ok {
-				log.Printf("Expected *MorseState but got different type: %T", actor.GetModule().GetModuleState())
-				continue
-			}
+func mapColorsToProto(colors []valueobject.Color) ([]pb.Color, error) {
+	protoColors := make([]pb.Color, len(colors))
+	for i, color := range colors {
+		protoColor, err := mapColorToProto(color)
+		if err != nil {
+			return nil, err
+		}
+		protoColors[i] = protoColor
+	}
+	return protoColors, nil
+}
 
-			protoModule.State = &pb.Module_MorseState{
-				MorseState: &pb.MorseState{
-					DisplayedPattern:       morseState.DisplayedPattern,
-					DisplayedFrequency:     morseState.DisplayedFrequency,
-					SelectedFrequencyIndex: int32(morseState.SelectedFrequencyIdx),
-				},
-			}
-		case valueobject.NeedyVentGasModule:
-			needyVentGasState, ok := actor.GetModule().GetModuleState().(*entities.NeedyVentGasState)
-			if !ok {
-				log.Printf("Expected *NeedyVentGasState but got different type: %T", actor.GetModule().GetModuleState())
-				continue
-			}
+func mapIntsToProto(nums []int) []int32 {
+	protoNums := make([]int32, len(nums))
+	for i, num := range nums {
+		protoNums[i] = int32(num)
+	}
+	return protoNums
+}
 
-			protoModule.State = &pb.Module_NeedyVentGasState{
-				NeedyVentGasState: &pb.NeedyVentGasState{
-					DisplayedQuestion:  needyVentGasState.DisplayedQuestion,
-					CountdownStartedAt: needyVentGasState.CountdownStartedAt,
-					CountdownDuration:  int32(needyVentGasState.CountdownDuration),
-				},
-			}
-		case valueobject.NeedyKnobModule:
-			needyKnobState, ok := actor.GetModule().GetModuleState().(*entities.NeedyKnobState)
-			if !ok {
-				log.Printf("Expected *NeedyKnobState but got different type: %T", actor.GetModule().GetModuleState())
-				continue
-			}
+func mapProtoToInts(protoNums []int32) []int {
+	nums := make([]int, len(protoNums))
+	for i, num := range protoNums {
+		nums[i] = int(num)
+	}
+	return nums
+}
 
-			protoModule.State = &pb.Module_NeedyKnobState{
-				NeedyKnobState: &pb.NeedyKnobState{
-					DisplayedPatternFirstRow:  needyKnobState.DisplayedPattern[0],
-					DisplayedPatternSecondRow: needyKnobState.DisplayedPattern[1],
-					CountdownStartedAt:        needyKnobState.CountdownStartedAt,
-					CountdownDuration:         int32(needyKnobState.CountdownDuration),
-				},
-			}
-		case valueobject.MazeModule:
-			mazeState, ok := actor.GetModule().GetModuleState().(*entities.MazeModuleState)
-			if !ok {
-				log.Printf("Expected *MazeModuleState but got different type: %T", actor.GetModule().GetModuleState())
-				continue
-			}
+func mapKeypadStateToProto(displayed []valueobject.Symbol, activated map[valueobject.Symbol]bool) (*pb.KeypadState, error) {
+	displayedSymbols := make([]pb.Symbol, 0, len(displayed))
+	for _, symbol := range displayed {
+		protoSymbol, err := mapSymbolToProto(symbol)
+		if err != nil {
+			return nil, err
+		}
+		displayedSymbols = append(displayedSymbols, protoSymbol)
+	}
 
-			maze := mazeState.VariantToMaze()
-			protoModule.State = &pb.Module_MazeState{
-				MazeState: &pb.MazeState{
-					Marker_1:       mapPoint2DToProto(maze.Marker1),
-					Marker_2:       mapPoint2DToProto(maze.Marker2),
-					PlayerPosition: mapPoint2DToProto(mazeState.PlayerPosition),
-					GoalPosition:   mapPoint2DToProto(mazeState.GoalPosition),
-				},
+	activatedSymbols := make([]pb.Symbol, 0, len(activated))
+	for symbol, active := range activated {
+		if active {
+			protoSymbol, err := mapSymbolToProto(symbol)
+			if err != nil {
+				return nil, err
 			}
-		default:
-			log.Fatalf("Unknown module type: %v. Couldn't provide state.", actor.GetModule().GetType())
+			activatedSymbols = append(activatedSymbols, protoSymbol)
 		}
-
-		protoModules[actor.GetModule().GetModuleID().String()] = protoModule
 	}
 
-	return protoModules
+	return &pb.KeypadState{
+		DisplayedSymbols: displayedSymbols,
+		ActivatedSymbols: activatedSymbols,
+	}, nil
 }
 
 func mapProtoToPressType(pressType pb.PressType) valueobject.PressType {
@@ -348,167 +242,183 @@ func mapPortsToProto(ports []valueobject.Port) []pb.Port {
 			protoPorts = append(protoPorts, pb.Port_RJ45)
 		case valueobject.PortSerial:
 			protoPorts = append(protoPorts, pb.Port_SERIAL)
+		case valueobject.PortParallel:
+			protoPorts = append(protoPorts, pb.Port_PARALLEL)
 		}
 	}
 	return protoPorts
 }
 
-func mapProtoToColor(color pb.Color) valueobject.Color {
+func mapPortPlatesToProto(plates []valueobject.PortPlate) []*pb.PortPlate {
+	protoPlates := make([]*pb.PortPlate, 0, len(plates))
+	for _, plate := range plates {
+		protoPlates = append(protoPlates, &pb.PortPlate{
+			Ports: mapPortsToProto(plate.Ports),
+		})
+	}
+	return protoPlates
+}
+
+func mapBatteryHoldersToProto(holders []valueobject.BatteryHolder) []*pb.BatteryHolder {
+	protoHolders := make([]*pb.BatteryHolder, 0, len(holders))
+	for _, holder := range holders {
+		batteryType := pb.BatteryType_AA
+		if holder.Type == valueobject.BatteryTypeD {
+			batteryType = pb.BatteryType_D
+		}
+
+		protoHolders = append(protoHolders, &pb.BatteryHolder{
+			Type:      batteryType,
+			Batteries: int32(holder.BatteryCount()),
+		})
+	}
+	return protoHolders
+}
+
+func mapProtoToColor(color pb.Color) (valueobject.Color, error) {
 	switch color {
 	case pb.Color_RED:
-		return valueobject.Red
+		return valueobject.Red, nil
 	case pb.Color_BLUE:
-		return valueobject.Blue
+		return valueobject.Blue, nil
 	case pb.Color_WHITE:
-		return valueobject.White
+		return valueobject.White, nil
 	case pb.Color_YELLOW:
-		return valueobject.Yellow
+		return valueobject.Yellow, nil
 	case pb.Color_GREEN:
-		return valueobject.Green
+		return valueobject.Green, nil
 	case pb.Color_BLACK:
-		return valueobject.Black
+		return valueobject.Black, nil
 	case pb.Color_ORANGE:
-		return valueobject.Orange
+		return valueobject.Orange, nil
 	case pb.Color_PINK:
-		return valueobject.Pink
+		return valueobject.Pink, nil
 	default:
-		log.Fatalf("Unknown color: %v. Couldn't provide state.", color)
-		return valueobject.Red
+		return "", fmt.Errorf("unknown color %v", color)
 	}
 }
 
-func mapSymbolToProto(symbol valueobject.Symbol) pb.Symbol {
+func mapSymbolToProto(symbol valueobject.Symbol) (pb.Symbol, error) {
 	switch symbol {
 	case valueobject.Copyright:
-		return pb.Symbol_COPYRIGHT
+		return pb.Symbol_COPYRIGHT, nil
 	case valueobject.FilledStar:
-		return pb.Symbol_FILLEDSTAR
+		return pb.Symbol_FILLEDSTAR, nil
 	case valueobject.HollowStar:
-		return pb.Symbol_HOLLOWSTAR
+		return pb.Symbol_HOLLOWSTAR, nil
 	case valueobject.SmileyFace:
-		return pb.Symbol_SMILEYFACE
+		return pb.Symbol_SMILEYFACE, nil
 	case valueobject.DoubleK:
-		return pb.Symbol_DOUBLEK
+		return pb.Symbol_DOUBLEK, nil
 	case valueobject.Omega:
-		return pb.Symbol_OMEGA
+		return pb.Symbol_OMEGA, nil
 	case valueobject.SquidKnife:
-		return pb.Symbol_SQUIDKNIFE
+		return pb.Symbol_SQUIDKNIFE, nil
 	case valueobject.Pumpkin:
-		return pb.Symbol_PUMPKIN
+		return pb.Symbol_PUMPKIN, nil
 	case valueobject.HookN:
-		return pb.Symbol_HOOKN
-	case valueobject.Teepee:
-		log.Fatalf("Teepee symbol is not implemented in proto mapping.")
+		return pb.Symbol_HOOKN, nil
 	case valueobject.Six:
-		return pb.Symbol_SIX
+		return pb.Symbol_SIX, nil
 	case valueobject.SquigglyN:
-		return pb.Symbol_SQUIGGLYN
+		return pb.Symbol_SQUIGGLYN, nil
 	case valueobject.At:
-		return pb.Symbol_AT
+		return pb.Symbol_AT, nil
 	case valueobject.Ae:
-		return pb.Symbol_AE
+		return pb.Symbol_AE, nil
 	case valueobject.MeltedThree:
-		return pb.Symbol_MELTEDTHREE
+		return pb.Symbol_MELTEDTHREE, nil
 	case valueobject.Euro:
-		return pb.Symbol_EURO
-	case valueobject.Circle:
-		log.Fatalf("Circle symbol is not implemented in proto mapping.")
+		return pb.Symbol_EURO, nil
 	case valueobject.NWithHat:
-		return pb.Symbol_NWITHHAT
+		return pb.Symbol_NWITHHAT, nil
 	case valueobject.Dragon:
-		return pb.Symbol_DRAGON
+		return pb.Symbol_DRAGON, nil
 	case valueobject.QuestionMark:
-		return pb.Symbol_QUESTIONMARK
+		return pb.Symbol_QUESTIONMARK, nil
 	case valueobject.Paragraph:
-		return pb.Symbol_PARAGRAPH
+		return pb.Symbol_PARAGRAPH, nil
 	case valueobject.RightC:
-		return pb.Symbol_RIGHTC
+		return pb.Symbol_RIGHTC, nil
 	case valueobject.LeftC:
-		return pb.Symbol_LEFTC
+		return pb.Symbol_LEFTC, nil
 	case valueobject.Pitchfork:
-		return pb.Symbol_PITCHFORK
-	case valueobject.Tripod:
-		log.Fatalf("Tripod symbol is not implemented in proto mapping.")
+		return pb.Symbol_PITCHFORK, nil
 	case valueobject.Cursive:
-		return pb.Symbol_CURSIVE
+		return pb.Symbol_CURSIVE, nil
 	case valueobject.Tracks:
-		return pb.Symbol_TRACKS
+		return pb.Symbol_TRACKS, nil
 	case valueobject.Balloon:
-		return pb.Symbol_BALLOON
-	case valueobject.WeirdNose:
-		log.Fatalf("WeirdNose symbol is not implemented in proto mapping.")
+		return pb.Symbol_BALLOON, nil
 	case valueobject.UpsideDownY:
-		return pb.Symbol_UPSIDEDOWNY
+		return pb.Symbol_UPSIDEDOWNY, nil
 	case valueobject.Bt:
-		return pb.Symbol_BT
+		return pb.Symbol_BT, nil
 	default:
-		log.Fatalf("Unknown symbol: %v. Couldn't provide state.", symbol)
+		// Teepee, Circle, Tripod and WeirdNose aren't in the proto yet
+		return 0, fmt.Errorf("symbol %q has no proto mapping", symbol)
 	}
-
-	return pb.Symbol_COPYRIGHT
 }
 
-func mapProtoToSymbol(symbol pb.Symbol) valueobject.Symbol {
+func mapProtoToSymbol(symbol pb.Symbol) (valueobject.Symbol, error) {
 	switch symbol {
 	case pb.Symbol_COPYRIGHT:
-		return valueobject.Copyright
+		return valueobject.Copyright, nil
 	case pb.Symbol_FILLEDSTAR:
-		return valueobject.FilledStar
+		return valueobject.FilledStar, nil
 	case pb.Symbol_HOLLOWSTAR:
-		return valueobject.HollowStar
+		return valueobject.HollowStar, nil
 	case pb.Symbol_SMILEYFACE:
-		return valueobject.SmileyFace
+		return valueobject.SmileyFace, nil
 	case pb.Symbol_DOUBLEK:
-		return valueobject.DoubleK
+		return valueobject.DoubleK, nil
 	case pb.Symbol_OMEGA:
-		return valueobject.Omega
+		return valueobject.Omega, nil
 	case pb.Symbol_SQUIDKNIFE:
-		return valueobject.SquidKnife
+		return valueobject.SquidKnife, nil
 	case pb.Symbol_PUMPKIN:
-		return valueobject.Pumpkin
+		return valueobject.Pumpkin, nil
 	case pb.Symbol_HOOKN:
-		return valueobject.HookN
+		return valueobject.HookN, nil
 	case pb.Symbol_SIX:
-		return valueobject.Six
+		return valueobject.Six, nil
 	case pb.Symbol_SQUIGGLYN:
-		return valueobject.SquigglyN
+		return valueobject.SquigglyN, nil
 	case pb.Symbol_AT:
-		return valueobject.At
+		return valueobject.At, nil
 	case pb.Symbol_AE:
-		return valueobject.Ae
+		return valueobject.Ae, nil
 	case pb.Symbol_MELTEDTHREE:
-		return valueobject.MeltedThree
+		return valueobject.MeltedThree, nil
 	case pb.Symbol_EURO:
-		return valueobject.Euro
+		return valueobject.Euro, nil
 	case pb.Symbol_NWITHHAT:
-		return valueobject.NWithHat
+		return valueobject.NWithHat, nil
 	case pb.Symbol_DRAGON:
-		return valueobject.Dragon
+		return valueobject.Dragon, nil
 	case pb.Symbol_QUESTIONMARK:
-		return valueobject.QuestionMark
+		return valueobject.QuestionMark, nil
 	case pb.Symbol_PARAGRAPH:
-		return valueobject.Paragraph
+		return valueobject.Paragraph, nil
 	case pb.Symbol_RIGHTC:
-		return valueobject.RightC
+		return valueobject.RightC, nil
 	case pb.Symbol_LEFTC:
-		return valueobject.LeftC
+		return valueobject.LeftC, nil
 	case pb.Symbol_PITCHFORK:
-		return valueobject.Pitchfork
+		return valueobject.Pitchfork, nil
 	case pb.Symbol_CURSIVE:
-		return valueobject.Cursive
+		return valueobject.Cursive, nil
 	case pb.Symbol_TRACKS:
-		return valueobject.Tracks
+		return valueobject.Tracks, nil
 	case pb.Symbol_BALLOON:
-		return valueobject.Balloon
+		return valueobject.Balloon, nil
 	case pb.Symbol_UPSIDEDOWNY:
-		return valueobject.UpsideDownY
+		return valueobject.UpsideDownY, nil
 	case pb.Symbol_BT:
-		return valueobject.Bt
+		return valueobject.Bt, nil
 	default:
-		log.Fatalf("Unknown symbol: %v. Couldn't provide state.", symbol)
+		return "", fmt.Errorf("unknown symbol %v", symbol)
 	}
-
-	return valueobject.Copyright
 }
 
 func mapPoint2DToProto(p valueobject.Point2D) *pb.Point2D {
@@ -517,3 +427,461 @@ func mapPoint2DToProto(p valueobject.Point2D) *pb.Point2D {
 		Y: int64(p.Y),
 	}
 }
+
+func mapBombStateToProto(state valueobject.BombState) pb.BombState {
+	switch state {
+	case valueobject.BombStateWaiting:
+		return pb.BombState_WAITING
+	case valueobject.BombStateArmed:
+		return pb.BombState_ARMED
+	case valueobject.BombStateDefused:
+		return pb.BombState_DEFUSED
+	case valueobject.BombStateExploded:
+		return pb.BombState_EXPLODED
+	case valueobject.BombStatePaused:
+		return pb.BombState_PAUSED
+	default:
+		log.Printf("Unknown bomb state: %v. Falling back to Armed.", state)
+		return pb.BombState_ARMED
+	}
+}
+
+func mapSessionStateToProto(state valueobject.SessionState) pb.SessionState {
+	switch state {
+	case valueobject.SessionStateCompleted:
+		return pb.SessionState_COMPLETED
+	case valueobject.SessionStateFailed:
+		return pb.SessionState_FAILED
+	case valueobject.SessionStateLobby:
+		return pb.SessionState_LOBBY
+	default:
+		return pb.SessionState_IN_PROGRESS
+	}
+}
+
+func mapLobbyToProto(lobby valueobject.Lobby) *pb.LobbyState {
+	return &pb.LobbyState{
+		Players: mapLobbyPlayersToProto(lobby.Players),
+		Started: lobby.Started,
+	}
+}
+
+func mapLobbyPlayersToProto(players []valueobject.LobbyPlayer) []*pb.LobbyPlayer {
+	protoPlayers := make([]*pb.LobbyPlayer, len(players))
+	for i, player := range players {
+		protoPlayers[i] = mapLobbyPlayerToProto(player)
+	}
+	return protoPlayers
+}
+
+func mapLobbyPlayerToProto(player valueobject.LobbyPlayer) *pb.LobbyPlayer {
+	return &pb.LobbyPlayer{
+		PlayerId: player.PlayerID,
+		Role:     mapPlayerRoleToProto(player.Role),
+		Ready:    player.Ready,
+		Faces:    mapIntsToProto(player.Faces),
+	}
+}
+
+func mapSpectatorUpdateToProto(update actors.SpectatorUpdate) *pb.SessionEvent {
+	event := update.Event
+	protoEvent := &pb.SessionEvent{
+		Sequence: event.Sequence,
+		Type:     mapSessionEventTypeToProto(event.Type),
+		AtMs:     event.At.UnixMilli(),
+		Strike:   event.Strike,
+		Solved:   event.Solved,
+		Rule:     mapRuleExplanationToProto(event.Rule),
+		Session:  mapSessionSnapshotToProto(update.Session),
+	}
+
+	if event.Player.PlayerID != "" {
+		protoEvent.Player = mapLobbyPlayerToProto(event.Player)
+	}
+	switch event.Type {
+	case valueobject.SessionEventModuleInput:
+		protoEvent.BombId = event.BombID.String()
+		protoEvent.ModuleId = event.ModuleID.String()
+	case valueobject.SessionEventEdgeworkRevealed:
+		protoEvent.BombId = event.BombID.String()
+	}
+
+	return protoEvent
+}
+
+func mapSessionEventTypeToProto(eventType valueobject.SessionEventType) pb.SessionEvent_EventType {
+	switch eventType {
+	case valueobject.SessionEventWatching:
+		return pb.SessionEvent_WATCHING
+	case valueobject.SessionEventPlayerJoined:
+		return pb.SessionEvent_PLAYER_JOINED
+	case valueobject.SessionEventPlayerReady:
+		return pb.SessionEvent_PLAYER_READY
+	case valueobject.SessionEventGameStarted:
+		return pb.SessionEvent_GAME_STARTED
+	case valueobject.SessionEventGamePaused:
+		return pb.SessionEvent_GAME_PAUSED
+	case valueobject.SessionEventGameResumed:
+		return pb.SessionEvent_GAME_RESUMED
+	case valueobject.SessionEventModuleInput:
+		return pb.SessionEvent_MODULE_INPUT
+	case valueobject.SessionEventFacesAssigned:
+		return pb.SessionEvent_FACES_ASSIGNED
+	case valueobject.SessionEventEdgeworkRevealed:
+		return pb.SessionEvent_EDGEWORK_REVEALED
+	default:
+		return pb.SessionEvent_UNKNOWN
+	}
+}
+
+func mapPlayerRoleToProto(role valueobject.PlayerRole) pb.PlayerRole {
+	switch role {
+	case valueobject.PlayerRoleDefuser:
+		return pb.PlayerRole_DEFUSER
+	case valueobject.PlayerRoleExpert:
+		return pb.PlayerRole_EXPERT
+	case valueobject.PlayerRoleSpectator:
+		return pb.PlayerRole_SPECTATOR
+	default:
+		return pb.PlayerRole_ROLE_UNKNOWN
+	}
+}
+
+// Unknown roles map to the zero value so that validation rejects them.
+func mapProtoToPlayerRole(role pb.PlayerRole) valueobject.PlayerRole {
+	switch role {
+	case pb.PlayerRole_DEFUSER:
+		return valueobject.PlayerRoleDefuser
+	case pb.PlayerRole_EXPERT:
+		return valueobject.PlayerRoleExpert
+	case pb.PlayerRole_SPECTATOR:
+		return valueobject.PlayerRoleSpectator
+	default:
+		return 0
+	}
+}
+
+func mapProtoToBombMode(mode pb.BombMode) valueobject.BombMode {
+	switch mode {
+	case pb.BombMode_SEQUENTIAL:
+		return valueobject.BombModeSequential
+	default:
+		return valueobject.BombModeParallel
+	}
+}
+
+func mapBombModeToProto(mode valueobject.BombMode) pb.BombMode {
+	switch mode {
+	case valueobject.BombModeSequential:
+		return pb.BombMode_SEQUENTIAL
+	default:
+		return pb.BombMode_PARALLEL
+	}
+}
+
+func mapModuleSpecsToProto(specs []valueobject.ModuleSpec) []*pb.ModuleSpec {
+	protoSpecs := make([]*pb.ModuleSpec, len(specs))
+	for i, spec := range specs {
+		protoSpec := &pb.ModuleSpec{
+			Count: int32(spec.Count),
+		}
+
+		switch {
+		case len(spec.PossibleTypes) > 0:
+			protoSpec.PossibleTypes = make([]pb.Module_ModuleType, len(spec.PossibleTypes))
+			for j, moduleType := range spec.PossibleTypes {
+				protoSpec.PossibleTypes[j] = mapTypeToProto(moduleType)
+			}
+		case spec.Type == valueobject.RandomModule:
+			protoSpec.Random = true
+		default:
+			protoSpec.Type = mapTypeToProto(spec.Type)
+		}
+
+		protoSpecs[i] = protoSpec
+	}
+
+	return protoSpecs
+}
+
+// Maps a resolved bomb config back into the shape clients submit custom games in.
+func mapBombConfigToProto(config valueobject.BombConfig) *pb.CustomBombConfig {
+	rates := make([]float32, len(config.StrikeTimerRates))
+	for i, rate := range config.StrikeTimerRates {
+		rates[i] = float32(rate)
+	}
+
+	return &pb.CustomBombConfig{
+		TimerSeconds:      int32(config.Timer.Seconds()),
+		MaxStrikes:        int32(config.MaxStrikes),
+		NumFaces:          int32(config.NumFaces),
+		Rows:              int32(config.Rows),
+		Columns:           int32(config.Columns),
+		Modules:           mapModuleSpecsToProto(config.ExplicitModules),
+		MinModules:        int32(config.MinModules),
+		MaxModulesPerFace: int32(config.MaxModulesPerFace),
+		MinBatteries:      int32(config.MinBatteries),
+		MaxBatteries:      int32(config.MaxBatteries),
+		MaxIndicatorCount: int32(config.MaxIndicatorCount),
+		PortCount:         int32(config.PortCount),
+		StrikeTimerRates:  rates,
+	}
+}
+
+func mapDescribeConfigResultToProto(result *command.DescribeConfigResult) *pb.DescribeConfigResponse {
+	resp := &pb.DescribeConfigResponse{
+		Seed:             result.Seed,
+		BombMode:         mapBombModeToProto(result.BombMode),
+		GeneratorVersion: int32(result.GeneratorVersion),
+	}
+
+	for _, err := range result.ValidationErrors {
+		resp.ValidationErrors = append(resp.ValidationErrors, &pb.ConfigValidationError{
+			Field:   err.Field,
+			Message: err.Message,
+		})
+	}
+
+	for i, bomb := range result.Bombs {
+		planned := &pb.PlannedBomb{
+			Order:   int32(i),
+			Config:  mapBombConfigToProto(bomb.Config),
+			Modules: make([]*pb.PlannedModule, len(bomb.Modules)),
+			Code:    bomb.Code,
+		}
+		for j, module := range bomb.Modules {
+			planned.Modules[j] = &pb.PlannedModule{
+				Type: mapTypeToProto(module.Type),
+				Position: &pb.ModulePosition{
+					Face: int32(module.Position.Face),
+					Row:  int32(module.Position.Row),
+					Col:  int32(module.Position.Column),
+				},
+			}
+		}
+		resp.Bombs = append(resp.Bombs, planned)
+	}
+
+	return resp
+}
+
+func mapCreateGameResultToConfigInfo(result *command.CreateGameCommandResult) *pb.GeneratedConfigInfo {
+	config := result.Config
+	info := &pb.GeneratedConfigInfo{
+		Seed:             result.Seed,
+		TimerSeconds:     int32(config.Timer.Seconds()),
+		MaxStrikes:       int32(config.MaxStrikes),
+		NumFaces:         int32(config.NumFaces),
+		Rows:             int32(config.Rows),
+		Columns:          int32(config.Columns),
+		MissionName:      config.MissionName,
+		MissionSection:   int32(config.MissionSection),
+		NumBombs:         int32(result.NumBombs),
+		BombMode:         mapBombModeToProto(result.BombMode),
+		ConfigHash:       result.ConfigHash,
+		BombCodes:        result.BombCodes,
+		GeneratorVersion: int32(result.GeneratorVersion),
+	}
+
+	moduleTypes := make([]valueobject.ModuleType, 0, len(result.ModuleCounts))
+	for moduleType := range result.ModuleCounts {
+		moduleTypes = append(moduleTypes, moduleType)
+	}
+	sort.Slice(moduleTypes, func(i, j int) bool { return moduleTypes[i] < moduleTypes[j] })
+
+	for _, moduleType := range moduleTypes {
+		info.ModuleCounts = append(info.ModuleCounts, &pb.ModuleCount{
+			Type:  mapTypeToProto(moduleType),
+			Count: int32(result.ModuleCounts[moduleType]),
+		})
+	}
+
+	return info
+}
+
+func mapRuleExplanationToProto(rule *valueobject.RuleExplanation) *pb.RuleExplanation {
+	if rule == nil {
+		return nil
+	}
+
+	return &pb.RuleExplanation{
+		RuleId:      rule.RuleID,
+		Description: rule.Description,
+	}
+}
+
+func mapCatalogMissionToProto(mission valueobject.CatalogMission) *pb.MissionInfo {
+	def := mission.Definition
+	info := &pb.MissionInfo{
+		Id:           mission.ID,
+		Name:         def.Name,
+		PackId:       mission.PackID,
+		Section:      int32(def.Section),
+		TimerSeconds: int32(def.Timer.Seconds()),
+		MaxStrikes:   int32(def.MaxStrikes),
+		NumFaces:     int32(def.NumFaces),
+		Rows:         int32(def.Rows),
+		Columns:      int32(def.Columns),
+		NumBombs:     int32(max(def.NumBombs, 1)),
+		BombMode:     mapBombModeToProto(def.BombMode),
+		Modules:      mapModuleSpecsToProto(def.Modules),
+	}
+
+	if mission.IsBuiltIn() {
+		// The domain and proto Mission enums share the same values
+		info.Mission = pb.Mission(mission.Mission)
+	}
+
+	return info
+}
+
+func mapGameReportToProto(report valueobject.GameReport) *pb.GameReport {
+	resp := &pb.GameReport{
+		SessionId:  report.SessionID.String(),
+		State:      mapSessionStateToProto(report.State),
+		Difficulty: int32(report.Difficulty),
+		Score:      int32(report.Score),
+		Bombs:      make([]*pb.BombReport, len(report.Bombs)),
+	}
+
+	for i, bomb := range report.Bombs {
+		bombReport := &pb.BombReport{
+			BombId:          bomb.BombID.String(),
+			State:           mapBombStateToProto(bomb.State),
+			TimeRemainingMs: bomb.TimeRemaining.Milliseconds(),
+			Strikes:         int32(bomb.Strikes),
+			ModulesSolved:   int32(bomb.ModulesSolved),
+			ModulesTotal:    int32(bomb.ModulesTotal),
+			NeedyFailures:   int32(bomb.NeedyFailures),
+			Score:           int32(bomb.Score),
+			Modules:         make([]*pb.ModuleReport, len(bomb.Modules)),
+		}
+
+		for j, module := range bomb.Modules {
+			bombReport.Modules[j] = &pb.ModuleReport{
+				ModuleId: module.ModuleID.String(),
+				Type:     mapTypeToProto(module.Type),
+				Position: &pb.ModulePosition{
+					Face: int32(module.Position.Face),
+					Row:  int32(module.Position.Row),
+					Col:  int32(module.Position.Column),
+				},
+				FirstInteractionMs: durationToMillis(module.FirstInteraction),
+				SolvedAtMs:         durationToMillis(module.SolvedAt),
+				Strikes:            int32(module.Strikes),
+			}
+		}
+
+		resp.Bombs[i] = bombReport
+	}
+
+	for _, defuser := range report.Defusers {
+		resp.Defusers = append(resp.Defusers, &pb.DefuserReport{
+			PlayerId: defuser.PlayerID,
+			Strikes:  int32(defuser.Strikes),
+		})
+	}
+
+	return resp
+}
+
+func durationToMillis(d *time.Duration) *int64 {
+	if d == nil {
+		return nil
+	}
+
+	ms := d.Milliseconds()
+	return &ms
+}
+
+func mapLeaderboardEntryToProto(entry valueobject.LeaderboardEntry) *pb.LeaderboardEntry {
+	return &pb.LeaderboardEntry{
+		TeamName:        entry.TeamName,
+		TimeRemainingMs: entry.TimeRemaining.Milliseconds(),
+		Strikes:         int32(entry.Strikes),
+		Seed:            entry.Seed,
+		SessionId:       entry.SessionID.String(),
+		SubmittedAtMs:   entry.SubmittedAt.UnixMilli(),
+	}
+}
+
+func mapProtoToLeaderboardKey(req *pb.GetLeaderboardRequest) (valueobject.LeaderboardKey, error) {
+	switch key := req.GetLeaderboard().(type) {
+	case *pb.GetLeaderboardRequest_Level:
+		return valueobject.LevelLeaderboardKey(int(key.Level)), nil
+	case *pb.GetLeaderboardRequest_Mission:
+		return valueobject.MissionLeaderboardKey(protoMissionToDomain(key.Mission)), nil
+	case *pb.GetLeaderboardRequest_MissionId:
+		return valueobject.MissionPackLeaderboardKey(key.MissionId), nil
+	case *pb.GetLeaderboardRequest_ConfigHash:
+		return valueobject.ConfigLeaderboardKey(key.ConfigHash), nil
+	default:
+		return "", errors.New("leaderboard must be set")
+	}
+}
+
+func mapProtoToChallengePeriod(period pb.ChallengePeriod) valueobject.ChallengePeriod {
+	switch period {
+	case pb.ChallengePeriod_WEEKLY:
+		return valueobject.ChallengePeriodWeekly
+	default:
+		return valueobject.ChallengePeriodDaily
+	}
+}
+
+func mapChallengePeriodToProto(period valueobject.ChallengePeriod) pb.ChallengePeriod {
+	switch period {
+	case valueobject.ChallengePeriodWeekly:
+		return pb.ChallengePeriod_WEEKLY
+	default:
+		return pb.ChallengePeriod_DAILY
+	}
+}
+
+func mapChallengeToProto(challenge valueobject.Challenge) *pb.Challenge {
+	return &pb.Challenge{
+		Id:         challenge.ID,
+		Period:     mapChallengePeriodToProto(challenge.Period),
+		StartsAtMs: challenge.StartsAt.UnixMilli(),
+		EndsAtMs:   challenge.EndsAt.UnixMilli(),
+	}
+}
+
+func mapCreateMatchResultToProto(result *command.CreateMatchCommandResult) *pb.CreateMatchResponse {
+	resp := &pb.CreateMatchResponse{
+		MatchId:    result.MatchID.String(),
+		Teams:      result.Teams,
+		ConfigInfo: mapCreateGameResultToConfigInfo(result.Game),
+	}
+	return resp
+}
+
+func mapScoreboardToProto(scoreboard valueobject.Scoreboard) *pb.Scoreboard {
+	resp := &pb.Scoreboard{
+		MatchId:     scoreboard.MatchID.String(),
+		Teams:       make([]*pb.TeamProgress, 0, len(scoreboard.Teams)),
+		StartedAtMs: timeToMillis(scoreboard.StartedAt),
+		Winner:      scoreboard.Winner,
+		Finished:    scoreboard.Finished,
+	}
+	for _, team := range scoreboard.Teams {
+		resp.Teams = append(resp.Teams, &pb.TeamProgress{
+			Team:          team.Team,
+			State:         mapSessionStateToProto(team.State),
+			ModulesSolved: int32(team.ModulesSolved),
+			ModulesTotal:  int32(team.ModulesTotal),
+			Strikes:       int32(team.Strikes),
+			FinishedAtMs:  timeToMillis(team.FinishedAt),
+		})
+	}
+	return resp
+}
+
+func timeToMillis(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+
+	ms := t.UnixMilli()
+	return &ms
+}
@@ -0,0 +1,124 @@
+package grpc
+
+import (
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	pb "github.com/ZaneH/defuse.party-go/pkg/proto"
+)
+
+// How a modifier is exposed over gRPC. Modifiers that don't hide anything from players use
+// noProjection.
+type modifierMapping struct {
+	protoModifier pb.Modifier
+	// Hides what the modifier keeps from players
+	project bombProjector
+}
+
+// Changes a copy of the bomb before it's mapped. Slices and maps must be replaced rather
+// than changed in place, as the copy shares them with the snapshot.
+type bombProjector func(bomb *entities.BombSnapshot)
+
+// gRPC mapping for every modifier
+var modifierMappings = map[valueobject.Modifier]modifierMapping{
+	valueobject.ModifierHiddenStrikes: {
+		protoModifier: pb.Modifier_HIDDEN_STRIKES,
+		project: func(bomb *entities.BombSnapshot) {
+			if !bomb.StrikesHidden() {
+				return
+			}
+
+			bomb.StrikeCount = 0
+			bomb.MaxStrikes = 0
+			// Strikes speed up the clock, so its rate would give them away
+			bomb.TimerRate = 0
+		},
+	},
+	valueobject.ModifierHiddenTimer: {
+		protoModifier: pb.Modifier_HIDDEN_TIMER,
+		project: func(bomb *entities.BombSnapshot) {
+			if !bomb.TimerHidden() {
+				return
+			}
+
+			bomb.TimeLeft = 0
+			bomb.Timer = 0
+			bomb.TimerRate = 0
+			// The time left could be worked out from how long the timer is and when it started
+			bomb.TimerDuration = 0
+			bomb.StartedAt = nil
+			bomb.StoppedAt = nil
+		},
+	},
+	valueobject.ModifierEdgeworkOnRequest: {
+		protoModifier: pb.Modifier_EDGEWORK_ON_REQUEST,
+		project: func(bomb *entities.BombSnapshot) {
+			if bomb.EdgeworkRevealed {
+				return
+			}
+
+			bomb.SerialNumber = ""
+			bomb.Indicators = nil
+			bomb.Batteries = 0
+			bomb.BatteryHolders = nil
+			bomb.Ports = nil
+			bomb.PortPlates = nil
+		},
+	},
+	valueobject.ModifierOneStrikePerModule: {
+		protoModifier: pb.Modifier_ONE_STRIKE_PER_MODULE,
+		project:       noProjection,
+	},
+	valueobject.ModifierZen: {
+		protoModifier: pb.Modifier_ZEN,
+		project: func(bomb *entities.BombSnapshot) {
+			// The timer counts up, so there's no time limit to show
+			bomb.TimerDuration = 0
+			bomb.TimeLeft = 0
+		},
+	},
+	valueobject.ModifierTimeMode: {
+		protoModifier: pb.Modifier_TIME_MODE,
+		project:       noProjection,
+	},
+}
+
+// For modifiers that change how the game plays but not what players see
+func noProjection(*entities.BombSnapshot) {}
+
+// Runs the projection of each of the bomb's modifiers, in order, on a copy of the bomb.
+// Every message a bomb is sent to players in is mapped from the projected copy. Hidden
+// strikes and a hidden timer are shown once the bomb is finished.
+func projectBomb(bomb entities.BombSnapshot) entities.BombSnapshot {
+	for _, modifier := range bomb.Modifiers {
+		if mapping, ok := modifierMappings[modifier]; ok {
+			mapping.project(&bomb)
+		}
+	}
+	return bomb
+}
+
+func mapModifiersToProto(modifiers []valueobject.Modifier) []pb.Modifier {
+	protoModifiers := make([]pb.Modifier, 0, len(modifiers))
+	for _, modifier := range modifiers {
+		protoModifiers = append(protoModifiers, modifierMappings[modifier].protoModifier)
+	}
+	return protoModifiers
+}
+
+// Modifiers without a mapping come back as the zero modifier, which validation rejects.
+func mapProtoToModifiers(protoModifiers []pb.Modifier) []valueobject.Modifier {
+	var modifiers []valueobject.Modifier
+	for _, protoModifier := range protoModifiers {
+		modifiers = append(modifiers, protoModifierToDomain(protoModifier))
+	}
+	return modifiers
+}
+
+func protoModifierToDomain(protoModifier pb.Modifier) valueobject.Modifier {
+	for modifier, mapping := range modifierMappings {
+		if mapping.protoModifier == protoModifier {
+			return modifier
+		}
+	}
+	return 0
+}
@@ -0,0 +1,88 @@
+package grpc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/actors"
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/grpc"
+	pb "github.com/ZaneH/defuse.party-go/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+)
+
+// Fails when a modifier is added without a gRPC mapping.
+func TestModifierRegistry_EveryModifierIsMapped(t *testing.T) {
+	for modifier := valueobject.ModifierHiddenStrikes; modifier.IsValid(); modifier++ {
+		t.Run(modifier.String(), func(t *testing.T) {
+			assert.NoError(t, grpc.CheckModifierMapping(modifier))
+		})
+	}
+}
+
+func TestModifierRegistry_ProjectionHidesWhatModifiersKeepFromPlayers(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("projection")
+	bomb := entities.NewBomb(rng, valueobject.NewDefaultBombConfig())
+	bomb.SetModifiers([]valueobject.Modifier{valueobject.ModifierHiddenStrikes, valueobject.ModifierEdgeworkOnRequest})
+	// Keeps the bomb from counting as defused
+	assert.NoError(t, bomb.AddModule(entities.NewWiresModule(rng), valueobject.ModulePosition{}))
+	bomb.StartTimer(time.Now())
+	bomb.AddStrike()
+
+	// Act
+	snapshot := bomb.Snapshot(time.Now())
+	hidden := grpc.ProjectBomb(snapshot)
+	bomb.RevealEdgework()
+	revealed := grpc.ProjectBomb(bomb.Snapshot(time.Now()))
+
+	// Assert
+	assert.Zero(t, hidden.StrikeCount)
+	assert.Zero(t, hidden.MaxStrikes)
+	assert.Empty(t, hidden.SerialNumber)
+	assert.Empty(t, hidden.Ports)
+	assert.Equal(t, 1, snapshot.StrikeCount, "Projecting mustn't change the snapshot")
+	assert.Equal(t, bomb.SerialNumber, snapshot.SerialNumber, "Projecting mustn't change the snapshot")
+	assert.Greater(t, hidden.TimeLeft, time.Duration(0), "Only hidden fields should be left out")
+
+	assert.Zero(t, revealed.StrikeCount, "Strikes should stay hidden")
+	assert.Equal(t, bomb.SerialNumber, revealed.SerialNumber)
+	assert.Equal(t, bomb.Ports, revealed.Ports)
+}
+
+func TestModifierRegistry_HiddenTimerCantBeWorkedOut(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("projection")
+	bomb := entities.NewBomb(rng, valueobject.NewDefaultBombConfig())
+	bomb.SetModifiers([]valueobject.Modifier{valueobject.ModifierHiddenTimer})
+	bomb.MaxStrikes = 1
+	assert.NoError(t, bomb.AddModule(entities.NewWiresModule(rng), valueobject.ModulePosition{}))
+
+	armedAt := time.Now()
+	bomb.StartTimer(armedAt)
+	mapBomb := func(snapshot entities.BombSnapshot) *pb.Bomb {
+		return grpc.MapSessionSnapshotToProto(actors.SessionSnapshot{Bombs: []entities.BombSnapshot{snapshot}}).Bombs[0]
+	}
+
+	// Act
+	early := mapBomb(bomb.Snapshot(armedAt.Add(time.Second)))
+	late := mapBomb(bomb.Snapshot(armedAt.Add(time.Minute)))
+	bomb.AddStrike()
+	exploded := mapBomb(bomb.Snapshot(armedAt.Add(time.Minute)))
+
+	// Assert
+	assert.True(t, proto.Equal(early, late), "Nothing sent should change as the clock runs down")
+	assert.Zero(t, early.TimeLeftMs)
+	assert.Zero(t, early.TimerMs)
+	assert.Zero(t, early.TimerRate)
+	assert.Zero(t, early.TimerDuration, "The timer's length and start would give the time left away")
+	assert.Zero(t, early.StartedAt, "The timer's length and start would give the time left away")
+
+	assert.Equal(t, pb.BombState_EXPLODED, exploded.State)
+	assert.Positive(t, exploded.TimeLeftMs, "The timer should be shown once the bomb is finished")
+	assert.Positive(t, exploded.TimerDuration)
+	assert.Equal(t, int32(armedAt.Unix()), exploded.StartedAt)
+}
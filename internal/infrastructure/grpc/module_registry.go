@@ -0,0 +1,495 @@
+package grpc
+
+import (
+	"fmt"
+
+	"github.com/ZaneH/defuse.party-go/internal/application/command"
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	pb "github.com/ZaneH/defuse.party-go/pkg/proto"
+)
+
+// How a module type is exposed over gRPC. Every field must be set; modules without input,
+// state or result fields use noInput, noState and noResult.
+type moduleMapping struct {
+	protoType pb.Module_ModuleType
+	// Builds the command for a player's input
+	mapInput inputMapper
+	// Sets the module's state on the proto sent to clients
+	projectState stateProjector
+	// Sets the module-specific part of an input result
+	mapResult resultMapper
+}
+
+// Reports false if the input is meant for a different module type.
+type inputMapper func(base command.BaseModuleInputCommand, input *pb.PlayerInput) (cmd command.ModuleInputCommand, matched bool, err error)
+
+type stateProjector func(module entities.ModuleSnapshot, protoModule *pb.Module) error
+
+type resultMapper func(result command.ModuleInputCommandResult, protoResult *pb.PlayerInputResult) error
+
+// gRPC mapping for every module type in the domain's module registry
+var moduleMappings = map[valueobject.ModuleType]moduleMapping{
+	valueobject.ClockModule: {
+		protoType:    pb.Module_CLOCK,
+		mapInput:     noInput,
+		projectState: noState,
+		mapResult:    noResult,
+	},
+	valueobject.WiresModule: {
+		protoType: pb.Module_WIRES,
+		mapInput: onInput((*pb.PlayerInput).GetWiresInput, func(base command.BaseModuleInputCommand, input *pb.WiresInput) (command.ModuleInputCommand, error) {
+			return &command.WiresInputCommand{
+				BaseModuleInputCommand: base,
+				WirePosition:           int(input.WirePosition),
+			}, nil
+		}),
+		projectState: onState(func(state *entities.WiresState, _ entities.ModuleSnapshot, protoModule *pb.Module) error {
+			wires := make([]*pb.Wire, 0, len(state.Wires))
+			for _, wire := range state.Wires {
+				color, err := mapColorToProto(wire.WireColor)
+				if err != nil {
+					return err
+				}
+				wires = append(wires, &pb.Wire{
+					WireColor: color,
+					IsCut:     wire.IsCut,
+					Position:  int32(wire.Position),
+				})
+			}
+
+			protoModule.State = &pb.Module_WiresState{
+				WiresState: &pb.WiresState{
+					Wires: wires,
+				},
+			}
+			return nil
+		}),
+		mapResult: noResult,
+	},
+	valueobject.PasswordModule: {
+		protoType: pb.Module_PASSWORD,
+		mapInput: onInput((*pb.PlayerInput).GetPasswordInput, func(base command.BaseModuleInputCommand, input *pb.PasswordInput) (command.ModuleInputCommand, error) {
+			switch pi := input.Input.(type) {
+			case *pb.PasswordInput_LetterChange:
+				return &command.PasswordLetterChangeCommand{
+					BaseModuleInputCommand: base,
+					LetterIndex:            int(pi.LetterChange.LetterIndex),
+					Direction:              valueobject.IncrementDecrement(pi.LetterChange.Direction),
+				}, nil
+			case *pb.PasswordInput_Submit:
+				return &command.PasswordSubmitCommand{
+					BaseModuleInputCommand: base,
+				}, nil
+			default:
+				return nil, fmt.Errorf("unknown password input type: %T", pi)
+			}
+		}),
+		projectState: onState(func(state *entities.PasswordState, _ entities.ModuleSnapshot, protoModule *pb.Module) error {
+			protoModule.State = &pb.Module_PasswordState{
+				PasswordState: &pb.PasswordState{
+					Letters: state.CurrentGuess(),
+				},
+			}
+			return nil
+		}),
+		mapResult: onResult(func(result *command.PasswordCommandResult, protoResult *pb.PlayerInputResult) error {
+			protoResult.Result = &pb.PlayerInputResult_PasswordInputResult{
+				PasswordInputResult: &pb.PasswordInputResult{
+					PasswordState: &pb.PasswordState{
+						Letters: result.Letters,
+					},
+				},
+			}
+			return nil
+		}),
+	},
+	valueobject.BigButtonModule: {
+		protoType: pb.Module_BIG_BUTTON,
+		mapInput: onInput((*pb.PlayerInput).GetBigButtonInput, func(base command.BaseModuleInputCommand, input *pb.BigButtonInput) (command.ModuleInputCommand, error) {
+			return &command.BigButtonInputCommand{
+				BaseModuleInputCommand: base,
+				PressType:              mapProtoToPressType(input.PressType),
+				ReleaseTimestamp:       input.ReleaseTimestamp,
+			}, nil
+		}),
+		projectState: onState(func(state *entities.BigButtonState, _ entities.ModuleSnapshot, protoModule *pb.Module) error {
+			color, err := mapColorToProto(state.ButtonColor)
+			if err != nil {
+				return err
+			}
+
+			protoModule.State = &pb.Module_BigButtonState{
+				BigButtonState: &pb.BigButtonState{
+					ButtonColor: color,
+					Label:       state.Label,
+				},
+			}
+			return nil
+		}),
+		mapResult: onResult(func(result *command.BigButtonInputCommandResult, protoResult *pb.PlayerInputResult) error {
+			var color pb.Color
+			if result.StripColor != nil {
+				var err error
+				if color, err = mapColorToProto(*result.StripColor); err != nil {
+					return err
+				}
+			}
+
+			protoResult.Result = &pb.PlayerInputResult_BigButtonInputResult{
+				BigButtonInputResult: &pb.BigButtonInputResult{
+					StripColor: color,
+				},
+			}
+			return nil
+		}),
+	},
+	valueobject.SimonModule: {
+		protoType: pb.Module_SIMON,
+		mapInput: onInput((*pb.PlayerInput).GetSimonInput, func(base command.BaseModuleInputCommand, input *pb.SimonInput) (command.ModuleInputCommand, error) {
+			color, err := mapProtoToColor(input.Color)
+			if err != nil {
+				return nil, err
+			}
+
+			return &command.SimonInputCommand{
+				BaseModuleInputCommand: base,
+				Color:                  color,
+			}, nil
+		}),
+		projectState: onState(func(state *entities.SimonState, _ entities.ModuleSnapshot, protoModule *pb.Module) error {
+			sequence, err := mapColorsToProto(state.DisplaySequence)
+			if err != nil {
+				return err
+			}
+
+			protoModule.State = &pb.Module_SimonState{
+				SimonState: &pb.SimonState{
+					CurrentSequence: sequence,
+				},
+			}
+			return nil
+		}),
+		mapResult: onResult(func(result *command.SimonInputCommandResult, protoResult *pb.PlayerInputResult) error {
+			sequence, err := mapColorsToProto(result.DisplaySequence)
+			if err != nil {
+				return err
+			}
+
+			protoResult.Result = &pb.PlayerInputResult_SimonInputResult{
+				SimonInputResult: &pb.SimonInputResult{
+					DisplaySequence: sequence,
+					HasFinishedSeq:  result.HasFinishedSeq,
+				},
+			}
+			return nil
+		}),
+	},
+	valueobject.KeypadModule: {
+		protoType: pb.Module_KEYPAD,
+		mapInput: onInput((*pb.PlayerInput).GetKeypadInput, func(base command.BaseModuleInputCommand, input *pb.KeypadInput) (command.ModuleInputCommand, error) {
+			symbol, err := mapProtoToSymbol(input.Symbol)
+			if err != nil {
+				return nil, err
+			}
+
+			return &command.KeypadInputCommand{
+				BaseModuleInputCommand: base,
+				Symbol:                 symbol,
+			}, nil
+		}),
+		projectState: onState(func(state *entities.KeypadState, _ entities.ModuleSnapshot, protoModule *pb.Module) error {
+			keypadState, err := mapKeypadStateToProto(state.DisplayedSymbols, state.ActivatedSymbols)
+			if err != nil {
+				return err
+			}
+
+			protoModule.State = &pb.Module_KeypadState{
+				KeypadState: keypadState,
+			}
+			return nil
+		}),
+		mapResult: onResult(func(result *command.KeypadInputCommandResult, protoResult *pb.PlayerInputResult) error {
+			keypadState, err := mapKeypadStateToProto(result.DisplayedSymbols, result.ActivatedSymbols)
+			if err != nil {
+				return err
+			}
+
+			protoResult.Result = &pb.PlayerInputResult_KeypadInputResult{
+				KeypadInputResult: &pb.KeypadInputResult{
+					KeypadState: keypadState,
+				},
+			}
+			return nil
+		}),
+	},
+	valueobject.WhosOnFirstModule: {
+		protoType: pb.Module_WHOS_ON_FIRST,
+		mapInput: onInput((*pb.PlayerInput).GetWhosOnFirstInput, func(base command.BaseModuleInputCommand, input *pb.WhosOnFirstInput) (command.ModuleInputCommand, error) {
+			return &command.WhosOnFirstInputCommand{
+				BaseModuleInputCommand: base,
+				Word:                   input.Word,
+			}, nil
+		}),
+		projectState: onState(func(state *entities.WhosOnFirstState, _ entities.ModuleSnapshot, protoModule *pb.Module) error {
+			protoModule.State = &pb.Module_WhosOnFirstState{
+				WhosOnFirstState: &pb.WhosOnFirstState{
+					ScreenWord:  state.ScreenWord,
+					ButtonWords: state.ButtonWords,
+					Stage:       int32(state.Stage),
+				},
+			}
+			return nil
+		}),
+		mapResult: onResult(func(result *command.WhosOnFirstInputCommandResult, protoResult *pb.PlayerInputResult) error {
+			protoResult.Result = &pb.PlayerInputResult_WhosOnFirstInputResult{
+				WhosOnFirstInputResult: &pb.WhosOnFirstInputResult{
+					WhosOnFirstState: &pb.WhosOnFirstState{
+						ScreenWord:  result.ScreenWord,
+						ButtonWords: result.ButtonWords,
+						Stage:       int32(result.Stage),
+					},
+				},
+			}
+			return nil
+		}),
+	},
+	valueobject.MemoryModule: {
+		protoType: pb.Module_MEMORY,
+		mapInput: onInput((*pb.PlayerInput).GetMemoryInput, func(base command.BaseModuleInputCommand, input *pb.MemoryInput) (command.ModuleInputCommand, error) {
+			return &command.MemoryInputCommand{
+				BaseModuleInputCommand: base,
+				ButtonIndex:            int(input.ButtonIndex),
+			}, nil
+		}),
+		projectState: onState(func(state *entities.MemoryState, _ entities.ModuleSnapshot, protoModule *pb.Module) error {
+			protoModule.State = &pb.Module_MemoryState{
+				MemoryState: &pb.MemoryState{
+					ScreenNumber:     int32(state.ScreenNumber),
+					DisplayedNumbers: mapIntsToProto(state.DisplayedNumbers),
+					Stage:            int32(state.Stage),
+				},
+			}
+			return nil
+		}),
+		mapResult: onResult(func(result *command.MemoryInputCommandResult, protoResult *pb.PlayerInputResult) error {
+			protoResult.Result = &pb.PlayerInputResult_MemoryInputResult{
+				MemoryInputResult: &pb.MemoryInputResult{
+					MemoryState: &pb.MemoryState{
+						ScreenNumber:     int32(result.ScreenNumber),
+						DisplayedNumbers: mapIntsToProto(result.DisplayedNumbers),
+						Stage:            int32(result.Stage),
+					},
+				},
+			}
+			return nil
+		}),
+	},
+	valueobject.MorseModule: {
+		protoType: pb.Module_MORSE,
+		mapInput: onInput((*pb.PlayerInput).GetMorseInput, func(base command.BaseModuleInputCommand, input *pb.MorseInput) (command.ModuleInputCommand, error) {
+			switch mi := input.Input.(type) {
+			case *pb.MorseInput_FrequencyChange:
+				return &command.MorseChangeFrequencyCommand{
+					BaseModuleInputCommand: base,
+					Direction:              valueobject.IncrementDecrement(mi.FrequencyChange.Direction),
+				}, nil
+			case *pb.MorseInput_Tx:
+				return &command.MorseTxCommand{
+					BaseModuleInputCommand: base,
+				}, nil
+			default:
+				return nil, fmt.Errorf("unknown morse input type: %T", mi)
+			}
+		}),
+		projectState: onState(func(state *entities.MorseState, _ entities.ModuleSnapshot, protoModule *pb.Module) error {
+			protoModule.State = &pb.Module_MorseState{
+				MorseState: &pb.MorseState{
+					DisplayedPattern:       state.DisplayedPattern,
+					DisplayedFrequency:     state.DisplayedFrequency,
+					SelectedFrequencyIndex: int32(state.SelectedFrequencyIdx),
+				},
+			}
+			return nil
+		}),
+		mapResult: onResult(func(result *command.MorseCommandResult, protoResult *pb.PlayerInputResult) error {
+			protoResult.Result = &pb.PlayerInputResult_MorseInputResult{
+				MorseInputResult: &pb.MorseInputResult{
+					MorseState: &pb.MorseState{
+						DisplayedPattern:       result.DisplayedPattern,
+						DisplayedFrequency:     result.DisplayedFrequency,
+						SelectedFrequencyIndex: int32(result.SelectedFrequencyIdx),
+					},
+				},
+			}
+			return nil
+		}),
+	},
+	valueobject.NeedyVentGasModule: {
+		protoType: pb.Module_NEEDY_VENT_GAS,
+		mapInput: onInput((*pb.PlayerInput).GetNeedyVentGasInput, func(base command.BaseModuleInputCommand, input *pb.NeedyVentGasInput) (command.ModuleInputCommand, error) {
+			return &command.NeedyVentGasCommand{
+				BaseModuleInputCommand: base,
+				Input:                  input.Input,
+			}, nil
+		}),
+		projectState: onState(func(state *entities.NeedyVentGasState, module entities.ModuleSnapshot, protoModule *pb.Module) error {
+			protoModule.State = &pb.Module_NeedyVentGasState{
+				NeedyVentGasState: &pb.NeedyVentGasState{
+					DisplayedQuestion:    state.DisplayedQuestion,
+					CountdownStartedAt:   state.CountdownStartedAt,
+					CountdownDuration:    int32(state.CountdownDuration),
+					CountdownRemainingMs: module.CountdownRemaining.Milliseconds(),
+				},
+			}
+			return nil
+		}),
+		mapResult: onResult(func(result *command.NeedyVentGasCommandResult, protoResult *pb.PlayerInputResult) error {
+			protoResult.Result = &pb.PlayerInputResult_NeedyVentGasInputResult{
+				NeedyVentGasInputResult: &pb.NeedyVentGasInputResult{
+					NeedyVentGasState: &pb.NeedyVentGasState{
+						DisplayedQuestion:    result.DisplayedQuestion,
+						CountdownStartedAt:   result.CountdownStartedAt,
+						CountdownDuration:    int32(result.CountdownDuration),
+						CountdownRemainingMs: result.CountdownRemaining.Milliseconds(),
+					},
+				},
+			}
+			return nil
+		}),
+	},
+	valueobject.NeedyKnobModule: {
+		protoType: pb.Module_NEEDY_KNOB,
+		mapInput: onInput((*pb.PlayerInput).GetNeedyKnobInput, func(base command.BaseModuleInputCommand, input *pb.NeedyKnobInput) (command.ModuleInputCommand, error) {
+			return &command.NeedyKnobCommand{
+				BaseModuleInputCommand: base,
+			}, nil
+		}),
+		projectState: onState(func(state *entities.NeedyKnobState, module entities.ModuleSnapshot, protoModule *pb.Module) error {
+			protoModule.State = &pb.Module_NeedyKnobState{
+				NeedyKnobState: &pb.NeedyKnobState{
+					DisplayedPatternFirstRow:  state.DisplayedPattern[0],
+					DisplayedPatternSecondRow: state.DisplayedPattern[1],
+					CountdownStartedAt:        state.CountdownStartedAt,
+					CountdownDuration:         int32(state.CountdownDuration),
+					CountdownRemainingMs:      module.CountdownRemaining.Milliseconds(),
+				},
+			}
+			return nil
+		}),
+		mapResult: onResult(func(result *command.NeedyKnobCommandResult, protoResult *pb.PlayerInputResult) error {
+			protoResult.Result = &pb.PlayerInputResult_NeedyKnobInputResult{
+				NeedyKnobInputResult: &pb.NeedyKnobInputResult{
+					NeedyKnobState: &pb.NeedyKnobState{
+						DisplayedPatternFirstRow:  result.DisplayedPattern[0],
+						DisplayedPatternSecondRow: result.DisplayedPattern[1],
+						DialDirection:             pb.CardinalDirection(result.DialDirection),
+						CountdownStartedAt:        result.CoundownStartedAt,
+						CountdownDuration:         int32(result.CountdownDuration),
+						CountdownRemainingMs:      result.CountdownRemaining.Milliseconds(),
+					},
+				},
+			}
+			return nil
+		}),
+	},
+	valueobject.MazeModule: {
+		protoType: pb.Module_MAZE,
+		mapInput: onInput((*pb.PlayerInput).GetMazeInput, func(base command.BaseModuleInputCommand, input *pb.MazeInput) (command.ModuleInputCommand, error) {
+			return &command.MazeCommand{
+				BaseModuleInputCommand: base,
+				Direction:              valueobject.CardinalDirection(input.Direction),
+			}, nil
+		}),
+		projectState: onState(func(state *entities.MazeModuleState, _ entities.ModuleSnapshot, protoModule *pb.Module) error {
+			maze := state.VariantToMaze()
+			protoModule.State = &pb.Module_MazeState{
+				MazeState: &pb.MazeState{
+					Marker_1:       mapPoint2DToProto(maze.Marker1),
+					Marker_2:       mapPoint2DToProto(maze.Marker2),
+					PlayerPosition: mapPoint2DToProto(state.PlayerPosition),
+					GoalPosition:   mapPoint2DToProto(state.GoalPosition),
+				},
+			}
+			return nil
+		}),
+		mapResult: onResult(func(result *command.MazeInputCommandResult, protoResult *pb.PlayerInputResult) error {
+			protoResult.Result = &pb.PlayerInputResult_MazeInputResult{
+				MazeInputResult: &pb.MazeInputResult{
+					MazeState: &pb.MazeState{
+						PlayerPosition: mapPoint2DToProto(result.PlayerPosition),
+						GoalPosition:   mapPoint2DToProto(result.GoalPosition),
+					},
+				},
+			}
+			return nil
+		}),
+	},
+}
+
+// Adapts a mapper for one kind of input to the registry. The getter returns nil when the
+// player's input is for a different module type.
+func onInput[I comparable](get func(*pb.PlayerInput) I, mapInput func(base command.BaseModuleInputCommand, input I) (command.ModuleInputCommand, error)) inputMapper {
+	return func(base command.BaseModuleInputCommand, input *pb.PlayerInput) (command.ModuleInputCommand, bool, error) {
+		var none I
+		typed := get(input)
+		if typed == none {
+			return nil, false, nil
+		}
+
+		cmd, err := mapInput(base, typed)
+		return cmd, true, err
+	}
+}
+
+// Adapts a projector for one module's state to the registry
+func onState[S entities.ModuleState](project func(state S, module entities.ModuleSnapshot, protoModule *pb.Module) error) stateProjector {
+	return func(module entities.ModuleSnapshot, protoModule *pb.Module) error {
+		state, ok := module.State.(S)
+		if !ok {
+			return fmt.Errorf("expected %T but got %T", state, module.State)
+		}
+
+		return project(state, module, protoModule)
+	}
+}
+
+// Adapts a mapper for one module's input result to the registry
+func onResult[R command.ModuleInputCommandResult](mapResult func(result R, protoResult *pb.PlayerInputResult) error) resultMapper {
+	return func(result command.ModuleInputCommandResult, protoResult *pb.PlayerInputResult) error {
+		typed, ok := result.(R)
+		if !ok {
+			return fmt.Errorf("expected %T but got %T", typed, result)
+		}
+
+		return mapResult(typed, protoResult)
+	}
+}
+
+// For modules the player can't interact with, such as the clock
+func noInput(command.BaseModuleInputCommand, *pb.PlayerInput) (command.ModuleInputCommand, bool, error) {
+	return nil, false, nil
+}
+
+// For modules without state to show, such as the clock
+func noState(entities.ModuleSnapshot, *pb.Module) error {
+	return nil
+}
+
+// For modules whose results only report strikes and solves
+func noResult(command.ModuleInputCommandResult, *pb.PlayerInputResult) error {
+	return nil
+}
+
+// Finds the module type a player's input is for and builds its command.
+func mapInputToCommand(base command.BaseModuleInputCommand, input *pb.PlayerInput) (command.ModuleInputCommand, moduleMapping, error) {
+	for _, mapping := range moduleMappings {
+		cmd, matched, err := mapping.mapInput(base, input)
+		if !matched {
+			continue
+		}
+		return cmd, mapping, err
+	}
+
+	return nil, moduleMapping{}, fmt.Errorf("unknown input type: %T", input.GetInput())
+}
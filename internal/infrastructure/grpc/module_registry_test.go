@@ -0,0 +1,68 @@
+package grpc_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/actors"
+	"github.com/ZaneH/defuse.party-go/internal/domain/entities"
+	"github.com/ZaneH/defuse.party-go/internal/domain/services"
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/grpc"
+	"github.com/stretchr/testify/assert"
+)
+
+// Fails when a registered module type is missing its actor, gRPC mapping or a layout that
+// survives being shared.
+func TestModuleRegistry_EveryModuleTypeIsComplete(t *testing.T) {
+	for _, moduleType := range entities.RegisteredModuleTypes() {
+		t.Run(moduleType.String(), func(t *testing.T) {
+			// Arrange
+			rng := services.NewSeededRNGFromString("registry")
+			bomb := entities.NewBomb(rng, valueobject.NewDefaultBombConfig())
+			module, err := services.NewModuleFactory(rng).CreateModule(moduleType)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, moduleType, module.GetType())
+			assert.NoError(t, bomb.AddModule(module, valueobject.ModulePosition{}))
+
+			// Act
+			_, actorErr := actors.CreateModuleActor(bomb, module)
+			snapshot := bomb.Snapshot(time.Now())
+			layout, layoutErr := entities.ExportBombLayout(bomb)
+
+			// Assert
+			assert.NoError(t, actorErr, "Module type needs an actor")
+			if assert.Len(t, snapshot.Modules, 1) {
+				assert.NoError(t, grpc.CheckModuleMapping(moduleType, snapshot.Modules[0]), "Module type needs a complete gRPC mapping")
+			}
+			if assert.NoError(t, layoutErr, "Module type needs a layout export") {
+				rebuilt, err := entities.NewBombFromLayout(rng, layout)
+				if assert.NoError(t, err, "Exported layout should be accepted") {
+					relayout, err := entities.ExportBombLayout(rebuilt)
+					assert.NoError(t, err)
+					assert.Equal(t, layout, relayout, "Rebuilt module should export the same layout")
+				}
+			}
+		})
+	}
+}
+
+func TestModuleRegistry_UnmappableStateIsAnError(t *testing.T) {
+	// Arrange
+	rng := services.NewSeededRNGFromString("registry")
+	bomb := entities.NewBomb(rng, valueobject.NewDefaultBombConfig())
+	keypad := entities.NewKeypadModule(rng)
+	state := keypad.State
+	// Not in the proto yet
+	state.DisplayedSymbols = []valueobject.Symbol{valueobject.Teepee}
+	keypad.SetState(state)
+	assert.NoError(t, bomb.AddModule(keypad, valueobject.ModulePosition{}))
+
+	// Act
+	err := grpc.CheckModuleMapping(valueobject.KeypadModule, bomb.Snapshot(time.Now()).Modules[0])
+
+	// Assert
+	assert.ErrorContains(t, err, "Teepee")
+}
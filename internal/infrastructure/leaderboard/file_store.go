@@ -0,0 +1,95 @@
+package leaderboard
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/atomicfile"
+	"github.com/google/uuid"
+)
+
+// Keeps leaderboards in memory and writes every result to a JSON file so they survive
+// restarts.
+type FileStore struct {
+	mu      sync.RWMutex
+	path    string
+	entries []valueobject.LeaderboardEntry
+	// Sessions that already have a result
+	submitted map[uuid.UUID]bool
+}
+
+// Opens the leaderboard file at path, creating it on the first result if it doesn't exist.
+func NewFileStore(path string) (*FileStore, error) {
+	store := &FileStore{
+		path:      path,
+		submitted: make(map[uuid.UUID]bool),
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading leaderboard file: %w", err)
+	}
+
+	var entries []valueobject.LeaderboardEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("parsing leaderboard file %s: %w", path, err)
+	}
+
+	for _, entry := range entries {
+		if store.submitted[entry.SessionID] {
+			return nil, fmt.Errorf("leaderboard file %s: session %s: %w", path, entry.SessionID, valueobject.ErrResultAlreadySubmitted)
+		}
+		store.submitted[entry.SessionID] = true
+	}
+	store.entries = entries
+
+	return store, nil
+}
+
+func (s *FileStore) Add(entry valueobject.LeaderboardEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.submitted[entry.SessionID] {
+		return valueobject.ErrResultAlreadySubmitted
+	}
+
+	// Only kept once it's on disk, so memory never holds a result the file doesn't
+	entries := append(slices.Clip(s.entries), entry)
+	if err := save(s.path, entries); err != nil {
+		return err
+	}
+
+	s.entries = entries
+	s.submitted[entry.SessionID] = true
+	return nil
+}
+
+func (s *FileStore) Top(key valueobject.LeaderboardKey, limit int) ([]valueobject.LeaderboardEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return topEntries(s.entries, key, limit), nil
+}
+
+// Writes every entry to the leaderboard file in one go, so a crash never leaves a
+// half-written file behind.
+func save(path string, entries []valueobject.LeaderboardEntry) error {
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding leaderboard: %w", err)
+	}
+
+	if err := atomicfile.WriteFile(path, data); err != nil {
+		return fmt.Errorf("writing leaderboard file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package leaderboard_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/leaderboard"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore_PersistsRankedEntries(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "leaderboard.json")
+	key := valueobject.LevelLeaderboardKey(3)
+	submittedAt := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	slow := valueobject.LeaderboardEntry{
+		SessionID:     uuid.New(),
+		Leaderboard:   key,
+		TeamName:      "Slow",
+		TimeRemaining: 30 * time.Second,
+		Seed:          "a",
+		SubmittedAt:   submittedAt,
+	}
+	fast := valueobject.LeaderboardEntry{
+		SessionID:     uuid.New(),
+		Leaderboard:   key,
+		TeamName:      "Fast",
+		TimeRemaining: 90 * time.Second,
+		Strikes:       1,
+		Seed:          "b",
+		SubmittedAt:   submittedAt,
+	}
+	otherLevel := valueobject.LeaderboardEntry{
+		SessionID:   uuid.New(),
+		Leaderboard: valueobject.LevelLeaderboardKey(4),
+		TeamName:    "Elsewhere",
+		SubmittedAt: submittedAt,
+	}
+
+	store, err := leaderboard.NewFileStore(path)
+	assert.NoError(t, err)
+
+	// Act
+	for _, entry := range []valueobject.LeaderboardEntry{slow, fast, otherLevel} {
+		assert.NoError(t, store.Add(entry))
+	}
+	assert.ErrorIs(t, store.Add(slow), valueobject.ErrResultAlreadySubmitted)
+
+	reopened, err := leaderboard.NewFileStore(path)
+	assert.NoError(t, err)
+
+	// Assert
+	entries, err := reopened.Top(key, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []valueobject.LeaderboardEntry{fast, slow}, entries, "Entries should be ranked by time remaining")
+
+	entries, err = reopened.Top(key, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []valueobject.LeaderboardEntry{fast}, entries)
+
+	assert.ErrorIs(t, reopened.Add(fast), valueobject.ErrResultAlreadySubmitted, "Reloaded sessions should stay submitted")
+}
+
+func TestFileStore_KeepsNothingThatWasntSaved(t *testing.T) {
+	// Arrange: the leaderboard's directory doesn't exist yet, so saving fails
+	dir := filepath.Join(t.TempDir(), "missing")
+	key := valueobject.LevelLeaderboardKey(1)
+	entry := valueobject.LeaderboardEntry{SessionID: uuid.New(), Leaderboard: key, TeamName: "Team"}
+
+	store, err := leaderboard.NewFileStore(filepath.Join(dir, "leaderboard.json"))
+	assert.NoError(t, err)
+
+	// Act
+	addErr := store.Add(entry)
+	entries, err := store.Top(key, 0)
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.Mkdir(dir, 0o755))
+	retryErr := store.Add(entry)
+
+	// Assert
+	assert.Error(t, addErr)
+	assert.Empty(t, entries, "A result that couldn't be saved shouldn't be ranked")
+	assert.NoError(t, retryErr, "A result that couldn't be saved can be submitted again")
+}
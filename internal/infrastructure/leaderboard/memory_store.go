@@ -0,0 +1,64 @@
+package leaderboard
+
+import (
+	"sync"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/google/uuid"
+)
+
+// Keeps leaderboards in memory. Results are lost when the server stops.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	entries []valueobject.LeaderboardEntry
+	// Sessions that already have a result
+	submitted map[uuid.UUID]bool
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		submitted: make(map[uuid.UUID]bool),
+	}
+}
+
+func (s *MemoryStore) Add(entry valueobject.LeaderboardEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.add(entry)
+}
+
+func (s *MemoryStore) add(entry valueobject.LeaderboardEntry) error {
+	if s.submitted[entry.SessionID] {
+		return valueobject.ErrResultAlreadySubmitted
+	}
+
+	s.submitted[entry.SessionID] = true
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func (s *MemoryStore) Top(key valueobject.LeaderboardKey, limit int) ([]valueobject.LeaderboardEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return topEntries(s.entries, key, limit), nil
+}
+
+// Returns the best entries on the leaderboard, best first. A limit of 0 returns them all.
+func topEntries(all []valueobject.LeaderboardEntry, key valueobject.LeaderboardKey, limit int) []valueobject.LeaderboardEntry {
+	entries := make([]valueobject.LeaderboardEntry, 0)
+	for _, entry := range all {
+		if entry.Leaderboard == key {
+			entries = append(entries, entry)
+		}
+	}
+
+	valueobject.SortLeaderboardEntries(entries)
+
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	return entries
+}
@@ -0,0 +1,179 @@
+package missionpack
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"gopkg.in/yaml.v3"
+)
+
+// On-disk format of a mission pack. Each mission mirrors valueobject.MissionDefinition.
+type missionPackFile struct {
+	ID       string        `json:"id" yaml:"id"`
+	Name     string        `json:"name" yaml:"name"`
+	Missions []missionFile `json:"missions" yaml:"missions"`
+}
+
+type missionFile struct {
+	ID           string           `json:"id" yaml:"id"`
+	Name         string           `json:"name" yaml:"name"`
+	Section      int              `json:"section" yaml:"section"`
+	TimerSeconds int              `json:"timer_seconds" yaml:"timer_seconds"`
+	MaxStrikes   int              `json:"max_strikes" yaml:"max_strikes"`
+	NumFaces     int              `json:"num_faces" yaml:"num_faces"`
+	Rows         int              `json:"rows" yaml:"rows"`
+	Columns      int              `json:"columns" yaml:"columns"`
+	NumBombs     int              `json:"num_bombs" yaml:"num_bombs"`
+	BombMode     string           `json:"bomb_mode" yaml:"bomb_mode"`
+	Modules      []moduleSpecFile `json:"modules" yaml:"modules"`
+}
+
+type moduleSpecFile struct {
+	// Module type name (e.g. "wires", "big_button") or "random" for the section's pool
+	Type string `json:"type" yaml:"type"`
+	// One of these is picked at random. Takes precedence over Type.
+	PossibleTypes []string `json:"possible_types" yaml:"possible_types"`
+	Count         int      `json:"count" yaml:"count"`
+}
+
+// Loads every .yaml, .yml and .json mission pack in dir into the catalog. A pack that
+// fails to parse or validate is rejected as a whole.
+func LoadDir(dir string, catalog *valueobject.MissionCatalog) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("reading mission pack directory: %w", err)
+	}
+
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		switch strings.ToLower(filepath.Ext(entry.Name())) {
+		case ".yaml", ".yml", ".json":
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		count, err := LoadFile(file, catalog)
+		if err != nil {
+			return fmt.Errorf("loading mission pack %s: %w", filepath.Base(file), err)
+		}
+
+		log.Printf("loaded %d missions from %s", count, filepath.Base(file))
+	}
+
+	return nil
+}
+
+// Loads a single mission pack into the catalog and returns the number of missions loaded.
+func LoadFile(path string, catalog *valueobject.MissionCatalog) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	var pack missionPackFile
+	if strings.ToLower(filepath.Ext(path)) == ".json" {
+		err = json.Unmarshal(data, &pack)
+	} else {
+		err = yaml.Unmarshal(data, &pack)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("parsing: %w", err)
+	}
+
+	if strings.TrimSpace(pack.ID) == "" {
+		return 0, fmt.Errorf("pack is missing an id")
+	}
+
+	// Convert everything before registering so a bad mission doesn't leave the pack half-loaded
+	definitions := make([]valueobject.MissionDefinition, len(pack.Missions))
+	for i, mission := range pack.Missions {
+		def, err := mission.toDefinition()
+		if err != nil {
+			return 0, fmt.Errorf("mission %q: %w", mission.ID, err)
+		}
+
+		if errs := valueobject.ValidateMissionDefinition(def); errs.HasErrors() {
+			return 0, fmt.Errorf("mission %q: %w", mission.ID, errs)
+		}
+
+		definitions[i] = def
+	}
+
+	for i, def := range definitions {
+		if err := catalog.Register(pack.ID, pack.Missions[i].ID, def); err != nil {
+			return i, fmt.Errorf("mission %q: %w", pack.Missions[i].ID, err)
+		}
+	}
+
+	return len(definitions), nil
+}
+
+func (m missionFile) toDefinition() (valueobject.MissionDefinition, error) {
+	bombMode, err := parseBombMode(m.BombMode)
+	if err != nil {
+		return valueobject.MissionDefinition{}, err
+	}
+
+	modules := make([]valueobject.ModuleSpec, len(m.Modules))
+	for i, spec := range m.Modules {
+		moduleSpec := valueobject.ModuleSpec{
+			Count: spec.Count,
+		}
+
+		if len(spec.PossibleTypes) > 0 {
+			moduleSpec.PossibleTypes = make([]valueobject.ModuleType, len(spec.PossibleTypes))
+			for j, name := range spec.PossibleTypes {
+				moduleType, err := valueobject.ParseModuleType(name)
+				if err != nil {
+					return valueobject.MissionDefinition{}, err
+				}
+				moduleSpec.PossibleTypes[j] = moduleType
+			}
+		} else {
+			moduleType, err := valueobject.ParseModuleType(spec.Type)
+			if err != nil {
+				return valueobject.MissionDefinition{}, err
+			}
+			moduleSpec.Type = moduleType
+		}
+
+		modules[i] = moduleSpec
+	}
+
+	return valueobject.MissionDefinition{
+		Name:       m.Name,
+		Timer:      time.Duration(m.TimerSeconds) * time.Second,
+		MaxStrikes: m.MaxStrikes,
+		Modules:    modules,
+		NumFaces:   m.NumFaces,
+		Rows:       m.Rows,
+		Columns:    m.Columns,
+		Section:    m.Section,
+		NumBombs:   m.NumBombs,
+		BombMode:   bombMode,
+	}, nil
+}
+
+func parseBombMode(mode string) (valueobject.BombMode, error) {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "", "parallel":
+		return valueobject.BombModeParallel, nil
+	case "sequential":
+		return valueobject.BombModeSequential, nil
+	default:
+		return valueobject.BombModeParallel, fmt.Errorf("unknown bomb mode %q", mode)
+	}
+}
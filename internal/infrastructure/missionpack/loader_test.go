@@ -0,0 +1,185 @@
+package missionpack_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ZaneH/defuse.party-go/internal/domain/valueobject"
+	"github.com/ZaneH/defuse.party-go/internal/infrastructure/missionpack"
+	"github.com/stretchr/testify/assert"
+)
+
+const yamlPack = `
+id: community
+name: Community Pack
+missions:
+  - id: triple-threat
+    name: Triple Threat
+    section: 3
+    timer_seconds: 240
+    max_strikes: 2
+    num_faces: 1
+    rows: 2
+    columns: 3
+    num_bombs: 3
+    bomb_mode: sequential
+    modules:
+      - type: wires
+        count: 2
+      - possible_types: [password, morse]
+        count: 1
+      - type: random
+        count: 1
+`
+
+const jsonPack = `{
+  "id": "json-pack",
+  "missions": [
+    {
+      "id": "quick",
+      "name": "Quick",
+      "section": 1,
+      "timer_seconds": 60,
+      "max_strikes": 1,
+      "num_faces": 1,
+      "rows": 1,
+      "columns": 3,
+      "modules": [{"type": "keypad", "count": 2}]
+    }
+  ]
+}`
+
+func writePack(t *testing.T, dir string, name string, contents string) {
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(contents), 0o644); err != nil {
+		t.Fatalf("writing pack: %v", err)
+	}
+}
+
+func TestLoadDir_YAMLAndJSON(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	writePack(t, dir, "community.yaml", yamlPack)
+	writePack(t, dir, "quick.json", jsonPack)
+	writePack(t, dir, "README.md", "not a mission pack")
+	catalog := valueobject.NewMissionCatalog()
+
+	// Act
+	err := missionpack.LoadDir(dir, catalog)
+
+	// Assert
+	assert.NoError(t, err)
+
+	mission, err := catalog.Get("community/triple-threat")
+	assert.NoError(t, err)
+	assert.Equal(t, "Triple Threat", mission.Definition.Name)
+	assert.Equal(t, 4*time.Minute, mission.Definition.Timer)
+	assert.Equal(t, 3, mission.Definition.NumBombs)
+	assert.Equal(t, valueobject.BombModeSequential, mission.Definition.BombMode)
+	assert.Equal(t, []valueobject.ModuleType{valueobject.PasswordModule, valueobject.MorseModule}, mission.Definition.Modules[1].PossibleTypes)
+	assert.Equal(t, valueobject.RandomModule, mission.Definition.Modules[2].Type)
+
+	_, err = catalog.Get("json-pack/quick")
+	assert.NoError(t, err)
+
+	missions := catalog.List()
+	assert.Len(t, missions, len(valueobject.MissionDefinitions)+2)
+}
+
+func TestLoadFile_RejectsInvalidMission(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	writePack(t, dir, "bad.yaml", `
+id: bad
+missions:
+  - id: too-many
+    name: Too Many
+    section: 1
+    timer_seconds: 300
+    max_strikes: 3
+    num_faces: 1
+    rows: 1
+    columns: 2
+    modules:
+      - type: wires
+        count: 5
+  - id: fine
+    name: Fine
+    section: 1
+    timer_seconds: 300
+    max_strikes: 3
+    num_faces: 1
+    rows: 2
+    columns: 3
+    modules:
+      - type: wires
+        count: 1
+`)
+	catalog := valueobject.NewMissionCatalog()
+
+	// Act
+	_, err := missionpack.LoadFile(filepath.Join(dir, "bad.yaml"), catalog)
+
+	// Assert
+	assert.Error(t, err, "5 modules plus the clock can't fit in a 1x2 grid")
+	_, err = catalog.Get("bad/fine")
+	assert.ErrorIs(t, err, valueobject.ErrUnknownMission, "A rejected pack shouldn't be partially loaded")
+}
+
+func TestLoadFile_RejectsUnknownModuleType(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	writePack(t, dir, "typo.yaml", `
+id: typo
+missions:
+  - id: typo
+    name: Typo
+    section: 1
+    timer_seconds: 300
+    max_strikes: 3
+    num_faces: 1
+    rows: 2
+    columns: 3
+    modules:
+      - type: wirez
+        count: 1
+`)
+	catalog := valueobject.NewMissionCatalog()
+
+	// Act
+	_, err := missionpack.LoadFile(filepath.Join(dir, "typo.yaml"), catalog)
+
+	// Assert
+	assert.ErrorContains(t, err, "wirez")
+}
+
+func TestLoadFile_RejectsBuiltInPackID(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	writePack(t, dir, "builtin.yaml", `
+id: builtin
+missions:
+  - id: the-first-bomb
+    name: The First Bomb
+    section: 1
+    timer_seconds: 300
+    max_strikes: 3
+    num_faces: 1
+    rows: 2
+    columns: 3
+    modules:
+      - type: wires
+        count: 1
+`)
+	catalog := valueobject.NewMissionCatalog()
+
+	// Act
+	_, err := missionpack.LoadFile(filepath.Join(dir, "builtin.yaml"), catalog)
+
+	// Assert
+	assert.ErrorContains(t, err, "reserved")
+	mission, getErr := catalog.Get("builtin/the-first-bomb")
+	assert.NoError(t, getErr)
+	assert.True(t, mission.IsBuiltIn(), "The built-in mission should still be the one selected")
+}
@@ -21,14 +21,72 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BombState int32
+
+const (
+	// Waiting for the previous bomb to be defused
+	BombState_WAITING  BombState = 0
+	BombState_ARMED    BombState = 1
+	BombState_DEFUSED  BombState = 2
+	BombState_EXPLODED BombState = 3
+	// Armed, but the session is paused
+	BombState_PAUSED BombState = 4
+)
+
+// Enum value maps for BombState.
+var (
+	BombState_name = map[int32]string{
+		0: "WAITING",
+		1: "ARMED",
+		2: "DEFUSED",
+		3: "EXPLODED",
+		4: "PAUSED",
+	}
+	BombState_value = map[string]int32{
+		"WAITING":  0,
+		"ARMED":    1,
+		"DEFUSED":  2,
+		"EXPLODED": 3,
+		"PAUSED":   4,
+	}
+)
+
+func (x BombState) Enum() *BombState {
+	p := new(BombState)
+	*p = x
+	return p
+}
+
+func (x BombState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BombState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_bomb_proto_enumTypes[0].Descriptor()
+}
+
+func (BombState) Type() protoreflect.EnumType {
+	return &file_proto_bomb_proto_enumTypes[0]
+}
+
+func (x BombState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BombState.Descriptor instead.
+func (BombState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_bomb_proto_rawDescGZIP(), []int{0}
+}
+
 type Port int32
 
 const (
-	Port_DVID   Port = 0
-	Port_RCA    Port = 1
-	Port_PS2    Port = 2
-	Port_RJ45   Port = 3
-	Port_SERIAL Port = 4
+	Port_DVID     Port = 0
+	Port_RCA      Port = 1
+	Port_PS2      Port = 2
+	Port_RJ45     Port = 3
+	Port_SERIAL   Port = 4
+	Port_PARALLEL Port = 5
 )
 
 // Enum value maps for Port.
@@ -39,13 +97,15 @@ var (
 		2: "PS2",
 		3: "RJ45",
 		4: "SERIAL",
+		5: "PARALLEL",
 	}
 	Port_value = map[string]int32{
-		"DVID":   0,
-		"RCA":    1,
-		"PS2":    2,
-		"RJ45":   3,
-		"SERIAL": 4,
+		"DVID":     0,
+		"RCA":      1,
+		"PS2":      2,
+		"RJ45":     3,
+		"SERIAL":   4,
+		"PARALLEL": 5,
 	}
 )
 
@@ -60,11 +120,11 @@ func (x Port) String() string {
 }
 
 func (Port) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_bomb_proto_enumTypes[0].Descriptor()
+	return file_proto_bomb_proto_enumTypes[1].Descriptor()
 }
 
 func (Port) Type() protoreflect.EnumType {
-	return &file_proto_bomb_proto_enumTypes[0]
+	return &file_proto_bomb_proto_enumTypes[1]
 }
 
 func (x Port) Number() protoreflect.EnumNumber {
@@ -73,7 +133,55 @@ func (x Port) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Port.Descriptor instead.
 func (Port) EnumDescriptor() ([]byte, []int) {
-	return file_proto_bomb_proto_rawDescGZIP(), []int{0}
+	return file_proto_bomb_proto_rawDescGZIP(), []int{1}
+}
+
+type BatteryType int32
+
+const (
+	// Holds two AA batteries
+	BatteryType_AA BatteryType = 0
+	// Holds a single D battery
+	BatteryType_D BatteryType = 1
+)
+
+// Enum value maps for BatteryType.
+var (
+	BatteryType_name = map[int32]string{
+		0: "AA",
+		1: "D",
+	}
+	BatteryType_value = map[string]int32{
+		"AA": 0,
+		"D":  1,
+	}
+)
+
+func (x BatteryType) Enum() *BatteryType {
+	p := new(BatteryType)
+	*p = x
+	return p
+}
+
+func (x BatteryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatteryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_bomb_proto_enumTypes[2].Descriptor()
+}
+
+func (BatteryType) Type() protoreflect.EnumType {
+	return &file_proto_bomb_proto_enumTypes[2]
+}
+
+func (x BatteryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BatteryType.Descriptor instead.
+func (BatteryType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_bomb_proto_rawDescGZIP(), []int{2}
 }
 
 type Bomb struct {
@@ -86,8 +194,27 @@ type Bomb struct {
 	MaxStrikes    int32                  `protobuf:"varint,6,opt,name=max_strikes,json=maxStrikes,proto3" json:"max_strikes,omitempty"`
 	Modules       map[string]*Module     `protobuf:"bytes,7,rep,name=modules,proto3" json:"modules,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	Indicators    map[string]*Indicator  `protobuf:"bytes,8,rep,name=indicators,proto3" json:"indicators,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Batteries     int32                  `protobuf:"varint,9,opt,name=batteries,proto3" json:"batteries,omitempty"`
-	Ports         []Port                 `protobuf:"varint,10,rep,packed,name=ports,proto3,enum=bomb.Port" json:"ports,omitempty"`
+	// Total number of batteries across every holder
+	Batteries int32 `protobuf:"varint,9,opt,name=batteries,proto3" json:"batteries,omitempty"`
+	// Every port across all port plates
+	Ports []Port `protobuf:"varint,10,rep,packed,name=ports,proto3,enum=bomb.Port" json:"ports,omitempty"`
+	// Speed the clock is counting down at (1.0 is real time, faster after strikes)
+	TimerRate float32 `protobuf:"fixed32,11,opt,name=timer_rate,json=timerRate,proto3" json:"timer_rate,omitempty"`
+	// Time left on the clock when this message was created
+	TimeLeftMs int64     `protobuf:"varint,12,opt,name=time_left_ms,json=timeLeftMs,proto3" json:"time_left_ms,omitempty"`
+	State      BombState `protobuf:"varint,13,opt,name=state,proto3,enum=bomb.BombState" json:"state,omitempty"`
+	// Position of the bomb in the session (sequential sessions arm bombs in this order)
+	Order         int32 `protobuf:"varint,14,opt,name=order,proto3" json:"order,omitempty"`
+	ModulesSolved int32 `protobuf:"varint,15,opt,name=modules_solved,json=modulesSolved,proto3" json:"modules_solved,omitempty"`
+	// Number of modules that must be solved to defuse the bomb (excludes needy modules)
+	ModulesTotal   int32            `protobuf:"varint,16,opt,name=modules_total,json=modulesTotal,proto3" json:"modules_total,omitempty"`
+	PortPlates     []*PortPlate     `protobuf:"bytes,17,rep,name=port_plates,json=portPlates,proto3" json:"port_plates,omitempty"`
+	BatteryHolders []*BatteryHolder `protobuf:"bytes,18,rep,name=battery_holders,json=batteryHolders,proto3" json:"battery_holders,omitempty"`
+	// Rules the bomb is played with. Fields a modifier hides are left unset.
+	Modifiers []Modifier `protobuf:"varint,19,rep,packed,name=modifiers,proto3,enum=game_config.Modifier" json:"modifiers,omitempty"`
+	// What the bomb's timer shows. Same as time_left_ms unless a modifier changes
+	// it: zen bombs count up.
+	TimerMs       int64 `protobuf:"varint,20,opt,name=timer_ms,json=timerMs,proto3" json:"timer_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -192,6 +319,76 @@ func (x *Bomb) GetPorts() []Port {
 	return nil
 }
 
+func (x *Bomb) GetTimerRate() float32 {
+	if x != nil {
+		return x.TimerRate
+	}
+	return 0
+}
+
+func (x *Bomb) GetTimeLeftMs() int64 {
+	if x != nil {
+		return x.TimeLeftMs
+	}
+	return 0
+}
+
+func (x *Bomb) GetState() BombState {
+	if x != nil {
+		return x.State
+	}
+	return BombState_WAITING
+}
+
+func (x *Bomb) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *Bomb) GetModulesSolved() int32 {
+	if x != nil {
+		return x.ModulesSolved
+	}
+	return 0
+}
+
+func (x *Bomb) GetModulesTotal() int32 {
+	if x != nil {
+		return x.ModulesTotal
+	}
+	return 0
+}
+
+func (x *Bomb) GetPortPlates() []*PortPlate {
+	if x != nil {
+		return x.PortPlates
+	}
+	return nil
+}
+
+func (x *Bomb) GetBatteryHolders() []*BatteryHolder {
+	if x != nil {
+		return x.BatteryHolders
+	}
+	return nil
+}
+
+func (x *Bomb) GetModifiers() []Modifier {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+func (x *Bomb) GetTimerMs() int64 {
+	if x != nil {
+		return x.TimerMs
+	}
+	return 0
+}
+
 type Indicator struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
@@ -244,11 +441,109 @@ func (x *Indicator) GetLit() bool {
 	return false
 }
 
+// A plate can be empty. DVI-D, PS/2, RJ-45 and Stereo RCA share one layout,
+// Parallel and Serial the other.
+type PortPlate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ports         []Port                 `protobuf:"varint,1,rep,packed,name=ports,proto3,enum=bomb.Port" json:"ports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortPlate) Reset() {
+	*x = PortPlate{}
+	mi := &file_proto_bomb_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PortPlate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortPlate) ProtoMessage() {}
+
+func (x *PortPlate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bomb_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortPlate.ProtoReflect.Descriptor instead.
+func (*PortPlate) Descriptor() ([]byte, []int) {
+	return file_proto_bomb_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PortPlate) GetPorts() []Port {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+type BatteryHolder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          BatteryType            `protobuf:"varint,1,opt,name=type,proto3,enum=bomb.BatteryType" json:"type,omitempty"`
+	Batteries     int32                  `protobuf:"varint,2,opt,name=batteries,proto3" json:"batteries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatteryHolder) Reset() {
+	*x = BatteryHolder{}
+	mi := &file_proto_bomb_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatteryHolder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatteryHolder) ProtoMessage() {}
+
+func (x *BatteryHolder) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_bomb_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatteryHolder.ProtoReflect.Descriptor instead.
+func (*BatteryHolder) Descriptor() ([]byte, []int) {
+	return file_proto_bomb_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatteryHolder) GetType() BatteryType {
+	if x != nil {
+		return x.Type
+	}
+	return BatteryType_AA
+}
+
+func (x *BatteryHolder) GetBatteries() int32 {
+	if x != nil {
+		return x.Batteries
+	}
+	return 0
+}
+
 var File_proto_bomb_proto protoreflect.FileDescriptor
 
 const file_proto_bomb_proto_rawDesc = "" +
 	"\n" +
-	"\x10proto/bomb.proto\x12\x04bomb\x1a\x13proto/modules.proto\"\x91\x04\n" +
+	"\x10proto/bomb.proto\x12\x04bomb\x1a\x13proto/modules.proto\x1a\x17proto/game_config.proto\"\x9b\a\n" +
 	"\x04Bomb\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
 	"\rserial_number\x18\x02 \x01(\tR\fserialNumber\x12%\n" +
@@ -265,7 +560,20 @@ const file_proto_bomb_proto_rawDesc = "" +
 	"\tbatteries\x18\t \x01(\x05R\tbatteries\x12 \n" +
 	"\x05ports\x18\n" +
 	" \x03(\x0e2\n" +
-	".bomb.PortR\x05ports\x1aK\n" +
+	".bomb.PortR\x05ports\x12\x1d\n" +
+	"\n" +
+	"timer_rate\x18\v \x01(\x02R\ttimerRate\x12 \n" +
+	"\ftime_left_ms\x18\f \x01(\x03R\n" +
+	"timeLeftMs\x12%\n" +
+	"\x05state\x18\r \x01(\x0e2\x0f.bomb.BombStateR\x05state\x12\x14\n" +
+	"\x05order\x18\x0e \x01(\x05R\x05order\x12%\n" +
+	"\x0emodules_solved\x18\x0f \x01(\x05R\rmodulesSolved\x12#\n" +
+	"\rmodules_total\x18\x10 \x01(\x05R\fmodulesTotal\x120\n" +
+	"\vport_plates\x18\x11 \x03(\v2\x0f.bomb.PortPlateR\n" +
+	"portPlates\x12<\n" +
+	"\x0fbattery_holders\x18\x12 \x03(\v2\x13.bomb.BatteryHolderR\x0ebatteryHolders\x123\n" +
+	"\tmodifiers\x18\x13 \x03(\x0e2\x15.game_config.ModifierR\tmodifiers\x12\x19\n" +
+	"\btimer_ms\x18\x14 \x01(\x03R\atimerMs\x1aK\n" +
 	"\fModulesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12%\n" +
 	"\x05value\x18\x02 \x01(\v2\x0f.modules.ModuleR\x05value:\x028\x01\x1aN\n" +
@@ -274,14 +582,31 @@ const file_proto_bomb_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2\x0f.bomb.IndicatorR\x05value:\x028\x01\"3\n" +
 	"\tIndicator\x12\x14\n" +
 	"\x05label\x18\x01 \x01(\tR\x05label\x12\x10\n" +
-	"\x03lit\x18\x02 \x01(\bR\x03lit*8\n" +
+	"\x03lit\x18\x02 \x01(\bR\x03lit\"-\n" +
+	"\tPortPlate\x12 \n" +
+	"\x05ports\x18\x01 \x03(\x0e2\n" +
+	".bomb.PortR\x05ports\"T\n" +
+	"\rBatteryHolder\x12%\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x11.bomb.BatteryTypeR\x04type\x12\x1c\n" +
+	"\tbatteries\x18\x02 \x01(\x05R\tbatteries*J\n" +
+	"\tBombState\x12\v\n" +
+	"\aWAITING\x10\x00\x12\t\n" +
+	"\x05ARMED\x10\x01\x12\v\n" +
+	"\aDEFUSED\x10\x02\x12\f\n" +
+	"\bEXPLODED\x10\x03\x12\n" +
+	"\n" +
+	"\x06PAUSED\x10\x04*F\n" +
 	"\x04Port\x12\b\n" +
 	"\x04DVID\x10\x00\x12\a\n" +
 	"\x03RCA\x10\x01\x12\a\n" +
 	"\x03PS2\x10\x02\x12\b\n" +
 	"\x04RJ45\x10\x03\x12\n" +
 	"\n" +
-	"\x06SERIAL\x10\x04B\tZ\a./protob\x06proto3"
+	"\x06SERIAL\x10\x04\x12\f\n" +
+	"\bPARALLEL\x10\x05*\x1c\n" +
+	"\vBatteryType\x12\x06\n" +
+	"\x02AA\x10\x00\x12\x05\n" +
+	"\x01D\x10\x01B\tZ\a./protob\x06proto3"
 
 var (
 	file_proto_bomb_proto_rawDescOnce sync.Once
@@ -295,27 +620,38 @@ func file_proto_bomb_proto_rawDescGZIP() []byte {
 	return file_proto_bomb_proto_rawDescData
 }
 
-var file_proto_bomb_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_bomb_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_bomb_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_bomb_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_proto_bomb_proto_goTypes = []any{
-	(Port)(0),         // 0: bomb.Port
-	(*Bomb)(nil),      // 1: bomb.Bomb
-	(*Indicator)(nil), // 2: bomb.Indicator
-	nil,               // 3: bomb.Bomb.ModulesEntry
-	nil,               // 4: bomb.Bomb.IndicatorsEntry
-	(*Module)(nil),    // 5: modules.Module
+	(BombState)(0),        // 0: bomb.BombState
+	(Port)(0),             // 1: bomb.Port
+	(BatteryType)(0),      // 2: bomb.BatteryType
+	(*Bomb)(nil),          // 3: bomb.Bomb
+	(*Indicator)(nil),     // 4: bomb.Indicator
+	(*PortPlate)(nil),     // 5: bomb.PortPlate
+	(*BatteryHolder)(nil), // 6: bomb.BatteryHolder
+	nil,                   // 7: bomb.Bomb.ModulesEntry
+	nil,                   // 8: bomb.Bomb.IndicatorsEntry
+	(Modifier)(0),         // 9: game_config.Modifier
+	(*Module)(nil),        // 10: modules.Module
 }
 var file_proto_bomb_proto_depIdxs = []int32{
-	3, // 0: bomb.Bomb.modules:type_name -> bomb.Bomb.ModulesEntry
-	4, // 1: bomb.Bomb.indicators:type_name -> bomb.Bomb.IndicatorsEntry
-	0, // 2: bomb.Bomb.ports:type_name -> bomb.Port
-	5, // 3: bomb.Bomb.ModulesEntry.value:type_name -> modules.Module
-	2, // 4: bomb.Bomb.IndicatorsEntry.value:type_name -> bomb.Indicator
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	7,  // 0: bomb.Bomb.modules:type_name -> bomb.Bomb.ModulesEntry
+	8,  // 1: bomb.Bomb.indicators:type_name -> bomb.Bomb.IndicatorsEntry
+	1,  // 2: bomb.Bomb.ports:type_name -> bomb.Port
+	0,  // 3: bomb.Bomb.state:type_name -> bomb.BombState
+	5,  // 4: bomb.Bomb.port_plates:type_name -> bomb.PortPlate
+	6,  // 5: bomb.Bomb.battery_holders:type_name -> bomb.BatteryHolder
+	9,  // 6: bomb.Bomb.modifiers:type_name -> game_config.Modifier
+	1,  // 7: bomb.PortPlate.ports:type_name -> bomb.Port
+	2,  // 8: bomb.BatteryHolder.type:type_name -> bomb.BatteryType
+	10, // 9: bomb.Bomb.ModulesEntry.value:type_name -> modules.Module
+	4,  // 10: bomb.Bomb.IndicatorsEntry.value:type_name -> bomb.Indicator
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_proto_bomb_proto_init() }
@@ -324,13 +660,14 @@ func file_proto_bomb_proto_init() {
 		return
 	}
 	file_proto_modules_proto_init()
+	file_proto_game_config_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_bomb_proto_rawDesc), len(file_proto_bomb_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   4,
+			NumEnums:      3,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
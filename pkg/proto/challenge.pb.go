@@ -0,0 +1,305 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: proto/challenge.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetDailyChallengeRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Period ChallengePeriod        `protobuf:"varint,1,opt,name=period,proto3,enum=game_config.ChallengePeriod" json:"period,omitempty"`
+	// Day to look up (YYYY-MM-DD, UTC). Defaults to today. Challenges that
+	// haven't started yet aren't revealed.
+	Date          string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDailyChallengeRequest) Reset() {
+	*x = GetDailyChallengeRequest{}
+	mi := &file_proto_challenge_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDailyChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDailyChallengeRequest) ProtoMessage() {}
+
+func (x *GetDailyChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_challenge_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDailyChallengeRequest.ProtoReflect.Descriptor instead.
+func (*GetDailyChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_challenge_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetDailyChallengeRequest) GetPeriod() ChallengePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return ChallengePeriod_DAILY
+}
+
+func (x *GetDailyChallengeRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// Everyone attempting the challenge plays the same bomb. Its seed stays on the
+// server so the bomb can't be generated ahead of an attempt.
+type Challenge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "daily:2006-01-02" or "weekly:2006-W01"
+	Id     string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Period ChallengePeriod `protobuf:"varint,2,opt,name=period,proto3,enum=game_config.ChallengePeriod" json:"period,omitempty"`
+	// Unix time in milliseconds
+	StartsAtMs    int64 `protobuf:"varint,3,opt,name=starts_at_ms,json=startsAtMs,proto3" json:"starts_at_ms,omitempty"`
+	EndsAtMs      int64 `protobuf:"varint,4,opt,name=ends_at_ms,json=endsAtMs,proto3" json:"ends_at_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Challenge) Reset() {
+	*x = Challenge{}
+	mi := &file_proto_challenge_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Challenge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Challenge) ProtoMessage() {}
+
+func (x *Challenge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_challenge_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Challenge.ProtoReflect.Descriptor instead.
+func (*Challenge) Descriptor() ([]byte, []int) {
+	return file_proto_challenge_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Challenge) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Challenge) GetPeriod() ChallengePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return ChallengePeriod_DAILY
+}
+
+func (x *Challenge) GetStartsAtMs() int64 {
+	if x != nil {
+		return x.StartsAtMs
+	}
+	return 0
+}
+
+func (x *Challenge) GetEndsAtMs() int64 {
+	if x != nil {
+		return x.EndsAtMs
+	}
+	return 0
+}
+
+type RegisterChallengePlayerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterChallengePlayerRequest) Reset() {
+	*x = RegisterChallengePlayerRequest{}
+	mi := &file_proto_challenge_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterChallengePlayerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterChallengePlayerRequest) ProtoMessage() {}
+
+func (x *RegisterChallengePlayerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_challenge_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterChallengePlayerRequest.ProtoReflect.Descriptor instead.
+func (*RegisterChallengePlayerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_challenge_proto_rawDescGZIP(), []int{2}
+}
+
+type ChallengePlayer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Signed by the server. Challenges only accept player IDs issued here, so a
+	// player's attempt can't be used up by anyone who doesn't have their ID. Each
+	// client is only issued a few IDs a day.
+	PlayerId      string `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChallengePlayer) Reset() {
+	*x = ChallengePlayer{}
+	mi := &file_proto_challenge_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChallengePlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengePlayer) ProtoMessage() {}
+
+func (x *ChallengePlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_challenge_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengePlayer.ProtoReflect.Descriptor instead.
+func (*ChallengePlayer) Descriptor() ([]byte, []int) {
+	return file_proto_challenge_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ChallengePlayer) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+var File_proto_challenge_proto protoreflect.FileDescriptor
+
+const file_proto_challenge_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/challenge.proto\x12\tchallenge\x1a\x17proto/game_config.proto\"d\n" +
+	"\x18GetDailyChallengeRequest\x124\n" +
+	"\x06period\x18\x01 \x01(\x0e2\x1c.game_config.ChallengePeriodR\x06period\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04date\"\x91\x01\n" +
+	"\tChallenge\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\x06period\x18\x02 \x01(\x0e2\x1c.game_config.ChallengePeriodR\x06period\x12 \n" +
+	"\fstarts_at_ms\x18\x03 \x01(\x03R\n" +
+	"startsAtMs\x12\x1c\n" +
+	"\n" +
+	"ends_at_ms\x18\x04 \x01(\x03R\bendsAtMs\" \n" +
+	"\x1eRegisterChallengePlayerRequest\".\n" +
+	"\x0fChallengePlayer\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerIdB\tZ\a./protob\x06proto3"
+
+var (
+	file_proto_challenge_proto_rawDescOnce sync.Once
+	file_proto_challenge_proto_rawDescData []byte
+)
+
+func file_proto_challenge_proto_rawDescGZIP() []byte {
+	file_proto_challenge_proto_rawDescOnce.Do(func() {
+		file_proto_challenge_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_challenge_proto_rawDesc), len(file_proto_challenge_proto_rawDesc)))
+	})
+	return file_proto_challenge_proto_rawDescData
+}
+
+var file_proto_challenge_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_challenge_proto_goTypes = []any{
+	(*GetDailyChallengeRequest)(nil),       // 0: challenge.GetDailyChallengeRequest
+	(*Challenge)(nil),                      // 1: challenge.Challenge
+	(*RegisterChallengePlayerRequest)(nil), // 2: challenge.RegisterChallengePlayerRequest
+	(*ChallengePlayer)(nil),                // 3: challenge.ChallengePlayer
+	(ChallengePeriod)(0),                   // 4: game_config.ChallengePeriod
+}
+var file_proto_challenge_proto_depIdxs = []int32{
+	4, // 0: challenge.GetDailyChallengeRequest.period:type_name -> game_config.ChallengePeriod
+	4, // 1: challenge.Challenge.period:type_name -> game_config.ChallengePeriod
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_proto_challenge_proto_init() }
+func file_proto_challenge_proto_init() {
+	if File_proto_challenge_proto != nil {
+		return
+	}
+	file_proto_game_config_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_challenge_proto_rawDesc), len(file_proto_challenge_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_challenge_proto_goTypes,
+		DependencyIndexes: file_proto_challenge_proto_depIdxs,
+		MessageInfos:      file_proto_challenge_proto_msgTypes,
+	}.Build()
+	File_proto_challenge_proto = out.File
+	file_proto_challenge_proto_goTypes = nil
+	file_proto_challenge_proto_depIdxs = nil
+}
@@ -25,33 +25,128 @@ var File_proto_game_proto protoreflect.FileDescriptor
 
 const file_proto_game_proto_rawDesc = "" +
 	"\n" +
-	"\x10proto/game.proto\x12\x04game\x1a\x12proto/player.proto\x1a\x13proto/session.proto\x1a\x1cgoogle/api/annotations.proto2\x9f\x02\n" +
+	"\x10proto/game.proto\x12\x04game\x1a\x12proto/player.proto\x1a\x13proto/session.proto\x1a\x17proto/game_config.proto\x1a\x17proto/leaderboard.proto\x1a\x15proto/challenge.proto\x1a\x15proto/spectator.proto\x1a\x11proto/match.proto\x1a\x1cgoogle/api/annotations.proto2\xed\x11\n" +
 	"\vGameService\x12_\n" +
 	"\n" +
 	"CreateGame\x12\x19.player.CreateGameRequest\x1a\x1a.player.CreateGameResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/game/create\x12W\n" +
-	"\bGetBombs\x12\x18.session.GetBombsRequest\x1a\x19.session.GetBombsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/game/bombs\x12V\n" +
-	"\tSendInput\x12\x13.player.PlayerInput\x1a\x19.player.PlayerInputResult\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/game/inputB\tZ\a./protob\x06proto3"
+	"\bGetBombs\x12\x18.session.GetBombsRequest\x1a\x19.session.GetBombsResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/game/bombs\x12\\\n" +
+	"\rGetGameReport\x12\x1d.session.GetGameReportRequest\x1a\x13.session.GameReport\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/game/report\x12Y\n" +
+	"\vJoinSession\x12\x1b.session.JoinSessionRequest\x1a\x13.session.LobbyState\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/game/join\x12T\n" +
+	"\bSetReady\x12\x18.session.SetReadyRequest\x1a\x13.session.LobbyState\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/game/ready\x12Z\n" +
+	"\vAssignFaces\x12\x1b.session.AssignFacesRequest\x1a\x13.session.LobbyState\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/game/faces\x12V\n" +
+	"\tStartGame\x12\x19.session.StartGameRequest\x1a\x13.session.LobbyState\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/game/start\x12\\\n" +
+	"\tPauseGame\x12\x19.session.PauseGameRequest\x1a\x19.session.GetBombsResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/game/pause\x12_\n" +
+	"\n" +
+	"ResumeGame\x12\x1a.session.ResumeGameRequest\x1a\x19.session.GetBombsResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/game/resume\x12i\n" +
+	"\x0eRevealEdgework\x12\x1e.session.RevealEdgeworkRequest\x1a\x19.session.GetBombsResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/game/edgework\x12a\n" +
+	"\fWatchSession\x12\x1e.spectator.WatchSessionRequest\x1a\x17.spectator.SessionEvent\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/game/watch0\x01\x12a\n" +
+	"\vCreateMatch\x12\x19.match.CreateMatchRequest\x1a\x1a.match.CreateMatchResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/match/create\x12U\n" +
+	"\n" +
+	"StartMatch\x12\x18.match.StartMatchRequest\x1a\x11.match.Scoreboard\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/match/start\x12Q\n" +
+	"\tJoinMatch\x12\x17.match.JoinMatchRequest\x1a\x10.match.MatchTeam\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/match/join\x12]\n" +
+	"\rGetScoreboard\x12\x1b.match.GetScoreboardRequest\x1a\x11.match.Scoreboard\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/match/scoreboard\x12T\n" +
+	"\n" +
+	"WatchMatch\x12\x18.match.WatchMatchRequest\x1a\x11.match.Scoreboard\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/match/watch0\x01\x12V\n" +
+	"\tSendInput\x12\x13.player.PlayerInput\x1a\x19.player.PlayerInputResult\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/game/input\x12i\n" +
+	"\fListMissions\x12 .game_config.ListMissionsRequest\x1a!.game_config.ListMissionsResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/missions\x12w\n" +
+	"\x0eDescribeConfig\x12\".game_config.DescribeConfigRequest\x1a#.game_config.DescribeConfigResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/game/describe\x12v\n" +
+	"\fSubmitResult\x12 .leaderboard.SubmitResultRequest\x1a!.leaderboard.SubmitResultResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/leaderboard/submit\x12r\n" +
+	"\x0eGetLeaderboard\x12\".leaderboard.GetLeaderboardRequest\x1a#.leaderboard.GetLeaderboardResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/leaderboard\x12e\n" +
+	"\x11GetDailyChallenge\x12#.challenge.GetDailyChallengeRequest\x1a\x14.challenge.Challenge\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/challenge\x12\x82\x01\n" +
+	"\x17RegisterChallengePlayer\x12).challenge.RegisterChallengePlayerRequest\x1a\x1a.challenge.ChallengePlayer\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/challenge/playersB\tZ\a./protob\x06proto3"
 
 var file_proto_game_proto_goTypes = []any{
-	(*CreateGameRequest)(nil),  // 0: player.CreateGameRequest
-	(*GetBombsRequest)(nil),    // 1: session.GetBombsRequest
-	(*PlayerInput)(nil),        // 2: player.PlayerInput
-	(*CreateGameResponse)(nil), // 3: player.CreateGameResponse
-	(*GetBombsResponse)(nil),   // 4: session.GetBombsResponse
-	(*PlayerInputResult)(nil),  // 5: player.PlayerInputResult
+	(*CreateGameRequest)(nil),              // 0: player.CreateGameRequest
+	(*GetBombsRequest)(nil),                // 1: session.GetBombsRequest
+	(*GetGameReportRequest)(nil),           // 2: session.GetGameReportRequest
+	(*JoinSessionRequest)(nil),             // 3: session.JoinSessionRequest
+	(*SetReadyRequest)(nil),                // 4: session.SetReadyRequest
+	(*AssignFacesRequest)(nil),             // 5: session.AssignFacesRequest
+	(*StartGameRequest)(nil),               // 6: session.StartGameRequest
+	(*PauseGameRequest)(nil),               // 7: session.PauseGameRequest
+	(*ResumeGameRequest)(nil),              // 8: session.ResumeGameRequest
+	(*RevealEdgeworkRequest)(nil),          // 9: session.RevealEdgeworkRequest
+	(*WatchSessionRequest)(nil),            // 10: spectator.WatchSessionRequest
+	(*CreateMatchRequest)(nil),             // 11: match.CreateMatchRequest
+	(*StartMatchRequest)(nil),              // 12: match.StartMatchRequest
+	(*JoinMatchRequest)(nil),               // 13: match.JoinMatchRequest
+	(*GetScoreboardRequest)(nil),           // 14: match.GetScoreboardRequest
+	(*WatchMatchRequest)(nil),              // 15: match.WatchMatchRequest
+	(*PlayerInput)(nil),                    // 16: player.PlayerInput
+	(*ListMissionsRequest)(nil),            // 17: game_config.ListMissionsRequest
+	(*DescribeConfigRequest)(nil),          // 18: game_config.DescribeConfigRequest
+	(*SubmitResultRequest)(nil),            // 19: leaderboard.SubmitResultRequest
+	(*GetLeaderboardRequest)(nil),          // 20: leaderboard.GetLeaderboardRequest
+	(*GetDailyChallengeRequest)(nil),       // 21: challenge.GetDailyChallengeRequest
+	(*RegisterChallengePlayerRequest)(nil), // 22: challenge.RegisterChallengePlayerRequest
+	(*CreateGameResponse)(nil),             // 23: player.CreateGameResponse
+	(*GetBombsResponse)(nil),               // 24: session.GetBombsResponse
+	(*GameReport)(nil),                     // 25: session.GameReport
+	(*LobbyState)(nil),                     // 26: session.LobbyState
+	(*SessionEvent)(nil),                   // 27: spectator.SessionEvent
+	(*CreateMatchResponse)(nil),            // 28: match.CreateMatchResponse
+	(*Scoreboard)(nil),                     // 29: match.Scoreboard
+	(*MatchTeam)(nil),                      // 30: match.MatchTeam
+	(*PlayerInputResult)(nil),              // 31: player.PlayerInputResult
+	(*ListMissionsResponse)(nil),           // 32: game_config.ListMissionsResponse
+	(*DescribeConfigResponse)(nil),         // 33: game_config.DescribeConfigResponse
+	(*SubmitResultResponse)(nil),           // 34: leaderboard.SubmitResultResponse
+	(*GetLeaderboardResponse)(nil),         // 35: leaderboard.GetLeaderboardResponse
+	(*Challenge)(nil),                      // 36: challenge.Challenge
+	(*ChallengePlayer)(nil),                // 37: challenge.ChallengePlayer
 }
 var file_proto_game_proto_depIdxs = []int32{
-	0, // 0: game.GameService.CreateGame:input_type -> player.CreateGameRequest
-	1, // 1: game.GameService.GetBombs:input_type -> session.GetBombsRequest
-	2, // 2: game.GameService.SendInput:input_type -> player.PlayerInput
-	3, // 3: game.GameService.CreateGame:output_type -> player.CreateGameResponse
-	4, // 4: game.GameService.GetBombs:output_type -> session.GetBombsResponse
-	5, // 5: game.GameService.SendInput:output_type -> player.PlayerInputResult
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: game.GameService.CreateGame:input_type -> player.CreateGameRequest
+	1,  // 1: game.GameService.GetBombs:input_type -> session.GetBombsRequest
+	2,  // 2: game.GameService.GetGameReport:input_type -> session.GetGameReportRequest
+	3,  // 3: game.GameService.JoinSession:input_type -> session.JoinSessionRequest
+	4,  // 4: game.GameService.SetReady:input_type -> session.SetReadyRequest
+	5,  // 5: game.GameService.AssignFaces:input_type -> session.AssignFacesRequest
+	6,  // 6: game.GameService.StartGame:input_type -> session.StartGameRequest
+	7,  // 7: game.GameService.PauseGame:input_type -> session.PauseGameRequest
+	8,  // 8: game.GameService.ResumeGame:input_type -> session.ResumeGameRequest
+	9,  // 9: game.GameService.RevealEdgework:input_type -> session.RevealEdgeworkRequest
+	10, // 10: game.GameService.WatchSession:input_type -> spectator.WatchSessionRequest
+	11, // 11: game.GameService.CreateMatch:input_type -> match.CreateMatchRequest
+	12, // 12: game.GameService.StartMatch:input_type -> match.StartMatchRequest
+	13, // 13: game.GameService.JoinMatch:input_type -> match.JoinMatchRequest
+	14, // 14: game.GameService.GetScoreboard:input_type -> match.GetScoreboardRequest
+	15, // 15: game.GameService.WatchMatch:input_type -> match.WatchMatchRequest
+	16, // 16: game.GameService.SendInput:input_type -> player.PlayerInput
+	17, // 17: game.GameService.ListMissions:input_type -> game_config.ListMissionsRequest
+	18, // 18: game.GameService.DescribeConfig:input_type -> game_config.DescribeConfigRequest
+	19, // 19: game.GameService.SubmitResult:input_type -> leaderboard.SubmitResultRequest
+	20, // 20: game.GameService.GetLeaderboard:input_type -> leaderboard.GetLeaderboardRequest
+	21, // 21: game.GameService.GetDailyChallenge:input_type -> challenge.GetDailyChallengeRequest
+	22, // 22: game.GameService.RegisterChallengePlayer:input_type -> challenge.RegisterChallengePlayerRequest
+	23, // 23: game.GameService.CreateGame:output_type -> player.CreateGameResponse
+	24, // 24: game.GameService.GetBombs:output_type -> session.GetBombsResponse
+	25, // 25: game.GameService.GetGameReport:output_type -> session.GameReport
+	26, // 26: game.GameService.JoinSession:output_type -> session.LobbyState
+	26, // 27: game.GameService.SetReady:output_type -> session.LobbyState
+	26, // 28: game.GameService.AssignFaces:output_type -> session.LobbyState
+	26, // 29: game.GameService.StartGame:output_type -> session.LobbyState
+	24, // 30: game.GameService.PauseGame:output_type -> session.GetBombsResponse
+	24, // 31: game.GameService.ResumeGame:output_type -> session.GetBombsResponse
+	24, // 32: game.GameService.RevealEdgework:output_type -> session.GetBombsResponse
+	27, // 33: game.GameService.WatchSession:output_type -> spectator.SessionEvent
+	28, // 34: game.GameService.CreateMatch:output_type -> match.CreateMatchResponse
+	29, // 35: game.GameService.StartMatch:output_type -> match.Scoreboard
+	30, // 36: game.GameService.JoinMatch:output_type -> match.MatchTeam
+	29, // 37: game.GameService.GetScoreboard:output_type -> match.Scoreboard
+	29, // 38: game.GameService.WatchMatch:output_type -> match.Scoreboard
+	31, // 39: game.GameService.SendInput:output_type -> player.PlayerInputResult
+	32, // 40: game.GameService.ListMissions:output_type -> game_config.ListMissionsResponse
+	33, // 41: game.GameService.DescribeConfig:output_type -> game_config.DescribeConfigResponse
+	34, // 42: game.GameService.SubmitResult:output_type -> leaderboard.SubmitResultResponse
+	35, // 43: game.GameService.GetLeaderboard:output_type -> leaderboard.GetLeaderboardResponse
+	36, // 44: game.GameService.GetDailyChallenge:output_type -> challenge.Challenge
+	37, // 45: game.GameService.RegisterChallengePlayer:output_type -> challenge.ChallengePlayer
+	23, // [23:46] is the sub-list for method output_type
+	0,  // [0:23] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_proto_game_proto_init() }
@@ -61,6 +156,11 @@ func file_proto_game_proto_init() {
 	}
 	file_proto_player_proto_init()
 	file_proto_session_proto_init()
+	file_proto_game_config_proto_init()
+	file_proto_leaderboard_proto_init()
+	file_proto_challenge_proto_init()
+	file_proto_spectator_proto_init()
+	file_proto_match_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -167,6 +167,175 @@ func (Mission) EnumDescriptor() ([]byte, []int) {
 	return file_proto_game_config_proto_rawDescGZIP(), []int{0}
 }
 
+type BombMode int32
+
+const (
+	// All bombs are armed at once
+	BombMode_PARALLEL BombMode = 0
+	// The next bomb arms when the previous one is defused
+	BombMode_SEQUENTIAL BombMode = 1
+)
+
+// Enum value maps for BombMode.
+var (
+	BombMode_name = map[int32]string{
+		0: "PARALLEL",
+		1: "SEQUENTIAL",
+	}
+	BombMode_value = map[string]int32{
+		"PARALLEL":   0,
+		"SEQUENTIAL": 1,
+	}
+)
+
+func (x BombMode) Enum() *BombMode {
+	p := new(BombMode)
+	*p = x
+	return p
+}
+
+func (x BombMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BombMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_game_config_proto_enumTypes[1].Descriptor()
+}
+
+func (BombMode) Type() protoreflect.EnumType {
+	return &file_proto_game_config_proto_enumTypes[1]
+}
+
+func (x BombMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BombMode.Descriptor instead.
+func (BombMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_game_config_proto_rawDescGZIP(), []int{1}
+}
+
+type ChallengePeriod int32
+
+const (
+	ChallengePeriod_DAILY ChallengePeriod = 0
+	// Weekly challenges follow ISO weeks (Monday to Sunday, UTC)
+	ChallengePeriod_WEEKLY ChallengePeriod = 1
+)
+
+// Enum value maps for ChallengePeriod.
+var (
+	ChallengePeriod_name = map[int32]string{
+		0: "DAILY",
+		1: "WEEKLY",
+	}
+	ChallengePeriod_value = map[string]int32{
+		"DAILY":  0,
+		"WEEKLY": 1,
+	}
+)
+
+func (x ChallengePeriod) Enum() *ChallengePeriod {
+	p := new(ChallengePeriod)
+	*p = x
+	return p
+}
+
+func (x ChallengePeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChallengePeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_game_config_proto_enumTypes[2].Descriptor()
+}
+
+func (ChallengePeriod) Type() protoreflect.EnumType {
+	return &file_proto_game_config_proto_enumTypes[2]
+}
+
+func (x ChallengePeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChallengePeriod.Descriptor instead.
+func (ChallengePeriod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_game_config_proto_rawDescGZIP(), []int{2}
+}
+
+// Optional rules a game is played with. Any number can be combined, except zen
+// with time mode.
+type Modifier int32
+
+const (
+	Modifier_MODIFIER_UNSPECIFIED Modifier = 0
+	// Strike counts, max strikes and the timer rate are left out of every bomb sent
+	// until the bomb is defused or explodes
+	Modifier_HIDDEN_STRIKES Modifier = 1
+	// The time left, the timer, its rate, its duration and when it started are left
+	// out of every bomb sent until the bomb is defused or explodes
+	Modifier_HIDDEN_TIMER Modifier = 2
+	// The serial number, indicators, batteries and ports are left out until a
+	// player asks for them with RevealEdgework
+	Modifier_EDGEWORK_ON_REQUEST Modifier = 3
+	// Each module can only give the bomb one strike. Later mistakes are still
+	// reported as strikes but the bomb doesn't count them.
+	Modifier_ONE_STRIKE_PER_MODULE Modifier = 4
+	// The timer counts up and the bomb never explodes
+	Modifier_ZEN Modifier = 5
+	// Each solve adds 30 seconds and each strike takes away 60. Strikes never
+	// explode the bomb.
+	Modifier_TIME_MODE Modifier = 6
+)
+
+// Enum value maps for Modifier.
+var (
+	Modifier_name = map[int32]string{
+		0: "MODIFIER_UNSPECIFIED",
+		1: "HIDDEN_STRIKES",
+		2: "HIDDEN_TIMER",
+		3: "EDGEWORK_ON_REQUEST",
+		4: "ONE_STRIKE_PER_MODULE",
+		5: "ZEN",
+		6: "TIME_MODE",
+	}
+	Modifier_value = map[string]int32{
+		"MODIFIER_UNSPECIFIED":  0,
+		"HIDDEN_STRIKES":        1,
+		"HIDDEN_TIMER":          2,
+		"EDGEWORK_ON_REQUEST":   3,
+		"ONE_STRIKE_PER_MODULE": 4,
+		"ZEN":                   5,
+		"TIME_MODE":             6,
+	}
+)
+
+func (x Modifier) Enum() *Modifier {
+	p := new(Modifier)
+	*p = x
+	return p
+}
+
+func (x Modifier) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Modifier) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_game_config_proto_enumTypes[3].Descriptor()
+}
+
+func (Modifier) Type() protoreflect.EnumType {
+	return &file_proto_game_config_proto_enumTypes[3]
+}
+
+func (x Modifier) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Modifier.Descriptor instead.
+func (Modifier) EnumDescriptor() ([]byte, []int) {
+	return file_proto_game_config_proto_rawDescGZIP(), []int{3}
+}
+
 // Level configuration (1-10 difficulty)
 type LevelConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -213,8 +382,17 @@ func (x *LevelConfig) GetLevel() int32 {
 }
 
 type PresetMissionConfig struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mission       Mission                `protobuf:"varint,1,opt,name=mission,proto3,enum=game_config.Mission" json:"mission,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Mission Mission                `protobuf:"varint,1,opt,name=mission,proto3,enum=game_config.Mission" json:"mission,omitempty"`
+	// Selects a mission by the ID ListMissions gives it ("<pack>/<mission>")
+	// Takes precedence over mission when set
+	MissionId string `protobuf:"bytes,2,opt,name=mission_id,json=missionId,proto3" json:"mission_id,omitempty"`
+	// Plays the mission with this many bombs, each built from the mission's spec
+	// (min: 1, max: 10). If empty, the mission's own number of bombs is used.
+	// Missions played with a different number of bombs or bomb mode aren't ranked
+	// on the mission's leaderboard.
+	NumBombs      int32    `protobuf:"varint,3,opt,name=num_bombs,json=numBombs,proto3" json:"num_bombs,omitempty"`
+	BombMode      BombMode `protobuf:"varint,4,opt,name=bomb_mode,json=bombMode,proto3,enum=game_config.BombMode" json:"bomb_mode,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -256,11 +434,35 @@ func (x *PresetMissionConfig) GetMission() Mission {
 	return Mission_MISSION_UNSPECIFIED
 }
 
+func (x *PresetMissionConfig) GetMissionId() string {
+	if x != nil {
+		return x.MissionId
+	}
+	return ""
+}
+
+func (x *PresetMissionConfig) GetNumBombs() int32 {
+	if x != nil {
+		return x.NumBombs
+	}
+	return 0
+}
+
+func (x *PresetMissionConfig) GetBombMode() BombMode {
+	if x != nil {
+		return x.BombMode
+	}
+	return BombMode_PARALLEL
+}
+
 type ModuleSpec struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Type          Module_ModuleType      `protobuf:"varint,1,opt,name=type,proto3,enum=modules.Module_ModuleType" json:"type,omitempty"`
 	PossibleTypes []Module_ModuleType    `protobuf:"varint,2,rep,packed,name=possible_types,json=possibleTypes,proto3,enum=modules.Module_ModuleType" json:"possible_types,omitempty"`
 	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// Picks each module from the mission section's module pool. type and
+	// possible_types are ignored when set.
+	Random        bool `protobuf:"varint,4,opt,name=random,proto3" json:"random,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -316,6 +518,13 @@ func (x *ModuleSpec) GetCount() int32 {
 	return 0
 }
 
+func (x *ModuleSpec) GetRandom() bool {
+	if x != nil {
+		return x.Random
+	}
+	return false
+}
+
 type CustomBombConfig struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Time limit in seconds (min: 5, max: 3600)
@@ -338,8 +547,15 @@ type CustomBombConfig struct {
 	MaxBatteries      int32 `protobuf:"varint,11,opt,name=max_batteries,json=maxBatteries,proto3" json:"max_batteries,omitempty"`                  // 0-6
 	MaxIndicatorCount int32 `protobuf:"varint,12,opt,name=max_indicator_count,json=maxIndicatorCount,proto3" json:"max_indicator_count,omitempty"` // 0-5
 	PortCount         int32 `protobuf:"varint,13,opt,name=port_count,json=portCount,proto3" json:"port_count,omitempty"`                           // 0-6
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Timer speed multipliers indexed by strike count (0.1-10.0 each)
+	// If empty, uses the original game's rates (1.0, 1.25, 1.5, ...)
+	StrikeTimerRates []float32 `protobuf:"fixed32,14,rep,packed,name=strike_timer_rates,json=strikeTimerRates,proto3" json:"strike_timer_rates,omitempty"`
+	// Number of bombs built from this config (min: 1, max: 10)
+	// If empty, a single bomb is created
+	NumBombs      int32    `protobuf:"varint,15,opt,name=num_bombs,json=numBombs,proto3" json:"num_bombs,omitempty"`
+	BombMode      BombMode `protobuf:"varint,16,opt,name=bomb_mode,json=bombMode,proto3,enum=game_config.BombMode" json:"bomb_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CustomBombConfig) Reset() {
@@ -456,33 +672,47 @@ func (x *CustomBombConfig) GetPortCount() int32 {
 	return 0
 }
 
-type GameConfig struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to ConfigType:
-	//
-	//	*GameConfig_Level
-	//	*GameConfig_Preset
-	//	*GameConfig_Custom
-	ConfigType    isGameConfig_ConfigType `protobuf_oneof:"config_type"`
-	Seed          string                  `protobuf:"bytes,10,opt,name=seed,proto3" json:"seed,omitempty"`
+func (x *CustomBombConfig) GetStrikeTimerRates() []float32 {
+	if x != nil {
+		return x.StrikeTimerRates
+	}
+	return nil
+}
+
+func (x *CustomBombConfig) GetNumBombs() int32 {
+	if x != nil {
+		return x.NumBombs
+	}
+	return 0
+}
+
+func (x *CustomBombConfig) GetBombMode() BombMode {
+	if x != nil {
+		return x.BombMode
+	}
+	return BombMode_PARALLEL
+}
+
+type ListMissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameConfig) Reset() {
-	*x = GameConfig{}
+func (x *ListMissionsRequest) Reset() {
+	*x = ListMissionsRequest{}
 	mi := &file_proto_game_config_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameConfig) String() string {
+func (x *ListMissionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameConfig) ProtoMessage() {}
+func (*ListMissionsRequest) ProtoMessage() {}
 
-func (x *GameConfig) ProtoReflect() protoreflect.Message {
+func (x *ListMissionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_game_config_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -494,121 +724,1196 @@ func (x *GameConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameConfig.ProtoReflect.Descriptor instead.
-func (*GameConfig) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListMissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListMissionsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_game_config_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GameConfig) GetConfigType() isGameConfig_ConfigType {
+type MissionInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// "<pack>/<mission>" ID the mission is selected by through mission_id. Built-in
+	// missions are listed under the "builtin" pack.
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Set for built-in missions only
+	Mission Mission `protobuf:"varint,3,opt,name=mission,proto3,enum=game_config.Mission" json:"mission,omitempty"`
+	// Set for mission pack missions only
+	PackId       string   `protobuf:"bytes,4,opt,name=pack_id,json=packId,proto3" json:"pack_id,omitempty"`
+	Section      int32    `protobuf:"varint,5,opt,name=section,proto3" json:"section,omitempty"`
+	TimerSeconds int32    `protobuf:"varint,6,opt,name=timer_seconds,json=timerSeconds,proto3" json:"timer_seconds,omitempty"`
+	MaxStrikes   int32    `protobuf:"varint,7,opt,name=max_strikes,json=maxStrikes,proto3" json:"max_strikes,omitempty"`
+	NumFaces     int32    `protobuf:"varint,8,opt,name=num_faces,json=numFaces,proto3" json:"num_faces,omitempty"`
+	Rows         int32    `protobuf:"varint,9,opt,name=rows,proto3" json:"rows,omitempty"`
+	Columns      int32    `protobuf:"varint,10,opt,name=columns,proto3" json:"columns,omitempty"`
+	NumBombs     int32    `protobuf:"varint,11,opt,name=num_bombs,json=numBombs,proto3" json:"num_bombs,omitempty"`
+	BombMode     BombMode `protobuf:"varint,12,opt,name=bomb_mode,json=bombMode,proto3,enum=game_config.BombMode" json:"bomb_mode,omitempty"`
+	// Module composition of each bomb, not including the clock
+	Modules       []*ModuleSpec `protobuf:"bytes,13,rep,name=modules,proto3" json:"modules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MissionInfo) Reset() {
+	*x = MissionInfo{}
+	mi := &file_proto_game_config_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissionInfo) ProtoMessage() {}
+
+func (x *MissionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_config_proto_msgTypes[5]
 	if x != nil {
-		return x.ConfigType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GameConfig) GetLevel() *LevelConfig {
+// Deprecated: Use MissionInfo.ProtoReflect.Descriptor instead.
+func (*MissionInfo) Descriptor() ([]byte, []int) {
+	return file_proto_game_config_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MissionInfo) GetId() string {
 	if x != nil {
-		if x, ok := x.ConfigType.(*GameConfig_Level); ok {
-			return x.Level
-		}
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GameConfig) GetPreset() *PresetMissionConfig {
+func (x *MissionInfo) GetName() string {
 	if x != nil {
-		if x, ok := x.ConfigType.(*GameConfig_Preset); ok {
-			return x.Preset
-		}
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *GameConfig) GetCustom() *CustomBombConfig {
+func (x *MissionInfo) GetMission() Mission {
 	if x != nil {
-		if x, ok := x.ConfigType.(*GameConfig_Custom); ok {
-			return x.Custom
-		}
+		return x.Mission
 	}
-	return nil
+	return Mission_MISSION_UNSPECIFIED
 }
 
-func (x *GameConfig) GetSeed() string {
+func (x *MissionInfo) GetPackId() string {
 	if x != nil {
-		return x.Seed
+		return x.PackId
 	}
 	return ""
 }
 
-type isGameConfig_ConfigType interface {
-	isGameConfig_ConfigType()
+func (x *MissionInfo) GetSection() int32 {
+	if x != nil {
+		return x.Section
+	}
+	return 0
 }
 
-type GameConfig_Level struct {
-	Level *LevelConfig `protobuf:"bytes,1,opt,name=level,proto3,oneof"`
+func (x *MissionInfo) GetTimerSeconds() int32 {
+	if x != nil {
+		return x.TimerSeconds
+	}
+	return 0
 }
 
-type GameConfig_Preset struct {
-	Preset *PresetMissionConfig `protobuf:"bytes,2,opt,name=preset,proto3,oneof"`
+func (x *MissionInfo) GetMaxStrikes() int32 {
+	if x != nil {
+		return x.MaxStrikes
+	}
+	return 0
 }
 
-type GameConfig_Custom struct {
-	Custom *CustomBombConfig `protobuf:"bytes,3,opt,name=custom,proto3,oneof"`
+func (x *MissionInfo) GetNumFaces() int32 {
+	if x != nil {
+		return x.NumFaces
+	}
+	return 0
 }
 
-func (*GameConfig_Level) isGameConfig_ConfigType() {}
+func (x *MissionInfo) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
 
-func (*GameConfig_Preset) isGameConfig_ConfigType() {}
+func (x *MissionInfo) GetColumns() int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
 
-func (*GameConfig_Custom) isGameConfig_ConfigType() {}
+func (x *MissionInfo) GetNumBombs() int32 {
+	if x != nil {
+		return x.NumBombs
+	}
+	return 0
+}
 
-var File_proto_game_config_proto protoreflect.FileDescriptor
+func (x *MissionInfo) GetBombMode() BombMode {
+	if x != nil {
+		return x.BombMode
+	}
+	return BombMode_PARALLEL
+}
 
-const file_proto_game_config_proto_rawDesc = "" +
-	"\n" +
-	"\x17proto/game_config.proto\x12\vgame_config\x1a\x13proto/modules.proto\"#\n" +
-	"\vLevelConfig\x12\x14\n" +
-	"\x05level\x18\x01 \x01(\x05R\x05level\"E\n" +
-	"\x13PresetMissionConfig\x12.\n" +
-	"\amission\x18\x01 \x01(\x0e2\x14.game_config.MissionR\amission\"\x95\x01\n" +
-	"\n" +
-	"ModuleSpec\x12.\n" +
-	"\x04type\x18\x01 \x01(\x0e2\x1a.modules.Module.ModuleTypeR\x04type\x12A\n" +
-	"\x0epossible_types\x18\x02 \x03(\x0e2\x1a.modules.Module.ModuleTypeR\rpossibleTypes\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x05R\x05count\"\xc1\x03\n" +
-	"\x10CustomBombConfig\x12#\n" +
-	"\rtimer_seconds\x18\x01 \x01(\x05R\ftimerSeconds\x12\x1f\n" +
-	"\vmax_strikes\x18\x02 \x01(\x05R\n" +
-	"maxStrikes\x12\x1b\n" +
-	"\tnum_faces\x18\x03 \x01(\x05R\bnumFaces\x12\x12\n" +
-	"\x04rows\x18\x04 \x01(\x05R\x04rows\x12\x18\n" +
-	"\acolumns\x18\x05 \x01(\x05R\acolumns\x121\n" +
-	"\amodules\x18\x06 \x03(\v2\x17.game_config.ModuleSpecR\amodules\x12\x1f\n" +
-	"\vmin_modules\x18\a \x01(\x05R\n" +
-	"minModules\x12/\n" +
-	"\x14max_modules_per_face\x18\b \x01(\x05R\x11maxModulesPerFace\x12#\n" +
-	"\rmin_batteries\x18\n" +
-	" \x01(\x05R\fminBatteries\x12#\n" +
-	"\rmax_batteries\x18\v \x01(\x05R\fmaxBatteries\x12.\n" +
-	"\x13max_indicator_count\x18\f \x01(\x05R\x11maxIndicatorCount\x12\x1d\n" +
-	"\n" +
-	"port_count\x18\r \x01(\x05R\tportCount\"\xd6\x01\n" +
-	"\n" +
-	"GameConfig\x120\n" +
-	"\x05level\x18\x01 \x01(\v2\x18.game_config.LevelConfigH\x00R\x05level\x12:\n" +
-	"\x06preset\x18\x02 \x01(\v2 .game_config.PresetMissionConfigH\x00R\x06preset\x127\n" +
-	"\x06custom\x18\x03 \x01(\v2\x1d.game_config.CustomBombConfigH\x00R\x06custom\x12\x12\n" +
-	"\x04seed\x18\n" +
-	" \x01(\tR\x04seedB\r\n" +
-	"\vconfig_type*\xbf\x05\n" +
-	"\aMission\x12\x17\n" +
-	"\x13MISSION_UNSPECIFIED\x10\x00\x12\x12\n" +
-	"\x0eTHE_FIRST_BOMB\x10\x01\x12\x1f\n" +
-	"\x1bSOMETHING_OLD_SOMETHING_NEW\x10\x02\x12\x15\n" +
-	"\x11DOUBLE_YOUR_MONEY\x10\x03\x12\x0f\n" +
-	"\vONE_STEP_UP\x10\x04\x12\x14\n" +
-	"\x10PICK_UP_THE_PACE\x10\x05\x12\x14\n" +
-	"\x10A_HIDDEN_MESSAGE\x10\x06\x12\x18\n" +
+func (x *MissionInfo) GetModules() []*ModuleSpec {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+type ListMissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Missions      []*MissionInfo         `protobuf:"bytes,1,rep,name=missions,proto3" json:"missions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMissionsResponse) Reset() {
+	*x = ListMissionsResponse{}
+	mi := &file_proto_game_config_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMissionsResponse) ProtoMessage() {}
+
+func (x *ListMissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_config_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListMissionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListMissionsResponse) GetMissions() []*MissionInfo {
+	if x != nil {
+		return x.Missions
+	}
+	return nil
+}
+
+type DescribeConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Resolved exactly as CreateGame would, using config.seed
+	Config        *GameConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeConfigRequest) Reset() {
+	*x = DescribeConfigRequest{}
+	mi := &file_proto_game_config_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeConfigRequest) ProtoMessage() {}
+
+func (x *DescribeConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_config_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeConfigRequest.ProtoReflect.Descriptor instead.
+func (*DescribeConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_game_config_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DescribeConfigRequest) GetConfig() *GameConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+type ConfigValidationError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigValidationError) Reset() {
+	*x = ConfigValidationError{}
+	mi := &file_proto_game_config_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigValidationError) ProtoMessage() {}
+
+func (x *ConfigValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_config_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigValidationError.ProtoReflect.Descriptor instead.
+func (*ConfigValidationError) Descriptor() ([]byte, []int) {
+	return file_proto_game_config_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConfigValidationError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *ConfigValidationError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PlannedModule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          Module_ModuleType      `protobuf:"varint,1,opt,name=type,proto3,enum=modules.Module_ModuleType" json:"type,omitempty"`
+	Position      *ModulePosition        `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlannedModule) Reset() {
+	*x = PlannedModule{}
+	mi := &file_proto_game_config_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedModule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedModule) ProtoMessage() {}
+
+func (x *PlannedModule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_config_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedModule.ProtoReflect.Descriptor instead.
+func (*PlannedModule) Descriptor() ([]byte, []int) {
+	return file_proto_game_config_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PlannedModule) GetType() Module_ModuleType {
+	if x != nil {
+		return x.Type
+	}
+	return Module_UNKNOWN
+}
+
+func (x *PlannedModule) GetPosition() *ModulePosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+type PlannedBomb struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Position of the bomb in the session, starting at 0
+	Order int32 `protobuf:"varint,1,opt,name=order,proto3" json:"order,omitempty"`
+	// Resolved bomb config. modules holds the composition before random picks.
+	Config *CustomBombConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	// Every module the bomb factory would place, including the clock.
+	// Empty when the config is invalid.
+	Modules []*PlannedModule `protobuf:"bytes,3,rep,name=modules,proto3" json:"modules,omitempty"`
+	// Code that imports this exact bomb. Empty when the config is invalid.
+	Code          string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlannedBomb) Reset() {
+	*x = PlannedBomb{}
+	mi := &file_proto_game_config_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlannedBomb) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlannedBomb) ProtoMessage() {}
+
+func (x *PlannedBomb) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_config_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlannedBomb.ProtoReflect.Descriptor instead.
+func (*PlannedBomb) Descriptor() ([]byte, []int) {
+	return file_proto_game_config_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PlannedBomb) GetOrder() int32 {
+	if x != nil {
+		return x.Order
+	}
+	return 0
+}
+
+func (x *PlannedBomb) GetConfig() *CustomBombConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *PlannedBomb) GetModules() []*PlannedModule {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+func (x *PlannedBomb) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DescribeConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Seed the layout was generated from. Pass it back to CreateGame to get
+	// the same bombs. Generated when the request didn't set one, and set even
+	// when the config is invalid.
+	Seed     string   `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	BombMode BombMode `protobuf:"varint,2,opt,name=bomb_mode,json=bombMode,proto3,enum=game_config.BombMode" json:"bomb_mode,omitempty"`
+	// Empty when the config is valid
+	ValidationErrors []*ConfigValidationError `protobuf:"bytes,3,rep,name=validation_errors,json=validationErrors,proto3" json:"validation_errors,omitempty"`
+	Bombs            []*PlannedBomb           `protobuf:"bytes,4,rep,name=bombs,proto3" json:"bombs,omitempty"`
+	// Generator the layout was planned with
+	GeneratorVersion int32 `protobuf:"varint,5,opt,name=generator_version,json=generatorVersion,proto3" json:"generator_version,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DescribeConfigResponse) Reset() {
+	*x = DescribeConfigResponse{}
+	mi := &file_proto_game_config_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeConfigResponse) ProtoMessage() {}
+
+func (x *DescribeConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_config_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeConfigResponse.ProtoReflect.Descriptor instead.
+func (*DescribeConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_game_config_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DescribeConfigResponse) GetSeed() string {
+	if x != nil {
+		return x.Seed
+	}
+	return ""
+}
+
+func (x *DescribeConfigResponse) GetBombMode() BombMode {
+	if x != nil {
+		return x.BombMode
+	}
+	return BombMode_PARALLEL
+}
+
+func (x *DescribeConfigResponse) GetValidationErrors() []*ConfigValidationError {
+	if x != nil {
+		return x.ValidationErrors
+	}
+	return nil
+}
+
+func (x *DescribeConfigResponse) GetBombs() []*PlannedBomb {
+	if x != nil {
+		return x.Bombs
+	}
+	return nil
+}
+
+func (x *DescribeConfigResponse) GetGeneratorVersion() int32 {
+	if x != nil {
+		return x.GeneratorVersion
+	}
+	return 0
+}
+
+type ModuleCount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          Module_ModuleType      `protobuf:"varint,1,opt,name=type,proto3,enum=modules.Module_ModuleType" json:"type,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleCount) Reset() {
+	*x = ModuleCount{}
+	mi := &file_proto_game_config_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleCount) ProtoMessage() {}
+
+func (x *ModuleCount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_config_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleCount.ProtoReflect.Descriptor instead.
+func (*ModuleCount) Descriptor() ([]byte, []int) {
+	return file_proto_game_config_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ModuleCount) GetType() Module_ModuleType {
+	if x != nil {
+		return x.Type
+	}
+	return Module_UNKNOWN
+}
+
+func (x *ModuleCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Details of the bombs generated for a session. Creating a game with the same
+// config and seed reproduces the same bombs.
+type GeneratedConfigInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Effective seed, including one generated when the request didn't set one
+	Seed         string `protobuf:"bytes,1,opt,name=seed,proto3" json:"seed,omitempty"`
+	TimerSeconds int32  `protobuf:"varint,2,opt,name=timer_seconds,json=timerSeconds,proto3" json:"timer_seconds,omitempty"`
+	MaxStrikes   int32  `protobuf:"varint,3,opt,name=max_strikes,json=maxStrikes,proto3" json:"max_strikes,omitempty"`
+	NumFaces     int32  `protobuf:"varint,4,opt,name=num_faces,json=numFaces,proto3" json:"num_faces,omitempty"`
+	Rows         int32  `protobuf:"varint,5,opt,name=rows,proto3" json:"rows,omitempty"`
+	Columns      int32  `protobuf:"varint,6,opt,name=columns,proto3" json:"columns,omitempty"`
+	// Modules placed across every bomb, not counting clocks
+	ModuleCounts []*ModuleCount `protobuf:"bytes,7,rep,name=module_counts,json=moduleCounts,proto3" json:"module_counts,omitempty"`
+	// Set for mission games only
+	MissionName    string   `protobuf:"bytes,8,opt,name=mission_name,json=missionName,proto3" json:"mission_name,omitempty"`
+	MissionSection int32    `protobuf:"varint,9,opt,name=mission_section,json=missionSection,proto3" json:"mission_section,omitempty"`
+	NumBombs       int32    `protobuf:"varint,10,opt,name=num_bombs,json=numBombs,proto3" json:"num_bombs,omitempty"`
+	BombMode       BombMode `protobuf:"varint,11,opt,name=bomb_mode,json=bombMode,proto3,enum=game_config.BombMode" json:"bomb_mode,omitempty"`
+	// Identifies the settings the session was generated from. Custom games are
+	// ranked on the leaderboard for this hash.
+	ConfigHash string `protobuf:"bytes,12,opt,name=config_hash,json=configHash,proto3" json:"config_hash,omitempty"`
+	// Code for every bomb in the session, in play order. Importing them with
+	// BombCodeConfig rebuilds the bombs exactly.
+	BombCodes []string `protobuf:"bytes,13,rep,name=bomb_codes,json=bombCodes,proto3" json:"bomb_codes,omitempty"`
+	// Generator the bombs were built with. Pass it back to CreateGame along with
+	// the seed to get the same bombs after the generator changes.
+	GeneratorVersion int32 `protobuf:"varint,14,opt,name=generator_version,json=generatorVersion,proto3" json:"generator_version,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *GeneratedConfigInfo) Reset() {
+	*x = GeneratedConfigInfo{}
+	mi := &file_proto_game_config_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratedConfigInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratedConfigInfo) ProtoMessage() {}
+
+func (x *GeneratedConfigInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_config_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratedConfigInfo.ProtoReflect.Descriptor instead.
+func (*GeneratedConfigInfo) Descriptor() ([]byte, []int) {
+	return file_proto_game_config_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GeneratedConfigInfo) GetSeed() string {
+	if x != nil {
+		return x.Seed
+	}
+	return ""
+}
+
+func (x *GeneratedConfigInfo) GetTimerSeconds() int32 {
+	if x != nil {
+		return x.TimerSeconds
+	}
+	return 0
+}
+
+func (x *GeneratedConfigInfo) GetMaxStrikes() int32 {
+	if x != nil {
+		return x.MaxStrikes
+	}
+	return 0
+}
+
+func (x *GeneratedConfigInfo) GetNumFaces() int32 {
+	if x != nil {
+		return x.NumFaces
+	}
+	return 0
+}
+
+func (x *GeneratedConfigInfo) GetRows() int32 {
+	if x != nil {
+		return x.Rows
+	}
+	return 0
+}
+
+func (x *GeneratedConfigInfo) GetColumns() int32 {
+	if x != nil {
+		return x.Columns
+	}
+	return 0
+}
+
+func (x *GeneratedConfigInfo) GetModuleCounts() []*ModuleCount {
+	if x != nil {
+		return x.ModuleCounts
+	}
+	return nil
+}
+
+func (x *GeneratedConfigInfo) GetMissionName() string {
+	if x != nil {
+		return x.MissionName
+	}
+	return ""
+}
+
+func (x *GeneratedConfigInfo) GetMissionSection() int32 {
+	if x != nil {
+		return x.MissionSection
+	}
+	return 0
+}
+
+func (x *GeneratedConfigInfo) GetNumBombs() int32 {
+	if x != nil {
+		return x.NumBombs
+	}
+	return 0
+}
+
+func (x *GeneratedConfigInfo) GetBombMode() BombMode {
+	if x != nil {
+		return x.BombMode
+	}
+	return BombMode_PARALLEL
+}
+
+func (x *GeneratedConfigInfo) GetConfigHash() string {
+	if x != nil {
+		return x.ConfigHash
+	}
+	return ""
+}
+
+func (x *GeneratedConfigInfo) GetBombCodes() []string {
+	if x != nil {
+		return x.BombCodes
+	}
+	return nil
+}
+
+func (x *GeneratedConfigInfo) GetGeneratorVersion() int32 {
+	if x != nil {
+		return x.GeneratorVersion
+	}
+	return 0
+}
+
+// One untimed bomb holding a single module. Strikes never explode the bomb and
+// come with an explanation of the rule that applied.
+type PracticeConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Needy modules and the clock can't be practiced
+	ModuleType    Module_ModuleType `protobuf:"varint,1,opt,name=module_type,json=moduleType,proto3,enum=modules.Module_ModuleType" json:"module_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PracticeConfig) Reset() {
+	*x = PracticeConfig{}
+	mi := &file_proto_game_config_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PracticeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PracticeConfig) ProtoMessage() {}
+
+func (x *PracticeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_config_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PracticeConfig.ProtoReflect.Descriptor instead.
+func (*PracticeConfig) Descriptor() ([]byte, []int) {
+	return file_proto_game_config_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PracticeConfig) GetModuleType() Module_ModuleType {
+	if x != nil {
+		return x.ModuleType
+	}
+	return Module_UNKNOWN
+}
+
+// Attempts the current daily or weekly challenge. Each player gets one attempt
+// per challenge and the seed comes from the challenge.
+type ChallengeConfig struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Period ChallengePeriod        `protobuf:"varint,1,opt,name=period,proto3,enum=game_config.ChallengePeriod" json:"period,omitempty"`
+	// Issued by RegisterChallengePlayer
+	PlayerId      string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChallengeConfig) Reset() {
+	*x = ChallengeConfig{}
+	mi := &file_proto_game_config_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChallengeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChallengeConfig) ProtoMessage() {}
+
+func (x *ChallengeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_config_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChallengeConfig.ProtoReflect.Descriptor instead.
+func (*ChallengeConfig) Descriptor() ([]byte, []int) {
+	return file_proto_game_config_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ChallengeConfig) GetPeriod() ChallengePeriod {
+	if x != nil {
+		return x.Period
+	}
+	return ChallengePeriod_DAILY
+}
+
+func (x *ChallengeConfig) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+// Plays bombs exported as bomb codes, exactly as they were generated. The seed
+// and config come from the codes.
+type BombCodeConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Codes         []string               `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
+	BombMode      BombMode               `protobuf:"varint,2,opt,name=bomb_mode,json=bombMode,proto3,enum=game_config.BombMode" json:"bomb_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BombCodeConfig) Reset() {
+	*x = BombCodeConfig{}
+	mi := &file_proto_game_config_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BombCodeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BombCodeConfig) ProtoMessage() {}
+
+func (x *BombCodeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_config_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BombCodeConfig.ProtoReflect.Descriptor instead.
+func (*BombCodeConfig) Descriptor() ([]byte, []int) {
+	return file_proto_game_config_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BombCodeConfig) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *BombCodeConfig) GetBombMode() BombMode {
+	if x != nil {
+		return x.BombMode
+	}
+	return BombMode_PARALLEL
+}
+
+type GameConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to ConfigType:
+	//
+	//	*GameConfig_Level
+	//	*GameConfig_Preset
+	//	*GameConfig_Custom
+	//	*GameConfig_Practice
+	//	*GameConfig_Challenge
+	//	*GameConfig_BombCodes
+	ConfigType isGameConfig_ConfigType `protobuf_oneof:"config_type"`
+	Seed       string                  `protobuf:"bytes,10,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Version of the bomb generator to use. 0 picks the latest. The same seed and
+	// config only give the same bombs with the same generator version.
+	GeneratorVersion int32 `protobuf:"varint,11,opt,name=generator_version,json=generatorVersion,proto3" json:"generator_version,omitempty"`
+	// Stops players from pausing the game, for competitive play. Only games that
+	// can't be paused are ranked on leaderboards. Challenges can never be paused.
+	DisablePause bool `protobuf:"varint,12,opt,name=disable_pause,json=disablePause,proto3" json:"disable_pause,omitempty"`
+	// How many seconds behind the game spectators are kept, so that they can't
+	// relay what they see to the players. At most 600.
+	SpectatorDelaySeconds int32 `protobuf:"varint,13,opt,name=spectator_delay_seconds,json=spectatorDelaySeconds,proto3" json:"spectator_delay_seconds,omitempty"`
+	// Optional rules every bomb in the game is played with. Games with modifiers
+	// aren't ranked, and challenges can't have any.
+	Modifiers     []Modifier `protobuf:"varint,14,rep,packed,name=modifiers,proto3,enum=game_config.Modifier" json:"modifiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameConfig) Reset() {
+	*x = GameConfig{}
+	mi := &file_proto_game_config_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameConfig) ProtoMessage() {}
+
+func (x *GameConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_game_config_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameConfig.ProtoReflect.Descriptor instead.
+func (*GameConfig) Descriptor() ([]byte, []int) {
+	return file_proto_game_config_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GameConfig) GetConfigType() isGameConfig_ConfigType {
+	if x != nil {
+		return x.ConfigType
+	}
+	return nil
+}
+
+func (x *GameConfig) GetLevel() *LevelConfig {
+	if x != nil {
+		if x, ok := x.ConfigType.(*GameConfig_Level); ok {
+			return x.Level
+		}
+	}
+	return nil
+}
+
+func (x *GameConfig) GetPreset() *PresetMissionConfig {
+	if x != nil {
+		if x, ok := x.ConfigType.(*GameConfig_Preset); ok {
+			return x.Preset
+		}
+	}
+	return nil
+}
+
+func (x *GameConfig) GetCustom() *CustomBombConfig {
+	if x != nil {
+		if x, ok := x.ConfigType.(*GameConfig_Custom); ok {
+			return x.Custom
+		}
+	}
+	return nil
+}
+
+func (x *GameConfig) GetPractice() *PracticeConfig {
+	if x != nil {
+		if x, ok := x.ConfigType.(*GameConfig_Practice); ok {
+			return x.Practice
+		}
+	}
+	return nil
+}
+
+func (x *GameConfig) GetChallenge() *ChallengeConfig {
+	if x != nil {
+		if x, ok := x.ConfigType.(*GameConfig_Challenge); ok {
+			return x.Challenge
+		}
+	}
+	return nil
+}
+
+func (x *GameConfig) GetBombCodes() *BombCodeConfig {
+	if x != nil {
+		if x, ok := x.ConfigType.(*GameConfig_BombCodes); ok {
+			return x.BombCodes
+		}
+	}
+	return nil
+}
+
+func (x *GameConfig) GetSeed() string {
+	if x != nil {
+		return x.Seed
+	}
+	return ""
+}
+
+func (x *GameConfig) GetGeneratorVersion() int32 {
+	if x != nil {
+		return x.GeneratorVersion
+	}
+	return 0
+}
+
+func (x *GameConfig) GetDisablePause() bool {
+	if x != nil {
+		return x.DisablePause
+	}
+	return false
+}
+
+func (x *GameConfig) GetSpectatorDelaySeconds() int32 {
+	if x != nil {
+		return x.SpectatorDelaySeconds
+	}
+	return 0
+}
+
+func (x *GameConfig) GetModifiers() []Modifier {
+	if x != nil {
+		return x.Modifiers
+	}
+	return nil
+}
+
+type isGameConfig_ConfigType interface {
+	isGameConfig_ConfigType()
+}
+
+type GameConfig_Level struct {
+	Level *LevelConfig `protobuf:"bytes,1,opt,name=level,proto3,oneof"`
+}
+
+type GameConfig_Preset struct {
+	Preset *PresetMissionConfig `protobuf:"bytes,2,opt,name=preset,proto3,oneof"`
+}
+
+type GameConfig_Custom struct {
+	Custom *CustomBombConfig `protobuf:"bytes,3,opt,name=custom,proto3,oneof"`
+}
+
+type GameConfig_Practice struct {
+	Practice *PracticeConfig `protobuf:"bytes,4,opt,name=practice,proto3,oneof"`
+}
+
+type GameConfig_Challenge struct {
+	Challenge *ChallengeConfig `protobuf:"bytes,5,opt,name=challenge,proto3,oneof"`
+}
+
+type GameConfig_BombCodes struct {
+	BombCodes *BombCodeConfig `protobuf:"bytes,6,opt,name=bomb_codes,json=bombCodes,proto3,oneof"`
+}
+
+func (*GameConfig_Level) isGameConfig_ConfigType() {}
+
+func (*GameConfig_Preset) isGameConfig_ConfigType() {}
+
+func (*GameConfig_Custom) isGameConfig_ConfigType() {}
+
+func (*GameConfig_Practice) isGameConfig_ConfigType() {}
+
+func (*GameConfig_Challenge) isGameConfig_ConfigType() {}
+
+func (*GameConfig_BombCodes) isGameConfig_ConfigType() {}
+
+var File_proto_game_config_proto protoreflect.FileDescriptor
+
+const file_proto_game_config_proto_rawDesc = "" +
+	"\n" +
+	"\x17proto/game_config.proto\x12\vgame_config\x1a\x13proto/modules.proto\"#\n" +
+	"\vLevelConfig\x12\x14\n" +
+	"\x05level\x18\x01 \x01(\x05R\x05level\"\xb5\x01\n" +
+	"\x13PresetMissionConfig\x12.\n" +
+	"\amission\x18\x01 \x01(\x0e2\x14.game_config.MissionR\amission\x12\x1d\n" +
+	"\n" +
+	"mission_id\x18\x02 \x01(\tR\tmissionId\x12\x1b\n" +
+	"\tnum_bombs\x18\x03 \x01(\x05R\bnumBombs\x122\n" +
+	"\tbomb_mode\x18\x04 \x01(\x0e2\x15.game_config.BombModeR\bbombMode\"\xad\x01\n" +
+	"\n" +
+	"ModuleSpec\x12.\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1a.modules.Module.ModuleTypeR\x04type\x12A\n" +
+	"\x0epossible_types\x18\x02 \x03(\x0e2\x1a.modules.Module.ModuleTypeR\rpossibleTypes\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x16\n" +
+	"\x06random\x18\x04 \x01(\bR\x06random\"\xc0\x04\n" +
+	"\x10CustomBombConfig\x12#\n" +
+	"\rtimer_seconds\x18\x01 \x01(\x05R\ftimerSeconds\x12\x1f\n" +
+	"\vmax_strikes\x18\x02 \x01(\x05R\n" +
+	"maxStrikes\x12\x1b\n" +
+	"\tnum_faces\x18\x03 \x01(\x05R\bnumFaces\x12\x12\n" +
+	"\x04rows\x18\x04 \x01(\x05R\x04rows\x12\x18\n" +
+	"\acolumns\x18\x05 \x01(\x05R\acolumns\x121\n" +
+	"\amodules\x18\x06 \x03(\v2\x17.game_config.ModuleSpecR\amodules\x12\x1f\n" +
+	"\vmin_modules\x18\a \x01(\x05R\n" +
+	"minModules\x12/\n" +
+	"\x14max_modules_per_face\x18\b \x01(\x05R\x11maxModulesPerFace\x12#\n" +
+	"\rmin_batteries\x18\n" +
+	" \x01(\x05R\fminBatteries\x12#\n" +
+	"\rmax_batteries\x18\v \x01(\x05R\fmaxBatteries\x12.\n" +
+	"\x13max_indicator_count\x18\f \x01(\x05R\x11maxIndicatorCount\x12\x1d\n" +
+	"\n" +
+	"port_count\x18\r \x01(\x05R\tportCount\x12,\n" +
+	"\x12strike_timer_rates\x18\x0e \x03(\x02R\x10strikeTimerRates\x12\x1b\n" +
+	"\tnum_bombs\x18\x0f \x01(\x05R\bnumBombs\x122\n" +
+	"\tbomb_mode\x18\x10 \x01(\x0e2\x15.game_config.BombModeR\bbombMode\"\x15\n" +
+	"\x13ListMissionsRequest\"\xa9\x03\n" +
+	"\vMissionInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12.\n" +
+	"\amission\x18\x03 \x01(\x0e2\x14.game_config.MissionR\amission\x12\x17\n" +
+	"\apack_id\x18\x04 \x01(\tR\x06packId\x12\x18\n" +
+	"\asection\x18\x05 \x01(\x05R\asection\x12#\n" +
+	"\rtimer_seconds\x18\x06 \x01(\x05R\ftimerSeconds\x12\x1f\n" +
+	"\vmax_strikes\x18\a \x01(\x05R\n" +
+	"maxStrikes\x12\x1b\n" +
+	"\tnum_faces\x18\b \x01(\x05R\bnumFaces\x12\x12\n" +
+	"\x04rows\x18\t \x01(\x05R\x04rows\x12\x18\n" +
+	"\acolumns\x18\n" +
+	" \x01(\x05R\acolumns\x12\x1b\n" +
+	"\tnum_bombs\x18\v \x01(\x05R\bnumBombs\x122\n" +
+	"\tbomb_mode\x18\f \x01(\x0e2\x15.game_config.BombModeR\bbombMode\x121\n" +
+	"\amodules\x18\r \x03(\v2\x17.game_config.ModuleSpecR\amodules\"L\n" +
+	"\x14ListMissionsResponse\x124\n" +
+	"\bmissions\x18\x01 \x03(\v2\x18.game_config.MissionInfoR\bmissions\"H\n" +
+	"\x15DescribeConfigRequest\x12/\n" +
+	"\x06config\x18\x01 \x01(\v2\x17.game_config.GameConfigR\x06config\"G\n" +
+	"\x15ConfigValidationError\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"t\n" +
+	"\rPlannedModule\x12.\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1a.modules.Module.ModuleTypeR\x04type\x123\n" +
+	"\bposition\x18\x02 \x01(\v2\x17.modules.ModulePositionR\bposition\"\xa4\x01\n" +
+	"\vPlannedBomb\x12\x14\n" +
+	"\x05order\x18\x01 \x01(\x05R\x05order\x125\n" +
+	"\x06config\x18\x02 \x01(\v2\x1d.game_config.CustomBombConfigR\x06config\x124\n" +
+	"\amodules\x18\x03 \x03(\v2\x1a.game_config.PlannedModuleR\amodules\x12\x12\n" +
+	"\x04code\x18\x04 \x01(\tR\x04code\"\x8e\x02\n" +
+	"\x16DescribeConfigResponse\x12\x12\n" +
+	"\x04seed\x18\x01 \x01(\tR\x04seed\x122\n" +
+	"\tbomb_mode\x18\x02 \x01(\x0e2\x15.game_config.BombModeR\bbombMode\x12O\n" +
+	"\x11validation_errors\x18\x03 \x03(\v2\".game_config.ConfigValidationErrorR\x10validationErrors\x12.\n" +
+	"\x05bombs\x18\x04 \x03(\v2\x18.game_config.PlannedBombR\x05bombs\x12+\n" +
+	"\x11generator_version\x18\x05 \x01(\x05R\x10generatorVersion\"S\n" +
+	"\vModuleCount\x12.\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1a.modules.Module.ModuleTypeR\x04type\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x83\x04\n" +
+	"\x13GeneratedConfigInfo\x12\x12\n" +
+	"\x04seed\x18\x01 \x01(\tR\x04seed\x12#\n" +
+	"\rtimer_seconds\x18\x02 \x01(\x05R\ftimerSeconds\x12\x1f\n" +
+	"\vmax_strikes\x18\x03 \x01(\x05R\n" +
+	"maxStrikes\x12\x1b\n" +
+	"\tnum_faces\x18\x04 \x01(\x05R\bnumFaces\x12\x12\n" +
+	"\x04rows\x18\x05 \x01(\x05R\x04rows\x12\x18\n" +
+	"\acolumns\x18\x06 \x01(\x05R\acolumns\x12=\n" +
+	"\rmodule_counts\x18\a \x03(\v2\x18.game_config.ModuleCountR\fmoduleCounts\x12!\n" +
+	"\fmission_name\x18\b \x01(\tR\vmissionName\x12'\n" +
+	"\x0fmission_section\x18\t \x01(\x05R\x0emissionSection\x12\x1b\n" +
+	"\tnum_bombs\x18\n" +
+	" \x01(\x05R\bnumBombs\x122\n" +
+	"\tbomb_mode\x18\v \x01(\x0e2\x15.game_config.BombModeR\bbombMode\x12\x1f\n" +
+	"\vconfig_hash\x18\f \x01(\tR\n" +
+	"configHash\x12\x1d\n" +
+	"\n" +
+	"bomb_codes\x18\r \x03(\tR\tbombCodes\x12+\n" +
+	"\x11generator_version\x18\x0e \x01(\x05R\x10generatorVersion\"M\n" +
+	"\x0ePracticeConfig\x12;\n" +
+	"\vmodule_type\x18\x01 \x01(\x0e2\x1a.modules.Module.ModuleTypeR\n" +
+	"moduleType\"d\n" +
+	"\x0fChallengeConfig\x124\n" +
+	"\x06period\x18\x01 \x01(\x0e2\x1c.game_config.ChallengePeriodR\x06period\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"Z\n" +
+	"\x0eBombCodeConfig\x12\x14\n" +
+	"\x05codes\x18\x01 \x03(\tR\x05codes\x122\n" +
+	"\tbomb_mode\x18\x02 \x01(\x0e2\x15.game_config.BombModeR\bbombMode\"\xcc\x04\n" +
+	"\n" +
+	"GameConfig\x120\n" +
+	"\x05level\x18\x01 \x01(\v2\x18.game_config.LevelConfigH\x00R\x05level\x12:\n" +
+	"\x06preset\x18\x02 \x01(\v2 .game_config.PresetMissionConfigH\x00R\x06preset\x127\n" +
+	"\x06custom\x18\x03 \x01(\v2\x1d.game_config.CustomBombConfigH\x00R\x06custom\x129\n" +
+	"\bpractice\x18\x04 \x01(\v2\x1b.game_config.PracticeConfigH\x00R\bpractice\x12<\n" +
+	"\tchallenge\x18\x05 \x01(\v2\x1c.game_config.ChallengeConfigH\x00R\tchallenge\x12<\n" +
+	"\n" +
+	"bomb_codes\x18\x06 \x01(\v2\x1b.game_config.BombCodeConfigH\x00R\tbombCodes\x12\x12\n" +
+	"\x04seed\x18\n" +
+	" \x01(\tR\x04seed\x12+\n" +
+	"\x11generator_version\x18\v \x01(\x05R\x10generatorVersion\x12#\n" +
+	"\rdisable_pause\x18\f \x01(\bR\fdisablePause\x126\n" +
+	"\x17spectator_delay_seconds\x18\r \x01(\x05R\x15spectatorDelaySeconds\x123\n" +
+	"\tmodifiers\x18\x0e \x03(\x0e2\x15.game_config.ModifierR\tmodifiersB\r\n" +
+	"\vconfig_type*\xbf\x05\n" +
+	"\aMission\x12\x17\n" +
+	"\x13MISSION_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eTHE_FIRST_BOMB\x10\x01\x12\x1f\n" +
+	"\x1bSOMETHING_OLD_SOMETHING_NEW\x10\x02\x12\x15\n" +
+	"\x11DOUBLE_YOUR_MONEY\x10\x03\x12\x0f\n" +
+	"\vONE_STEP_UP\x10\x04\x12\x14\n" +
+	"\x10PICK_UP_THE_PACE\x10\x05\x12\x14\n" +
+	"\x10A_HIDDEN_MESSAGE\x10\x06\x12\x18\n" +
 	"\x14SOMETHINGS_DIFFERENT\x10\a\x12\x12\n" +
 	"\x0eONE_GIANT_LEAP\x10\b\x12\r\n" +
 	"\tFAIR_GAME\x10\t\x12\x17\n" +
@@ -636,7 +1941,23 @@ const file_proto_game_config_proto_rawDesc = "" +
 	"A_MAZE_ING\x10\x1d\x12\r\n" +
 	"\tSNIP_SNAP\x10\x1e\x12\x11\n" +
 	"\rRAINBOW_TABLE\x10\x1f\x12\x14\n" +
-	"\x10BLINKENLIGHTS_II\x10 B\tZ\a./protob\x06proto3"
+	"\x10BLINKENLIGHTS_II\x10 *(\n" +
+	"\bBombMode\x12\f\n" +
+	"\bPARALLEL\x10\x00\x12\x0e\n" +
+	"\n" +
+	"SEQUENTIAL\x10\x01*(\n" +
+	"\x0fChallengePeriod\x12\t\n" +
+	"\x05DAILY\x10\x00\x12\n" +
+	"\n" +
+	"\x06WEEKLY\x10\x01*\x96\x01\n" +
+	"\bModifier\x12\x18\n" +
+	"\x14MODIFIER_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eHIDDEN_STRIKES\x10\x01\x12\x10\n" +
+	"\fHIDDEN_TIMER\x10\x02\x12\x17\n" +
+	"\x13EDGEWORK_ON_REQUEST\x10\x03\x12\x19\n" +
+	"\x15ONE_STRIKE_PER_MODULE\x10\x04\x12\a\n" +
+	"\x03ZEN\x10\x05\x12\r\n" +
+	"\tTIME_MODE\x10\x06B\tZ\a./protob\x06proto3"
 
 var (
 	file_proto_game_config_proto_rawDescOnce sync.Once
@@ -650,30 +1971,71 @@ func file_proto_game_config_proto_rawDescGZIP() []byte {
 	return file_proto_game_config_proto_rawDescData
 }
 
-var file_proto_game_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_game_config_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_game_config_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_game_config_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_proto_game_config_proto_goTypes = []any{
-	(Mission)(0),                // 0: game_config.Mission
-	(*LevelConfig)(nil),         // 1: game_config.LevelConfig
-	(*PresetMissionConfig)(nil), // 2: game_config.PresetMissionConfig
-	(*ModuleSpec)(nil),          // 3: game_config.ModuleSpec
-	(*CustomBombConfig)(nil),    // 4: game_config.CustomBombConfig
-	(*GameConfig)(nil),          // 5: game_config.GameConfig
-	(Module_ModuleType)(0),      // 6: modules.Module.ModuleType
+	(Mission)(0),                   // 0: game_config.Mission
+	(BombMode)(0),                  // 1: game_config.BombMode
+	(ChallengePeriod)(0),           // 2: game_config.ChallengePeriod
+	(Modifier)(0),                  // 3: game_config.Modifier
+	(*LevelConfig)(nil),            // 4: game_config.LevelConfig
+	(*PresetMissionConfig)(nil),    // 5: game_config.PresetMissionConfig
+	(*ModuleSpec)(nil),             // 6: game_config.ModuleSpec
+	(*CustomBombConfig)(nil),       // 7: game_config.CustomBombConfig
+	(*ListMissionsRequest)(nil),    // 8: game_config.ListMissionsRequest
+	(*MissionInfo)(nil),            // 9: game_config.MissionInfo
+	(*ListMissionsResponse)(nil),   // 10: game_config.ListMissionsResponse
+	(*DescribeConfigRequest)(nil),  // 11: game_config.DescribeConfigRequest
+	(*ConfigValidationError)(nil),  // 12: game_config.ConfigValidationError
+	(*PlannedModule)(nil),          // 13: game_config.PlannedModule
+	(*PlannedBomb)(nil),            // 14: game_config.PlannedBomb
+	(*DescribeConfigResponse)(nil), // 15: game_config.DescribeConfigResponse
+	(*ModuleCount)(nil),            // 16: game_config.ModuleCount
+	(*GeneratedConfigInfo)(nil),    // 17: game_config.GeneratedConfigInfo
+	(*PracticeConfig)(nil),         // 18: game_config.PracticeConfig
+	(*ChallengeConfig)(nil),        // 19: game_config.ChallengeConfig
+	(*BombCodeConfig)(nil),         // 20: game_config.BombCodeConfig
+	(*GameConfig)(nil),             // 21: game_config.GameConfig
+	(Module_ModuleType)(0),         // 22: modules.Module.ModuleType
+	(*ModulePosition)(nil),         // 23: modules.ModulePosition
 }
 var file_proto_game_config_proto_depIdxs = []int32{
-	0, // 0: game_config.PresetMissionConfig.mission:type_name -> game_config.Mission
-	6, // 1: game_config.ModuleSpec.type:type_name -> modules.Module.ModuleType
-	6, // 2: game_config.ModuleSpec.possible_types:type_name -> modules.Module.ModuleType
-	3, // 3: game_config.CustomBombConfig.modules:type_name -> game_config.ModuleSpec
-	1, // 4: game_config.GameConfig.level:type_name -> game_config.LevelConfig
-	2, // 5: game_config.GameConfig.preset:type_name -> game_config.PresetMissionConfig
-	4, // 6: game_config.GameConfig.custom:type_name -> game_config.CustomBombConfig
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	0,  // 0: game_config.PresetMissionConfig.mission:type_name -> game_config.Mission
+	1,  // 1: game_config.PresetMissionConfig.bomb_mode:type_name -> game_config.BombMode
+	22, // 2: game_config.ModuleSpec.type:type_name -> modules.Module.ModuleType
+	22, // 3: game_config.ModuleSpec.possible_types:type_name -> modules.Module.ModuleType
+	6,  // 4: game_config.CustomBombConfig.modules:type_name -> game_config.ModuleSpec
+	1,  // 5: game_config.CustomBombConfig.bomb_mode:type_name -> game_config.BombMode
+	0,  // 6: game_config.MissionInfo.mission:type_name -> game_config.Mission
+	1,  // 7: game_config.MissionInfo.bomb_mode:type_name -> game_config.BombMode
+	6,  // 8: game_config.MissionInfo.modules:type_name -> game_config.ModuleSpec
+	9,  // 9: game_config.ListMissionsResponse.missions:type_name -> game_config.MissionInfo
+	21, // 10: game_config.DescribeConfigRequest.config:type_name -> game_config.GameConfig
+	22, // 11: game_config.PlannedModule.type:type_name -> modules.Module.ModuleType
+	23, // 12: game_config.PlannedModule.position:type_name -> modules.ModulePosition
+	7,  // 13: game_config.PlannedBomb.config:type_name -> game_config.CustomBombConfig
+	13, // 14: game_config.PlannedBomb.modules:type_name -> game_config.PlannedModule
+	1,  // 15: game_config.DescribeConfigResponse.bomb_mode:type_name -> game_config.BombMode
+	12, // 16: game_config.DescribeConfigResponse.validation_errors:type_name -> game_config.ConfigValidationError
+	14, // 17: game_config.DescribeConfigResponse.bombs:type_name -> game_config.PlannedBomb
+	22, // 18: game_config.ModuleCount.type:type_name -> modules.Module.ModuleType
+	16, // 19: game_config.GeneratedConfigInfo.module_counts:type_name -> game_config.ModuleCount
+	1,  // 20: game_config.GeneratedConfigInfo.bomb_mode:type_name -> game_config.BombMode
+	22, // 21: game_config.PracticeConfig.module_type:type_name -> modules.Module.ModuleType
+	2,  // 22: game_config.ChallengeConfig.period:type_name -> game_config.ChallengePeriod
+	1,  // 23: game_config.BombCodeConfig.bomb_mode:type_name -> game_config.BombMode
+	4,  // 24: game_config.GameConfig.level:type_name -> game_config.LevelConfig
+	5,  // 25: game_config.GameConfig.preset:type_name -> game_config.PresetMissionConfig
+	7,  // 26: game_config.GameConfig.custom:type_name -> game_config.CustomBombConfig
+	18, // 27: game_config.GameConfig.practice:type_name -> game_config.PracticeConfig
+	19, // 28: game_config.GameConfig.challenge:type_name -> game_config.ChallengeConfig
+	20, // 29: game_config.GameConfig.bomb_codes:type_name -> game_config.BombCodeConfig
+	3,  // 30: game_config.GameConfig.modifiers:type_name -> game_config.Modifier
+	31, // [31:31] is the sub-list for method output_type
+	31, // [31:31] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
 }
 
 func init() { file_proto_game_config_proto_init() }
@@ -682,18 +2044,21 @@ func file_proto_game_config_proto_init() {
 		return
 	}
 	file_proto_modules_proto_init()
-	file_proto_game_config_proto_msgTypes[4].OneofWrappers = []any{
+	file_proto_game_config_proto_msgTypes[17].OneofWrappers = []any{
 		(*GameConfig_Level)(nil),
 		(*GameConfig_Preset)(nil),
 		(*GameConfig_Custom)(nil),
+		(*GameConfig_Practice)(nil),
+		(*GameConfig_Challenge)(nil),
+		(*GameConfig_BombCodes)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_game_config_proto_rawDesc), len(file_proto_game_config_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      4,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,9 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	GameService_CreateGame_FullMethodName = "/game.GameService/CreateGame"
-	GameService_GetBombs_FullMethodName   = "/game.GameService/GetBombs"
-	GameService_SendInput_FullMethodName  = "/game.GameService/SendInput"
+	GameService_CreateGame_FullMethodName              = "/game.GameService/CreateGame"
+	GameService_GetBombs_FullMethodName                = "/game.GameService/GetBombs"
+	GameService_GetGameReport_FullMethodName           = "/game.GameService/GetGameReport"
+	GameService_JoinSession_FullMethodName             = "/game.GameService/JoinSession"
+	GameService_SetReady_FullMethodName                = "/game.GameService/SetReady"
+	GameService_AssignFaces_FullMethodName             = "/game.GameService/AssignFaces"
+	GameService_StartGame_FullMethodName               = "/game.GameService/StartGame"
+	GameService_PauseGame_FullMethodName               = "/game.GameService/PauseGame"
+	GameService_ResumeGame_FullMethodName              = "/game.GameService/ResumeGame"
+	GameService_RevealEdgework_FullMethodName          = "/game.GameService/RevealEdgework"
+	GameService_WatchSession_FullMethodName            = "/game.GameService/WatchSession"
+	GameService_CreateMatch_FullMethodName             = "/game.GameService/CreateMatch"
+	GameService_StartMatch_FullMethodName              = "/game.GameService/StartMatch"
+	GameService_JoinMatch_FullMethodName               = "/game.GameService/JoinMatch"
+	GameService_GetScoreboard_FullMethodName           = "/game.GameService/GetScoreboard"
+	GameService_WatchMatch_FullMethodName              = "/game.GameService/WatchMatch"
+	GameService_SendInput_FullMethodName               = "/game.GameService/SendInput"
+	GameService_ListMissions_FullMethodName            = "/game.GameService/ListMissions"
+	GameService_DescribeConfig_FullMethodName          = "/game.GameService/DescribeConfig"
+	GameService_SubmitResult_FullMethodName            = "/game.GameService/SubmitResult"
+	GameService_GetLeaderboard_FullMethodName          = "/game.GameService/GetLeaderboard"
+	GameService_GetDailyChallenge_FullMethodName       = "/game.GameService/GetDailyChallenge"
+	GameService_RegisterChallengePlayer_FullMethodName = "/game.GameService/RegisterChallengePlayer"
 )
 
 // GameServiceClient is the client API for GameService service.
@@ -30,7 +50,33 @@ const (
 type GameServiceClient interface {
 	CreateGame(ctx context.Context, in *CreateGameRequest, opts ...grpc.CallOption) (*CreateGameResponse, error)
 	GetBombs(ctx context.Context, in *GetBombsRequest, opts ...grpc.CallOption) (*GetBombsResponse, error)
+	GetGameReport(ctx context.Context, in *GetGameReportRequest, opts ...grpc.CallOption) (*GameReport, error)
+	JoinSession(ctx context.Context, in *JoinSessionRequest, opts ...grpc.CallOption) (*LobbyState, error)
+	SetReady(ctx context.Context, in *SetReadyRequest, opts ...grpc.CallOption) (*LobbyState, error)
+	AssignFaces(ctx context.Context, in *AssignFacesRequest, opts ...grpc.CallOption) (*LobbyState, error)
+	StartGame(ctx context.Context, in *StartGameRequest, opts ...grpc.CallOption) (*LobbyState, error)
+	PauseGame(ctx context.Context, in *PauseGameRequest, opts ...grpc.CallOption) (*GetBombsResponse, error)
+	ResumeGame(ctx context.Context, in *ResumeGameRequest, opts ...grpc.CallOption) (*GetBombsResponse, error)
+	// Shows a bomb's edgework in a game played with the EDGEWORK_ON_REQUEST
+	// modifier
+	RevealEdgework(ctx context.Context, in *RevealEdgeworkRequest, opts ...grpc.CallOption) (*GetBombsResponse, error)
+	// Streams a session's events to a spectator, delayed by the session's
+	// spectator delay
+	WatchSession(ctx context.Context, in *WatchSessionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SessionEvent], error)
+	CreateMatch(ctx context.Context, in *CreateMatchRequest, opts ...grpc.CallOption) (*CreateMatchResponse, error)
+	StartMatch(ctx context.Context, in *StartMatchRequest, opts ...grpc.CallOption) (*Scoreboard, error)
+	JoinMatch(ctx context.Context, in *JoinMatchRequest, opts ...grpc.CallOption) (*MatchTeam, error)
+	GetScoreboard(ctx context.Context, in *GetScoreboardRequest, opts ...grpc.CallOption) (*Scoreboard, error)
+	// Streams the scoreboard every time it changes, ending once the match is
+	// finished
+	WatchMatch(ctx context.Context, in *WatchMatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Scoreboard], error)
 	SendInput(ctx context.Context, in *PlayerInput, opts ...grpc.CallOption) (*PlayerInputResult, error)
+	ListMissions(ctx context.Context, in *ListMissionsRequest, opts ...grpc.CallOption) (*ListMissionsResponse, error)
+	DescribeConfig(ctx context.Context, in *DescribeConfigRequest, opts ...grpc.CallOption) (*DescribeConfigResponse, error)
+	SubmitResult(ctx context.Context, in *SubmitResultRequest, opts ...grpc.CallOption) (*SubmitResultResponse, error)
+	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	GetDailyChallenge(ctx context.Context, in *GetDailyChallengeRequest, opts ...grpc.CallOption) (*Challenge, error)
+	RegisterChallengePlayer(ctx context.Context, in *RegisterChallengePlayerRequest, opts ...grpc.CallOption) (*ChallengePlayer, error)
 }
 
 type gameServiceClient struct {
@@ -61,6 +107,164 @@ func (c *gameServiceClient) GetBombs(ctx context.Context, in *GetBombsRequest, o
 	return out, nil
 }
 
+func (c *gameServiceClient) GetGameReport(ctx context.Context, in *GetGameReportRequest, opts ...grpc.CallOption) (*GameReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GameReport)
+	err := c.cc.Invoke(ctx, GameService_GetGameReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) JoinSession(ctx context.Context, in *JoinSessionRequest, opts ...grpc.CallOption) (*LobbyState, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LobbyState)
+	err := c.cc.Invoke(ctx, GameService_JoinSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SetReady(ctx context.Context, in *SetReadyRequest, opts ...grpc.CallOption) (*LobbyState, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LobbyState)
+	err := c.cc.Invoke(ctx, GameService_SetReady_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) AssignFaces(ctx context.Context, in *AssignFacesRequest, opts ...grpc.CallOption) (*LobbyState, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LobbyState)
+	err := c.cc.Invoke(ctx, GameService_AssignFaces_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) StartGame(ctx context.Context, in *StartGameRequest, opts ...grpc.CallOption) (*LobbyState, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LobbyState)
+	err := c.cc.Invoke(ctx, GameService_StartGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) PauseGame(ctx context.Context, in *PauseGameRequest, opts ...grpc.CallOption) (*GetBombsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBombsResponse)
+	err := c.cc.Invoke(ctx, GameService_PauseGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) ResumeGame(ctx context.Context, in *ResumeGameRequest, opts ...grpc.CallOption) (*GetBombsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBombsResponse)
+	err := c.cc.Invoke(ctx, GameService_ResumeGame_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RevealEdgework(ctx context.Context, in *RevealEdgeworkRequest, opts ...grpc.CallOption) (*GetBombsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBombsResponse)
+	err := c.cc.Invoke(ctx, GameService_RevealEdgework_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) WatchSession(ctx context.Context, in *WatchSessionRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SessionEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameService_ServiceDesc.Streams[0], GameService_WatchSession_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchSessionRequest, SessionEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_WatchSessionClient = grpc.ServerStreamingClient[SessionEvent]
+
+func (c *gameServiceClient) CreateMatch(ctx context.Context, in *CreateMatchRequest, opts ...grpc.CallOption) (*CreateMatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMatchResponse)
+	err := c.cc.Invoke(ctx, GameService_CreateMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) StartMatch(ctx context.Context, in *StartMatchRequest, opts ...grpc.CallOption) (*Scoreboard, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scoreboard)
+	err := c.cc.Invoke(ctx, GameService_StartMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) JoinMatch(ctx context.Context, in *JoinMatchRequest, opts ...grpc.CallOption) (*MatchTeam, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchTeam)
+	err := c.cc.Invoke(ctx, GameService_JoinMatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetScoreboard(ctx context.Context, in *GetScoreboardRequest, opts ...grpc.CallOption) (*Scoreboard, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Scoreboard)
+	err := c.cc.Invoke(ctx, GameService_GetScoreboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) WatchMatch(ctx context.Context, in *WatchMatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Scoreboard], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &GameService_ServiceDesc.Streams[1], GameService_WatchMatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchMatchRequest, Scoreboard]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_WatchMatchClient = grpc.ServerStreamingClient[Scoreboard]
+
 func (c *gameServiceClient) SendInput(ctx context.Context, in *PlayerInput, opts ...grpc.CallOption) (*PlayerInputResult, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PlayerInputResult)
@@ -71,13 +275,99 @@ func (c *gameServiceClient) SendInput(ctx context.Context, in *PlayerInput, opts
 	return out, nil
 }
 
+func (c *gameServiceClient) ListMissions(ctx context.Context, in *ListMissionsRequest, opts ...grpc.CallOption) (*ListMissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMissionsResponse)
+	err := c.cc.Invoke(ctx, GameService_ListMissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) DescribeConfig(ctx context.Context, in *DescribeConfigRequest, opts ...grpc.CallOption) (*DescribeConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeConfigResponse)
+	err := c.cc.Invoke(ctx, GameService_DescribeConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) SubmitResult(ctx context.Context, in *SubmitResultRequest, opts ...grpc.CallOption) (*SubmitResultResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitResultResponse)
+	err := c.cc.Invoke(ctx, GameService_SubmitResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLeaderboardResponse)
+	err := c.cc.Invoke(ctx, GameService_GetLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) GetDailyChallenge(ctx context.Context, in *GetDailyChallengeRequest, opts ...grpc.CallOption) (*Challenge, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Challenge)
+	err := c.cc.Invoke(ctx, GameService_GetDailyChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameServiceClient) RegisterChallengePlayer(ctx context.Context, in *RegisterChallengePlayerRequest, opts ...grpc.CallOption) (*ChallengePlayer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChallengePlayer)
+	err := c.cc.Invoke(ctx, GameService_RegisterChallengePlayer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GameServiceServer is the server API for GameService service.
 // All implementations must embed UnimplementedGameServiceServer
 // for forward compatibility.
 type GameServiceServer interface {
 	CreateGame(context.Context, *CreateGameRequest) (*CreateGameResponse, error)
 	GetBombs(context.Context, *GetBombsRequest) (*GetBombsResponse, error)
+	GetGameReport(context.Context, *GetGameReportRequest) (*GameReport, error)
+	JoinSession(context.Context, *JoinSessionRequest) (*LobbyState, error)
+	SetReady(context.Context, *SetReadyRequest) (*LobbyState, error)
+	AssignFaces(context.Context, *AssignFacesRequest) (*LobbyState, error)
+	StartGame(context.Context, *StartGameRequest) (*LobbyState, error)
+	PauseGame(context.Context, *PauseGameRequest) (*GetBombsResponse, error)
+	ResumeGame(context.Context, *ResumeGameRequest) (*GetBombsResponse, error)
+	// Shows a bomb's edgework in a game played with the EDGEWORK_ON_REQUEST
+	// modifier
+	RevealEdgework(context.Context, *RevealEdgeworkRequest) (*GetBombsResponse, error)
+	// Streams a session's events to a spectator, delayed by the session's
+	// spectator delay
+	WatchSession(*WatchSessionRequest, grpc.ServerStreamingServer[SessionEvent]) error
+	CreateMatch(context.Context, *CreateMatchRequest) (*CreateMatchResponse, error)
+	StartMatch(context.Context, *StartMatchRequest) (*Scoreboard, error)
+	JoinMatch(context.Context, *JoinMatchRequest) (*MatchTeam, error)
+	GetScoreboard(context.Context, *GetScoreboardRequest) (*Scoreboard, error)
+	// Streams the scoreboard every time it changes, ending once the match is
+	// finished
+	WatchMatch(*WatchMatchRequest, grpc.ServerStreamingServer[Scoreboard]) error
 	SendInput(context.Context, *PlayerInput) (*PlayerInputResult, error)
+	ListMissions(context.Context, *ListMissionsRequest) (*ListMissionsResponse, error)
+	DescribeConfig(context.Context, *DescribeConfigRequest) (*DescribeConfigResponse, error)
+	SubmitResult(context.Context, *SubmitResultRequest) (*SubmitResultResponse, error)
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	GetDailyChallenge(context.Context, *GetDailyChallengeRequest) (*Challenge, error)
+	RegisterChallengePlayer(context.Context, *RegisterChallengePlayerRequest) (*ChallengePlayer, error)
 	mustEmbedUnimplementedGameServiceServer()
 }
 
@@ -94,9 +384,69 @@ func (UnimplementedGameServiceServer) CreateGame(context.Context, *CreateGameReq
 func (UnimplementedGameServiceServer) GetBombs(context.Context, *GetBombsRequest) (*GetBombsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetBombs not implemented")
 }
+func (UnimplementedGameServiceServer) GetGameReport(context.Context, *GetGameReportRequest) (*GameReport, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetGameReport not implemented")
+}
+func (UnimplementedGameServiceServer) JoinSession(context.Context, *JoinSessionRequest) (*LobbyState, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinSession not implemented")
+}
+func (UnimplementedGameServiceServer) SetReady(context.Context, *SetReadyRequest) (*LobbyState, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetReady not implemented")
+}
+func (UnimplementedGameServiceServer) AssignFaces(context.Context, *AssignFacesRequest) (*LobbyState, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignFaces not implemented")
+}
+func (UnimplementedGameServiceServer) StartGame(context.Context, *StartGameRequest) (*LobbyState, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartGame not implemented")
+}
+func (UnimplementedGameServiceServer) PauseGame(context.Context, *PauseGameRequest) (*GetBombsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PauseGame not implemented")
+}
+func (UnimplementedGameServiceServer) ResumeGame(context.Context, *ResumeGameRequest) (*GetBombsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResumeGame not implemented")
+}
+func (UnimplementedGameServiceServer) RevealEdgework(context.Context, *RevealEdgeworkRequest) (*GetBombsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevealEdgework not implemented")
+}
+func (UnimplementedGameServiceServer) WatchSession(*WatchSessionRequest, grpc.ServerStreamingServer[SessionEvent]) error {
+	return status.Error(codes.Unimplemented, "method WatchSession not implemented")
+}
+func (UnimplementedGameServiceServer) CreateMatch(context.Context, *CreateMatchRequest) (*CreateMatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateMatch not implemented")
+}
+func (UnimplementedGameServiceServer) StartMatch(context.Context, *StartMatchRequest) (*Scoreboard, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartMatch not implemented")
+}
+func (UnimplementedGameServiceServer) JoinMatch(context.Context, *JoinMatchRequest) (*MatchTeam, error) {
+	return nil, status.Error(codes.Unimplemented, "method JoinMatch not implemented")
+}
+func (UnimplementedGameServiceServer) GetScoreboard(context.Context, *GetScoreboardRequest) (*Scoreboard, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetScoreboard not implemented")
+}
+func (UnimplementedGameServiceServer) WatchMatch(*WatchMatchRequest, grpc.ServerStreamingServer[Scoreboard]) error {
+	return status.Error(codes.Unimplemented, "method WatchMatch not implemented")
+}
 func (UnimplementedGameServiceServer) SendInput(context.Context, *PlayerInput) (*PlayerInputResult, error) {
 	return nil, status.Error(codes.Unimplemented, "method SendInput not implemented")
 }
+func (UnimplementedGameServiceServer) ListMissions(context.Context, *ListMissionsRequest) (*ListMissionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListMissions not implemented")
+}
+func (UnimplementedGameServiceServer) DescribeConfig(context.Context, *DescribeConfigRequest) (*DescribeConfigResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DescribeConfig not implemented")
+}
+func (UnimplementedGameServiceServer) SubmitResult(context.Context, *SubmitResultRequest) (*SubmitResultResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitResult not implemented")
+}
+func (UnimplementedGameServiceServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedGameServiceServer) GetDailyChallenge(context.Context, *GetDailyChallengeRequest) (*Challenge, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDailyChallenge not implemented")
+}
+func (UnimplementedGameServiceServer) RegisterChallengePlayer(context.Context, *RegisterChallengePlayerRequest) (*ChallengePlayer, error) {
+	return nil, status.Error(codes.Unimplemented, "method RegisterChallengePlayer not implemented")
+}
 func (UnimplementedGameServiceServer) mustEmbedUnimplementedGameServiceServer() {}
 func (UnimplementedGameServiceServer) testEmbeddedByValue()                     {}
 
@@ -154,6 +504,244 @@ func _GameService_GetBombs_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GameService_GetGameReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetGameReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetGameReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetGameReport(ctx, req.(*GetGameReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_JoinSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).JoinSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_JoinSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).JoinSession(ctx, req.(*JoinSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SetReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReadyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SetReady(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_SetReady_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SetReady(ctx, req.(*SetReadyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_AssignFaces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignFacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).AssignFaces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_AssignFaces_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).AssignFaces(ctx, req.(*AssignFacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_StartGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).StartGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_StartGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).StartGame(ctx, req.(*StartGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_PauseGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).PauseGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_PauseGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).PauseGame(ctx, req.(*PauseGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_ResumeGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ResumeGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ResumeGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ResumeGame(ctx, req.(*ResumeGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RevealEdgework_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealEdgeworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RevealEdgework(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RevealEdgework_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RevealEdgework(ctx, req.(*RevealEdgeworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_WatchSession_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchSessionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServiceServer).WatchSession(m, &grpc.GenericServerStream[WatchSessionRequest, SessionEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_WatchSessionServer = grpc.ServerStreamingServer[SessionEvent]
+
+func _GameService_CreateMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).CreateMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_CreateMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).CreateMatch(ctx, req.(*CreateMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_StartMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).StartMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_StartMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).StartMatch(ctx, req.(*StartMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_JoinMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).JoinMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_JoinMatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).JoinMatch(ctx, req.(*JoinMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetScoreboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScoreboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetScoreboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetScoreboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetScoreboard(ctx, req.(*GetScoreboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_WatchMatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GameServiceServer).WatchMatch(m, &grpc.GenericServerStream[WatchMatchRequest, Scoreboard]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type GameService_WatchMatchServer = grpc.ServerStreamingServer[Scoreboard]
+
 func _GameService_SendInput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PlayerInput)
 	if err := dec(in); err != nil {
@@ -172,6 +760,114 @@ func _GameService_SendInput_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GameService_ListMissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).ListMissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_ListMissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).ListMissions(ctx, req.(*ListMissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_DescribeConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).DescribeConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_DescribeConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).DescribeConfig(ctx, req.(*DescribeConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_SubmitResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).SubmitResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_SubmitResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).SubmitResult(ctx, req.(*SubmitResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetLeaderboard(ctx, req.(*GetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_GetDailyChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDailyChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).GetDailyChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_GetDailyChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).GetDailyChallenge(ctx, req.(*GetDailyChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GameService_RegisterChallengePlayer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterChallengePlayerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServiceServer).RegisterChallengePlayer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GameService_RegisterChallengePlayer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServiceServer).RegisterChallengePlayer(ctx, req.(*RegisterChallengePlayerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GameService_ServiceDesc is the grpc.ServiceDesc for GameService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -187,11 +883,94 @@ var GameService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBombs",
 			Handler:    _GameService_GetBombs_Handler,
 		},
+		{
+			MethodName: "GetGameReport",
+			Handler:    _GameService_GetGameReport_Handler,
+		},
+		{
+			MethodName: "JoinSession",
+			Handler:    _GameService_JoinSession_Handler,
+		},
+		{
+			MethodName: "SetReady",
+			Handler:    _GameService_SetReady_Handler,
+		},
+		{
+			MethodName: "AssignFaces",
+			Handler:    _GameService_AssignFaces_Handler,
+		},
+		{
+			MethodName: "StartGame",
+			Handler:    _GameService_StartGame_Handler,
+		},
+		{
+			MethodName: "PauseGame",
+			Handler:    _GameService_PauseGame_Handler,
+		},
+		{
+			MethodName: "ResumeGame",
+			Handler:    _GameService_ResumeGame_Handler,
+		},
+		{
+			MethodName: "RevealEdgework",
+			Handler:    _GameService_RevealEdgework_Handler,
+		},
+		{
+			MethodName: "CreateMatch",
+			Handler:    _GameService_CreateMatch_Handler,
+		},
+		{
+			MethodName: "StartMatch",
+			Handler:    _GameService_StartMatch_Handler,
+		},
+		{
+			MethodName: "JoinMatch",
+			Handler:    _GameService_JoinMatch_Handler,
+		},
+		{
+			MethodName: "GetScoreboard",
+			Handler:    _GameService_GetScoreboard_Handler,
+		},
 		{
 			MethodName: "SendInput",
 			Handler:    _GameService_SendInput_Handler,
 		},
+		{
+			MethodName: "ListMissions",
+			Handler:    _GameService_ListMissions_Handler,
+		},
+		{
+			MethodName: "DescribeConfig",
+			Handler:    _GameService_DescribeConfig_Handler,
+		},
+		{
+			MethodName: "SubmitResult",
+			Handler:    _GameService_SubmitResult_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _GameService_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetDailyChallenge",
+			Handler:    _GameService_GetDailyChallenge_Handler,
+		},
+		{
+			MethodName: "RegisterChallengePlayer",
+			Handler:    _GameService_RegisterChallengePlayer_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchSession",
+			Handler:       _GameService_WatchSession_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchMatch",
+			Handler:       _GameService_WatchMatch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/game.proto",
 }
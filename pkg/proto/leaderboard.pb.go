@@ -0,0 +1,482 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: proto/leaderboard.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SubmitResultRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The session must be completed. Its result is read from the server, never
+	// taken from the request.
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// 1-32 characters
+	TeamName      string `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitResultRequest) Reset() {
+	*x = SubmitResultRequest{}
+	mi := &file_proto_leaderboard_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitResultRequest) ProtoMessage() {}
+
+func (x *SubmitResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitResultRequest.ProtoReflect.Descriptor instead.
+func (*SubmitResultRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SubmitResultRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SubmitResultRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type SubmitResultResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entry         *LeaderboardEntry      `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitResultResponse) Reset() {
+	*x = SubmitResultResponse{}
+	mi := &file_proto_leaderboard_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitResultResponse) ProtoMessage() {}
+
+func (x *SubmitResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitResultResponse.ProtoReflect.Descriptor instead.
+func (*SubmitResultResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SubmitResultResponse) GetEntry() *LeaderboardEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+type GetLeaderboardRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Leaderboard:
+	//
+	//	*GetLeaderboardRequest_Level
+	//	*GetLeaderboardRequest_Mission
+	//	*GetLeaderboardRequest_MissionId
+	//	*GetLeaderboardRequest_ConfigHash
+	Leaderboard isGetLeaderboardRequest_Leaderboard `protobuf_oneof:"leaderboard"`
+	// Maximum number of entries to return. 0 returns every entry.
+	Limit         int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	mi := &file_proto_leaderboard_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetLeaderboardRequest) GetLeaderboard() isGetLeaderboardRequest_Leaderboard {
+	if x != nil {
+		return x.Leaderboard
+	}
+	return nil
+}
+
+func (x *GetLeaderboardRequest) GetLevel() int32 {
+	if x != nil {
+		if x, ok := x.Leaderboard.(*GetLeaderboardRequest_Level); ok {
+			return x.Level
+		}
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetMission() Mission {
+	if x != nil {
+		if x, ok := x.Leaderboard.(*GetLeaderboardRequest_Mission); ok {
+			return x.Mission
+		}
+	}
+	return Mission_MISSION_UNSPECIFIED
+}
+
+func (x *GetLeaderboardRequest) GetMissionId() string {
+	if x != nil {
+		if x, ok := x.Leaderboard.(*GetLeaderboardRequest_MissionId); ok {
+			return x.MissionId
+		}
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetConfigHash() string {
+	if x != nil {
+		if x, ok := x.Leaderboard.(*GetLeaderboardRequest_ConfigHash); ok {
+			return x.ConfigHash
+		}
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type isGetLeaderboardRequest_Leaderboard interface {
+	isGetLeaderboardRequest_Leaderboard()
+}
+
+type GetLeaderboardRequest_Level struct {
+	Level int32 `protobuf:"varint,1,opt,name=level,proto3,oneof"`
+}
+
+type GetLeaderboardRequest_Mission struct {
+	Mission Mission `protobuf:"varint,2,opt,name=mission,proto3,enum=game_config.Mission,oneof"`
+}
+
+type GetLeaderboardRequest_MissionId struct {
+	// "<pack>/<mission>" ID of a mission pack mission
+	MissionId string `protobuf:"bytes,3,opt,name=mission_id,json=missionId,proto3,oneof"`
+}
+
+type GetLeaderboardRequest_ConfigHash struct {
+	// Config hash returned when a custom game is created
+	ConfigHash string `protobuf:"bytes,4,opt,name=config_hash,json=configHash,proto3,oneof"`
+}
+
+func (*GetLeaderboardRequest_Level) isGetLeaderboardRequest_Leaderboard() {}
+
+func (*GetLeaderboardRequest_Mission) isGetLeaderboardRequest_Leaderboard() {}
+
+func (*GetLeaderboardRequest_MissionId) isGetLeaderboardRequest_Leaderboard() {}
+
+func (*GetLeaderboardRequest_ConfigHash) isGetLeaderboardRequest_Leaderboard() {}
+
+type GetLeaderboardResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Best first
+	Entries       []*LeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLeaderboardResponse) Reset() {
+	*x = GetLeaderboardResponse{}
+	mi := &file_proto_leaderboard_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetLeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type LeaderboardEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 1 is the best result. Only set in leaderboard listings.
+	Rank     int32  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	TeamName string `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// Time left across every bomb in the session
+	TimeRemainingMs int64 `protobuf:"varint,3,opt,name=time_remaining_ms,json=timeRemainingMs,proto3" json:"time_remaining_ms,omitempty"`
+	// Strikes across every bomb in the session
+	Strikes   int32  `protobuf:"varint,4,opt,name=strikes,proto3" json:"strikes,omitempty"`
+	Seed      string `protobuf:"bytes,5,opt,name=seed,proto3" json:"seed,omitempty"`
+	SessionId string `protobuf:"bytes,6,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Unix time in milliseconds
+	SubmittedAtMs int64 `protobuf:"varint,7,opt,name=submitted_at_ms,json=submittedAtMs,proto3" json:"submitted_at_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_proto_leaderboard_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_leaderboard_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_proto_leaderboard_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetTimeRemainingMs() int64 {
+	if x != nil {
+		return x.TimeRemainingMs
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetStrikes() int32 {
+	if x != nil {
+		return x.Strikes
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetSeed() string {
+	if x != nil {
+		return x.Seed
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetSubmittedAtMs() int64 {
+	if x != nil {
+		return x.SubmittedAtMs
+	}
+	return 0
+}
+
+var File_proto_leaderboard_proto protoreflect.FileDescriptor
+
+const file_proto_leaderboard_proto_rawDesc = "" +
+	"\n" +
+	"\x17proto/leaderboard.proto\x12\vleaderboard\x1a\x17proto/game_config.proto\"Q\n" +
+	"\x13SubmitResultRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\"K\n" +
+	"\x14SubmitResultResponse\x123\n" +
+	"\x05entry\x18\x01 \x01(\v2\x1d.leaderboard.LeaderboardEntryR\x05entry\"\xca\x01\n" +
+	"\x15GetLeaderboardRequest\x12\x16\n" +
+	"\x05level\x18\x01 \x01(\x05H\x00R\x05level\x120\n" +
+	"\amission\x18\x02 \x01(\x0e2\x14.game_config.MissionH\x00R\amission\x12\x1f\n" +
+	"\n" +
+	"mission_id\x18\x03 \x01(\tH\x00R\tmissionId\x12!\n" +
+	"\vconfig_hash\x18\x04 \x01(\tH\x00R\n" +
+	"configHash\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limitB\r\n" +
+	"\vleaderboard\"Q\n" +
+	"\x16GetLeaderboardResponse\x127\n" +
+	"\aentries\x18\x01 \x03(\v2\x1d.leaderboard.LeaderboardEntryR\aentries\"\xe4\x01\n" +
+	"\x10LeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\x12*\n" +
+	"\x11time_remaining_ms\x18\x03 \x01(\x03R\x0ftimeRemainingMs\x12\x18\n" +
+	"\astrikes\x18\x04 \x01(\x05R\astrikes\x12\x12\n" +
+	"\x04seed\x18\x05 \x01(\tR\x04seed\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x06 \x01(\tR\tsessionId\x12&\n" +
+	"\x0fsubmitted_at_ms\x18\a \x01(\x03R\rsubmittedAtMsB\tZ\a./protob\x06proto3"
+
+var (
+	file_proto_leaderboard_proto_rawDescOnce sync.Once
+	file_proto_leaderboard_proto_rawDescData []byte
+)
+
+func file_proto_leaderboard_proto_rawDescGZIP() []byte {
+	file_proto_leaderboard_proto_rawDescOnce.Do(func() {
+		file_proto_leaderboard_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_leaderboard_proto_rawDesc), len(file_proto_leaderboard_proto_rawDesc)))
+	})
+	return file_proto_leaderboard_proto_rawDescData
+}
+
+var file_proto_leaderboard_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_leaderboard_proto_goTypes = []any{
+	(*SubmitResultRequest)(nil),    // 0: leaderboard.SubmitResultRequest
+	(*SubmitResultResponse)(nil),   // 1: leaderboard.SubmitResultResponse
+	(*GetLeaderboardRequest)(nil),  // 2: leaderboard.GetLeaderboardRequest
+	(*GetLeaderboardResponse)(nil), // 3: leaderboard.GetLeaderboardResponse
+	(*LeaderboardEntry)(nil),       // 4: leaderboard.LeaderboardEntry
+	(Mission)(0),                   // 5: game_config.Mission
+}
+var file_proto_leaderboard_proto_depIdxs = []int32{
+	4, // 0: leaderboard.SubmitResultResponse.entry:type_name -> leaderboard.LeaderboardEntry
+	5, // 1: leaderboard.GetLeaderboardRequest.mission:type_name -> game_config.Mission
+	4, // 2: leaderboard.GetLeaderboardResponse.entries:type_name -> leaderboard.LeaderboardEntry
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_leaderboard_proto_init() }
+func file_proto_leaderboard_proto_init() {
+	if File_proto_leaderboard_proto != nil {
+		return
+	}
+	file_proto_game_config_proto_init()
+	file_proto_leaderboard_proto_msgTypes[2].OneofWrappers = []any{
+		(*GetLeaderboardRequest_Level)(nil),
+		(*GetLeaderboardRequest_Mission)(nil),
+		(*GetLeaderboardRequest_MissionId)(nil),
+		(*GetLeaderboardRequest_ConfigHash)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_leaderboard_proto_rawDesc), len(file_proto_leaderboard_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_leaderboard_proto_goTypes,
+		DependencyIndexes: file_proto_leaderboard_proto_depIdxs,
+		MessageInfos:      file_proto_leaderboard_proto_msgTypes,
+	}.Build()
+	File_proto_leaderboard_proto = out.File
+	file_proto_leaderboard_proto_goTypes = nil
+	file_proto_leaderboard_proto_depIdxs = nil
+}
@@ -0,0 +1,657 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: proto/match.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreateMatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Every team plays bombs built from this config and the same seed. Challenges
+	// can't be played as a match and match sessions can't be paused.
+	Config *GameConfig `protobuf:"bytes,1,opt,name=config,proto3,oneof" json:"config,omitempty"`
+	// Names of the teams racing, between 2 and 8 of them
+	Teams         []string `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMatchRequest) Reset() {
+	*x = CreateMatchRequest{}
+	mi := &file_proto_match_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMatchRequest) ProtoMessage() {}
+
+func (x *CreateMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_match_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMatchRequest.ProtoReflect.Descriptor instead.
+func (*CreateMatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_match_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateMatchRequest) GetConfig() *GameConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *CreateMatchRequest) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type MatchTeam struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The session the team joins and plays in
+	SessionId     string `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MatchTeam) Reset() {
+	*x = MatchTeam{}
+	mi := &file_proto_match_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchTeam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchTeam) ProtoMessage() {}
+
+func (x *MatchTeam) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_match_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchTeam.ProtoReflect.Descriptor instead.
+func (*MatchTeam) Descriptor() ([]byte, []int) {
+	return file_proto_match_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MatchTeam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MatchTeam) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type CreateMatchResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MatchId string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	// Every team's session has the same seed and bombs
+	ConfigInfo *GeneratedConfigInfo `protobuf:"bytes,3,opt,name=config_info,json=configInfo,proto3" json:"config_info,omitempty"`
+	// In the order they were given
+	Teams         []string `protobuf:"bytes,4,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMatchResponse) Reset() {
+	*x = CreateMatchResponse{}
+	mi := &file_proto_match_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMatchResponse) ProtoMessage() {}
+
+func (x *CreateMatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_match_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMatchResponse.ProtoReflect.Descriptor instead.
+func (*CreateMatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_match_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateMatchResponse) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *CreateMatchResponse) GetConfigInfo() *GeneratedConfigInfo {
+	if x != nil {
+		return x.ConfigInfo
+	}
+	return nil
+}
+
+func (x *CreateMatchResponse) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+// Hands a team its session. Only the first to join a team is told it.
+type JoinMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Team          string                 `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinMatchRequest) Reset() {
+	*x = JoinMatchRequest{}
+	mi := &file_proto_match_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinMatchRequest) ProtoMessage() {}
+
+func (x *JoinMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_match_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinMatchRequest.ProtoReflect.Descriptor instead.
+func (*JoinMatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_match_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JoinMatchRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *JoinMatchRequest) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+type StartMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartMatchRequest) Reset() {
+	*x = StartMatchRequest{}
+	mi := &file_proto_match_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMatchRequest) ProtoMessage() {}
+
+func (x *StartMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_match_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMatchRequest.ProtoReflect.Descriptor instead.
+func (*StartMatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_match_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StartMatchRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+type GetScoreboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetScoreboardRequest) Reset() {
+	*x = GetScoreboardRequest{}
+	mi := &file_proto_match_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetScoreboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScoreboardRequest) ProtoMessage() {}
+
+func (x *GetScoreboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_match_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScoreboardRequest.ProtoReflect.Descriptor instead.
+func (*GetScoreboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_match_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetScoreboardRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+type WatchMatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MatchId       string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchMatchRequest) Reset() {
+	*x = WatchMatchRequest{}
+	mi := &file_proto_match_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchMatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchMatchRequest) ProtoMessage() {}
+
+func (x *WatchMatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_match_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchMatchRequest.ProtoReflect.Descriptor instead.
+func (*WatchMatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_match_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchMatchRequest) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+type TeamProgress struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Team  string                 `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	State SessionState           `protobuf:"varint,3,opt,name=state,proto3,enum=session.SessionState" json:"state,omitempty"`
+	// Across every bomb in the team's session
+	ModulesSolved int32 `protobuf:"varint,4,opt,name=modules_solved,json=modulesSolved,proto3" json:"modules_solved,omitempty"`
+	ModulesTotal  int32 `protobuf:"varint,5,opt,name=modules_total,json=modulesTotal,proto3" json:"modules_total,omitempty"`
+	Strikes       int32 `protobuf:"varint,6,opt,name=strikes,proto3" json:"strikes,omitempty"`
+	// Unix time in milliseconds the team defused its last bomb or one of its bombs
+	// exploded
+	FinishedAtMs  *int64 `protobuf:"varint,7,opt,name=finished_at_ms,json=finishedAtMs,proto3,oneof" json:"finished_at_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamProgress) Reset() {
+	*x = TeamProgress{}
+	mi := &file_proto_match_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamProgress) ProtoMessage() {}
+
+func (x *TeamProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_match_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamProgress.ProtoReflect.Descriptor instead.
+func (*TeamProgress) Descriptor() ([]byte, []int) {
+	return file_proto_match_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TeamProgress) GetTeam() string {
+	if x != nil {
+		return x.Team
+	}
+	return ""
+}
+
+func (x *TeamProgress) GetState() SessionState {
+	if x != nil {
+		return x.State
+	}
+	return SessionState_IN_PROGRESS
+}
+
+func (x *TeamProgress) GetModulesSolved() int32 {
+	if x != nil {
+		return x.ModulesSolved
+	}
+	return 0
+}
+
+func (x *TeamProgress) GetModulesTotal() int32 {
+	if x != nil {
+		return x.ModulesTotal
+	}
+	return 0
+}
+
+func (x *TeamProgress) GetStrikes() int32 {
+	if x != nil {
+		return x.Strikes
+	}
+	return 0
+}
+
+func (x *TeamProgress) GetFinishedAtMs() int64 {
+	if x != nil && x.FinishedAtMs != nil {
+		return *x.FinishedAtMs
+	}
+	return 0
+}
+
+// Every team's progress in a match. Matches start once every team is ready or
+// when StartMatch is called.
+type Scoreboard struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	MatchId string                 `protobuf:"bytes,1,opt,name=match_id,json=matchId,proto3" json:"match_id,omitempty"`
+	Teams   []*TeamProgress        `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
+	// Unix time in milliseconds the match started, unset while the teams are
+	// waiting in their lobbies
+	StartedAtMs *int64 `protobuf:"varint,3,opt,name=started_at_ms,json=startedAtMs,proto3,oneof" json:"started_at_ms,omitempty"`
+	// The first team to defuse every bomb. Empty if every team's bombs exploded.
+	Winner        string `protobuf:"bytes,4,opt,name=winner,proto3" json:"winner,omitempty"`
+	Finished      bool   `protobuf:"varint,5,opt,name=finished,proto3" json:"finished,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Scoreboard) Reset() {
+	*x = Scoreboard{}
+	mi := &file_proto_match_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Scoreboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scoreboard) ProtoMessage() {}
+
+func (x *Scoreboard) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_match_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scoreboard.ProtoReflect.Descriptor instead.
+func (*Scoreboard) Descriptor() ([]byte, []int) {
+	return file_proto_match_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Scoreboard) GetMatchId() string {
+	if x != nil {
+		return x.MatchId
+	}
+	return ""
+}
+
+func (x *Scoreboard) GetTeams() []*TeamProgress {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *Scoreboard) GetStartedAtMs() int64 {
+	if x != nil && x.StartedAtMs != nil {
+		return *x.StartedAtMs
+	}
+	return 0
+}
+
+func (x *Scoreboard) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *Scoreboard) GetFinished() bool {
+	if x != nil {
+		return x.Finished
+	}
+	return false
+}
+
+var File_proto_match_proto protoreflect.FileDescriptor
+
+const file_proto_match_proto_rawDesc = "" +
+	"\n" +
+	"\x11proto/match.proto\x12\x05match\x1a\x17proto/game_config.proto\x1a\x13proto/session.proto\"k\n" +
+	"\x12CreateMatchRequest\x124\n" +
+	"\x06config\x18\x01 \x01(\v2\x17.game_config.GameConfigH\x00R\x06config\x88\x01\x01\x12\x14\n" +
+	"\x05teams\x18\x02 \x03(\tR\x05teamsB\t\n" +
+	"\a_config\">\n" +
+	"\tMatchTeam\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\"\x8f\x01\n" +
+	"\x13CreateMatchResponse\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12A\n" +
+	"\vconfig_info\x18\x03 \x01(\v2 .game_config.GeneratedConfigInfoR\n" +
+	"configInfo\x12\x14\n" +
+	"\x05teams\x18\x04 \x03(\tR\x05teamsJ\x04\b\x02\x10\x03\"A\n" +
+	"\x10JoinMatchRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12\x12\n" +
+	"\x04team\x18\x02 \x01(\tR\x04team\".\n" +
+	"\x11StartMatchRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"1\n" +
+	"\x14GetScoreboardRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\".\n" +
+	"\x11WatchMatchRequest\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\"\xf9\x01\n" +
+	"\fTeamProgress\x12\x12\n" +
+	"\x04team\x18\x01 \x01(\tR\x04team\x12+\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x15.session.SessionStateR\x05state\x12%\n" +
+	"\x0emodules_solved\x18\x04 \x01(\x05R\rmodulesSolved\x12#\n" +
+	"\rmodules_total\x18\x05 \x01(\x05R\fmodulesTotal\x12\x18\n" +
+	"\astrikes\x18\x06 \x01(\x05R\astrikes\x12)\n" +
+	"\x0efinished_at_ms\x18\a \x01(\x03H\x00R\ffinishedAtMs\x88\x01\x01B\x11\n" +
+	"\x0f_finished_at_msJ\x04\b\x02\x10\x03\"\xc1\x01\n" +
+	"\n" +
+	"Scoreboard\x12\x19\n" +
+	"\bmatch_id\x18\x01 \x01(\tR\amatchId\x12)\n" +
+	"\x05teams\x18\x02 \x03(\v2\x13.match.TeamProgressR\x05teams\x12'\n" +
+	"\rstarted_at_ms\x18\x03 \x01(\x03H\x00R\vstartedAtMs\x88\x01\x01\x12\x16\n" +
+	"\x06winner\x18\x04 \x01(\tR\x06winner\x12\x1a\n" +
+	"\bfinished\x18\x05 \x01(\bR\bfinishedB\x10\n" +
+	"\x0e_started_at_msB\tZ\a./protob\x06proto3"
+
+var (
+	file_proto_match_proto_rawDescOnce sync.Once
+	file_proto_match_proto_rawDescData []byte
+)
+
+func file_proto_match_proto_rawDescGZIP() []byte {
+	file_proto_match_proto_rawDescOnce.Do(func() {
+		file_proto_match_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_match_proto_rawDesc), len(file_proto_match_proto_rawDesc)))
+	})
+	return file_proto_match_proto_rawDescData
+}
+
+var file_proto_match_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_match_proto_goTypes = []any{
+	(*CreateMatchRequest)(nil),   // 0: match.CreateMatchRequest
+	(*MatchTeam)(nil),            // 1: match.MatchTeam
+	(*CreateMatchResponse)(nil),  // 2: match.CreateMatchResponse
+	(*JoinMatchRequest)(nil),     // 3: match.JoinMatchRequest
+	(*StartMatchRequest)(nil),    // 4: match.StartMatchRequest
+	(*GetScoreboardRequest)(nil), // 5: match.GetScoreboardRequest
+	(*WatchMatchRequest)(nil),    // 6: match.WatchMatchRequest
+	(*TeamProgress)(nil),         // 7: match.TeamProgress
+	(*Scoreboard)(nil),           // 8: match.Scoreboard
+	(*GameConfig)(nil),           // 9: game_config.GameConfig
+	(*GeneratedConfigInfo)(nil),  // 10: game_config.GeneratedConfigInfo
+	(SessionState)(0),            // 11: session.SessionState
+}
+var file_proto_match_proto_depIdxs = []int32{
+	9,  // 0: match.CreateMatchRequest.config:type_name -> game_config.GameConfig
+	10, // 1: match.CreateMatchResponse.config_info:type_name -> game_config.GeneratedConfigInfo
+	11, // 2: match.TeamProgress.state:type_name -> session.SessionState
+	7,  // 3: match.Scoreboard.teams:type_name -> match.TeamProgress
+	4,  // [4:4] is the sub-list for method output_type
+	4,  // [4:4] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_match_proto_init() }
+func file_proto_match_proto_init() {
+	if File_proto_match_proto != nil {
+		return
+	}
+	file_proto_game_config_proto_init()
+	file_proto_session_proto_init()
+	file_proto_match_proto_msgTypes[0].OneofWrappers = []any{}
+	file_proto_match_proto_msgTypes[7].OneofWrappers = []any{}
+	file_proto_match_proto_msgTypes[8].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_match_proto_rawDesc), len(file_proto_match_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_match_proto_goTypes,
+		DependencyIndexes: file_proto_match_proto_depIdxs,
+		MessageInfos:      file_proto_match_proto_msgTypes,
+	}.Build()
+	File_proto_match_proto = out.File
+	file_proto_match_proto_goTypes = nil
+	file_proto_match_proto_depIdxs = nil
+}
@@ -109,8 +109,10 @@ type NeedyKnobState struct {
 	DialDirection             CardinalDirection      `protobuf:"varint,3,opt,name=dial_direction,json=dialDirection,proto3,enum=common.CardinalDirection" json:"dial_direction,omitempty"`
 	CountdownStartedAt        int64                  `protobuf:"varint,4,opt,name=countdown_started_at,json=countdownStartedAt,proto3" json:"countdown_started_at,omitempty"`
 	CountdownDuration         int32                  `protobuf:"varint,5,opt,name=countdown_duration,json=countdownDuration,proto3" json:"countdown_duration,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+	// Countdown time left, in bomb time (speeds up with the bomb's clock)
+	CountdownRemainingMs int64 `protobuf:"varint,6,opt,name=countdown_remaining_ms,json=countdownRemainingMs,proto3" json:"countdown_remaining_ms,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *NeedyKnobState) Reset() {
@@ -178,6 +180,13 @@ func (x *NeedyKnobState) GetCountdownDuration() int32 {
 	return 0
 }
 
+func (x *NeedyKnobState) GetCountdownRemainingMs() int64 {
+	if x != nil {
+		return x.CountdownRemainingMs
+	}
+	return 0
+}
+
 var File_proto_needy_knob_module_proto protoreflect.FileDescriptor
 
 const file_proto_needy_knob_module_proto_rawDesc = "" +
@@ -185,13 +194,14 @@ const file_proto_needy_knob_module_proto_rawDesc = "" +
 	"\x1dproto/needy_knob_module.proto\x12\amodules\x1a\x12proto/common.proto\"\x10\n" +
 	"\x0eNeedyKnobInput\"Y\n" +
 	"\x14NeedyKnobInputResult\x12A\n" +
-	"\x10needy_knob_state\x18\x01 \x01(\v2\x17.modules.NeedyKnobStateR\x0eneedyKnobState\"\xb3\x02\n" +
+	"\x10needy_knob_state\x18\x01 \x01(\v2\x17.modules.NeedyKnobStateR\x0eneedyKnobState\"\xe9\x02\n" +
 	"\x0eNeedyKnobState\x12=\n" +
 	"\x1bdisplayed_pattern_first_row\x18\x01 \x03(\bR\x18displayedPatternFirstRow\x12?\n" +
 	"\x1cdisplayed_pattern_second_row\x18\x02 \x03(\bR\x19displayedPatternSecondRow\x12@\n" +
 	"\x0edial_direction\x18\x03 \x01(\x0e2\x19.common.CardinalDirectionR\rdialDirection\x120\n" +
 	"\x14countdown_started_at\x18\x04 \x01(\x03R\x12countdownStartedAt\x12-\n" +
-	"\x12countdown_duration\x18\x05 \x01(\x05R\x11countdownDurationB\tZ\a./protob\x06proto3"
+	"\x12countdown_duration\x18\x05 \x01(\x05R\x11countdownDuration\x124\n" +
+	"\x16countdown_remaining_ms\x18\x06 \x01(\x03R\x14countdownRemainingMsB\tZ\a./protob\x06proto3"
 
 var (
 	file_proto_needy_knob_module_proto_rawDescOnce sync.Once
@@ -114,8 +114,10 @@ type NeedyVentGasState struct {
 	DisplayedQuestion  string                 `protobuf:"bytes,1,opt,name=displayed_question,json=displayedQuestion,proto3" json:"displayed_question,omitempty"`
 	CountdownStartedAt int64                  `protobuf:"varint,2,opt,name=countdown_started_at,json=countdownStartedAt,proto3" json:"countdown_started_at,omitempty"`
 	CountdownDuration  int32                  `protobuf:"varint,3,opt,name=countdown_duration,json=countdownDuration,proto3" json:"countdown_duration,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Countdown time left, in bomb time (speeds up with the bomb's clock)
+	CountdownRemainingMs int64 `protobuf:"varint,4,opt,name=countdown_remaining_ms,json=countdownRemainingMs,proto3" json:"countdown_remaining_ms,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *NeedyVentGasState) Reset() {
@@ -169,6 +171,13 @@ func (x *NeedyVentGasState) GetCountdownDuration() int32 {
 	return 0
 }
 
+func (x *NeedyVentGasState) GetCountdownRemainingMs() int64 {
+	if x != nil {
+		return x.CountdownRemainingMs
+	}
+	return 0
+}
+
 var File_proto_needy_vent_gas_module_proto protoreflect.FileDescriptor
 
 const file_proto_needy_vent_gas_module_proto_rawDesc = "" +
@@ -177,11 +186,12 @@ const file_proto_needy_vent_gas_module_proto_rawDesc = "" +
 	"\x11NeedyVentGasInput\x12\x14\n" +
 	"\x05input\x18\x01 \x01(\bR\x05input\"f\n" +
 	"\x17NeedyVentGasInputResult\x12K\n" +
-	"\x14needy_vent_gas_state\x18\x01 \x01(\v2\x1a.modules.NeedyVentGasStateR\x11needyVentGasState\"\xa3\x01\n" +
+	"\x14needy_vent_gas_state\x18\x01 \x01(\v2\x1a.modules.NeedyVentGasStateR\x11needyVentGasState\"\xd9\x01\n" +
 	"\x11NeedyVentGasState\x12-\n" +
 	"\x12displayed_question\x18\x01 \x01(\tR\x11displayedQuestion\x120\n" +
 	"\x14countdown_started_at\x18\x02 \x01(\x03R\x12countdownStartedAt\x12-\n" +
-	"\x12countdown_duration\x18\x03 \x01(\x05R\x11countdownDurationB\tZ\a./protob\x06proto3"
+	"\x12countdown_duration\x18\x03 \x01(\x05R\x11countdownDuration\x124\n" +
+	"\x16countdown_remaining_ms\x18\x04 \x01(\x03R\x14countdownRemainingMsB\tZ\a./protob\x06proto3"
 
 var (
 	file_proto_needy_vent_gas_module_proto_rawDescOnce sync.Once
@@ -69,6 +69,7 @@ func (x *CreateGameRequest) GetConfig() *GameConfig {
 type CreateGameResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ConfigInfo    *GeneratedConfigInfo   `protobuf:"bytes,2,opt,name=config_info,json=configInfo,proto3" json:"config_info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -110,11 +111,20 @@ func (x *CreateGameResponse) GetSessionId() string {
 	return ""
 }
 
+func (x *CreateGameResponse) GetConfigInfo() *GeneratedConfigInfo {
+	if x != nil {
+		return x.ConfigInfo
+	}
+	return nil
+}
+
 type PlayerInput struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
 	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
 	BombId    string                 `protobuf:"bytes,2,opt,name=bomb_id,json=bombId,proto3" json:"bomb_id,omitempty"`
 	ModuleId  string                 `protobuf:"bytes,3,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"`
+	// Defuser sending the input. Needed for modules on a face a defuser owns.
+	PlayerId string `protobuf:"bytes,4,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	// Types that are valid to be assigned to Input:
 	//
 	//	*PlayerInput_WiresInput
@@ -184,6 +194,13 @@ func (x *PlayerInput) GetModuleId() string {
 	return ""
 }
 
+func (x *PlayerInput) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
 func (x *PlayerInput) GetInput() isPlayerInput_Input {
 	if x != nil {
 		return x.Input
@@ -361,10 +378,17 @@ func (*PlayerInput_NeedyKnobInput) isPlayerInput_Input() {}
 func (*PlayerInput_MazeInput) isPlayerInput_Input() {}
 
 type BombStatus struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	StrikeCount   int32                  `protobuf:"varint,1,opt,name=strike_count,json=strikeCount,proto3" json:"strike_count,omitempty"`
-	MaxStrikes    int32                  `protobuf:"varint,2,opt,name=max_strikes,json=maxStrikes,proto3" json:"max_strikes,omitempty"`
-	Exploded      bool                   `protobuf:"varint,3,opt,name=exploded,proto3" json:"exploded,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	StrikeCount  int32                  `protobuf:"varint,1,opt,name=strike_count,json=strikeCount,proto3" json:"strike_count,omitempty"`
+	MaxStrikes   int32                  `protobuf:"varint,2,opt,name=max_strikes,json=maxStrikes,proto3" json:"max_strikes,omitempty"`
+	Exploded     bool                   `protobuf:"varint,3,opt,name=exploded,proto3" json:"exploded,omitempty"`
+	TimerRate    float32                `protobuf:"fixed32,4,opt,name=timer_rate,json=timerRate,proto3" json:"timer_rate,omitempty"`
+	TimeLeftMs   int64                  `protobuf:"varint,5,opt,name=time_left_ms,json=timeLeftMs,proto3" json:"time_left_ms,omitempty"`
+	State        BombState              `protobuf:"varint,6,opt,name=state,proto3,enum=bomb.BombState" json:"state,omitempty"`
+	SessionState SessionState           `protobuf:"varint,7,opt,name=session_state,json=sessionState,proto3,enum=session.SessionState" json:"session_state,omitempty"`
+	// What the bomb's timer shows. Same as time_left_ms unless a modifier changes
+	// it: zen bombs count up.
+	TimerMs       int64 `protobuf:"varint,8,opt,name=timer_ms,json=timerMs,proto3" json:"timer_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -420,12 +444,104 @@ func (x *BombStatus) GetExploded() bool {
 	return false
 }
 
+func (x *BombStatus) GetTimerRate() float32 {
+	if x != nil {
+		return x.TimerRate
+	}
+	return 0
+}
+
+func (x *BombStatus) GetTimeLeftMs() int64 {
+	if x != nil {
+		return x.TimeLeftMs
+	}
+	return 0
+}
+
+func (x *BombStatus) GetState() BombState {
+	if x != nil {
+		return x.State
+	}
+	return BombState_WAITING
+}
+
+func (x *BombStatus) GetSessionState() SessionState {
+	if x != nil {
+		return x.SessionState
+	}
+	return SessionState_IN_PROGRESS
+}
+
+func (x *BombStatus) GetTimerMs() int64 {
+	if x != nil {
+		return x.TimerMs
+	}
+	return 0
+}
+
+// The manual rule a strike was given for
+type RuleExplanation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Stable identifier, e.g. "wires.4.multiple_red_serial_odd"
+	RuleId string `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	// e.g. "4 wires, >1 red, serial odd → cut last red"
+	Description   string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleExplanation) Reset() {
+	*x = RuleExplanation{}
+	mi := &file_proto_player_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleExplanation) ProtoMessage() {}
+
+func (x *RuleExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_player_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleExplanation.ProtoReflect.Descriptor instead.
+func (*RuleExplanation) Descriptor() ([]byte, []int) {
+	return file_proto_player_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RuleExplanation) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+func (x *RuleExplanation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
 type PlayerInputResult struct {
 	state      protoimpl.MessageState `protogen:"open.v1"`
 	ModuleId   string                 `protobuf:"bytes,1,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"`
 	Strike     bool                   `protobuf:"varint,2,opt,name=strike,proto3" json:"strike,omitempty"`
 	Solved     bool                   `protobuf:"varint,3,opt,name=solved,proto3" json:"solved,omitempty"`
 	BombStatus *BombStatus            `protobuf:"bytes,4,opt,name=bomb_status,json=bombStatus,proto3" json:"bomb_status,omitempty"`
+	// Set for strikes on practice bombs only
+	Rule *RuleExplanation `protobuf:"bytes,5,opt,name=rule,proto3" json:"rule,omitempty"`
 	// Types that are valid to be assigned to Result:
 	//
 	//	*PlayerInputResult_BigButtonInputResult
@@ -445,7 +561,7 @@ type PlayerInputResult struct {
 
 func (x *PlayerInputResult) Reset() {
 	*x = PlayerInputResult{}
-	mi := &file_proto_player_proto_msgTypes[4]
+	mi := &file_proto_player_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -457,7 +573,7 @@ func (x *PlayerInputResult) String() string {
 func (*PlayerInputResult) ProtoMessage() {}
 
 func (x *PlayerInputResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_player_proto_msgTypes[4]
+	mi := &file_proto_player_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,7 +586,7 @@ func (x *PlayerInputResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PlayerInputResult.ProtoReflect.Descriptor instead.
 func (*PlayerInputResult) Descriptor() ([]byte, []int) {
-	return file_proto_player_proto_rawDescGZIP(), []int{4}
+	return file_proto_player_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *PlayerInputResult) GetModuleId() string {
@@ -501,6 +617,13 @@ func (x *PlayerInputResult) GetBombStatus() *BombStatus {
 	return nil
 }
 
+func (x *PlayerInputResult) GetRule() *RuleExplanation {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
 func (x *PlayerInputResult) GetResult() isPlayerInputResult_Result {
 	if x != nil {
 		return x.Result
@@ -666,18 +789,21 @@ var File_proto_player_proto protoreflect.FileDescriptor
 
 const file_proto_player_proto_rawDesc = "" +
 	"\n" +
-	"\x12proto/player.proto\x12\x06player\x1a\x18proto/wires_module.proto\x1a\x1bproto/password_module.proto\x1a\x1dproto/big_button_module.proto\x1a\x18proto/simon_module.proto\x1a\x19proto/keypad_module.proto\x1a proto/whos_on_first_module.proto\x1a\x19proto/memory_module.proto\x1a\x18proto/morse_module.proto\x1a!proto/needy_vent_gas_module.proto\x1a\x1dproto/needy_knob_module.proto\x1a\x17proto/maze_module.proto\x1a\x17proto/game_config.proto\"T\n" +
+	"\x12proto/player.proto\x12\x06player\x1a\x18proto/wires_module.proto\x1a\x1bproto/password_module.proto\x1a\x1dproto/big_button_module.proto\x1a\x18proto/simon_module.proto\x1a\x19proto/keypad_module.proto\x1a proto/whos_on_first_module.proto\x1a\x19proto/memory_module.proto\x1a\x18proto/morse_module.proto\x1a!proto/needy_vent_gas_module.proto\x1a\x1dproto/needy_knob_module.proto\x1a\x17proto/maze_module.proto\x1a\x17proto/game_config.proto\x1a\x10proto/bomb.proto\x1a\x13proto/session.proto\"T\n" +
 	"\x11CreateGameRequest\x124\n" +
 	"\x06config\x18\x01 \x01(\v2\x17.game_config.GameConfigH\x00R\x06config\x88\x01\x01B\t\n" +
-	"\a_config\"3\n" +
+	"\a_config\"v\n" +
 	"\x12CreateGameResponse\x12\x1d\n" +
 	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"\xa4\x06\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12A\n" +
+	"\vconfig_info\x18\x02 \x01(\v2 .game_config.GeneratedConfigInfoR\n" +
+	"configInfo\"\xc1\x06\n" +
 	"\vPlayerInput\x12\x1d\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
 	"\abomb_id\x18\x02 \x01(\tR\x06bombId\x12\x1b\n" +
-	"\tmodule_id\x18\x03 \x01(\tR\bmoduleId\x126\n" +
+	"\tmodule_id\x18\x03 \x01(\tR\bmoduleId\x12\x1b\n" +
+	"\tplayer_id\x18\x04 \x01(\tR\bplayerId\x126\n" +
 	"\vwires_input\x18\n" +
 	" \x01(\v2\x13.modules.WiresInputH\x00R\n" +
 	"wiresInput\x12?\n" +
@@ -694,19 +820,30 @@ const file_proto_player_proto_rawDesc = "" +
 	"\x10needy_knob_input\x18\x13 \x01(\v2\x17.modules.NeedyKnobInputH\x00R\x0eneedyKnobInput\x123\n" +
 	"\n" +
 	"maze_input\x18\x14 \x01(\v2\x12.modules.MazeInputH\x00R\tmazeInputB\a\n" +
-	"\x05input\"l\n" +
+	"\x05input\"\xab\x02\n" +
 	"\n" +
 	"BombStatus\x12!\n" +
 	"\fstrike_count\x18\x01 \x01(\x05R\vstrikeCount\x12\x1f\n" +
 	"\vmax_strikes\x18\x02 \x01(\x05R\n" +
 	"maxStrikes\x12\x1a\n" +
-	"\bexploded\x18\x03 \x01(\bR\bexploded\"\xde\a\n" +
+	"\bexploded\x18\x03 \x01(\bR\bexploded\x12\x1d\n" +
+	"\n" +
+	"timer_rate\x18\x04 \x01(\x02R\ttimerRate\x12 \n" +
+	"\ftime_left_ms\x18\x05 \x01(\x03R\n" +
+	"timeLeftMs\x12%\n" +
+	"\x05state\x18\x06 \x01(\x0e2\x0f.bomb.BombStateR\x05state\x12:\n" +
+	"\rsession_state\x18\a \x01(\x0e2\x15.session.SessionStateR\fsessionState\x12\x19\n" +
+	"\btimer_ms\x18\b \x01(\x03R\atimerMs\"L\n" +
+	"\x0fRuleExplanation\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\tR\x06ruleId\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"\x8b\b\n" +
 	"\x11PlayerInputResult\x12\x1b\n" +
 	"\tmodule_id\x18\x01 \x01(\tR\bmoduleId\x12\x16\n" +
 	"\x06strike\x18\x02 \x01(\bR\x06strike\x12\x16\n" +
 	"\x06solved\x18\x03 \x01(\bR\x06solved\x123\n" +
 	"\vbomb_status\x18\x04 \x01(\v2\x12.player.BombStatusR\n" +
-	"bombStatus\x12V\n" +
+	"bombStatus\x12+\n" +
+	"\x04rule\x18\x05 \x01(\v2\x17.player.RuleExplanationR\x04rule\x12V\n" +
 	"\x17big_button_input_result\x18\n" +
 	" \x01(\v2\x1d.modules.BigButtonInputResultH\x00R\x14bigButtonInputResult\x12I\n" +
 	"\x12simon_input_result\x18\v \x01(\v2\x19.modules.SimonInputResultH\x00R\x10simonInputResult\x12R\n" +
@@ -732,65 +869,73 @@ func file_proto_player_proto_rawDescGZIP() []byte {
 	return file_proto_player_proto_rawDescData
 }
 
-var file_proto_player_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_player_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_proto_player_proto_goTypes = []any{
 	(*CreateGameRequest)(nil),       // 0: player.CreateGameRequest
 	(*CreateGameResponse)(nil),      // 1: player.CreateGameResponse
 	(*PlayerInput)(nil),             // 2: player.PlayerInput
 	(*BombStatus)(nil),              // 3: player.BombStatus
-	(*PlayerInputResult)(nil),       // 4: player.PlayerInputResult
-	(*GameConfig)(nil),              // 5: game_config.GameConfig
-	(*WiresInput)(nil),              // 6: modules.WiresInput
-	(*PasswordInput)(nil),           // 7: modules.PasswordInput
-	(*BigButtonInput)(nil),          // 8: modules.BigButtonInput
-	(*SimonInput)(nil),              // 9: modules.SimonInput
-	(*KeypadInput)(nil),             // 10: modules.KeypadInput
-	(*WhosOnFirstInput)(nil),        // 11: modules.WhosOnFirstInput
-	(*MemoryInput)(nil),             // 12: modules.MemoryInput
-	(*MorseInput)(nil),              // 13: modules.MorseInput
-	(*NeedyVentGasInput)(nil),       // 14: modules.NeedyVentGasInput
-	(*NeedyKnobInput)(nil),          // 15: modules.NeedyKnobInput
-	(*MazeInput)(nil),               // 16: modules.MazeInput
-	(*BigButtonInputResult)(nil),    // 17: modules.BigButtonInputResult
-	(*SimonInputResult)(nil),        // 18: modules.SimonInputResult
-	(*PasswordInputResult)(nil),     // 19: modules.PasswordInputResult
-	(*KeypadInputResult)(nil),       // 20: modules.KeypadInputResult
-	(*WhosOnFirstInputResult)(nil),  // 21: modules.WhosOnFirstInputResult
-	(*MemoryInputResult)(nil),       // 22: modules.MemoryInputResult
-	(*MorseInputResult)(nil),        // 23: modules.MorseInputResult
-	(*NeedyVentGasInputResult)(nil), // 24: modules.NeedyVentGasInputResult
-	(*NeedyKnobInputResult)(nil),    // 25: modules.NeedyKnobInputResult
-	(*MazeInputResult)(nil),         // 26: modules.MazeInputResult
+	(*RuleExplanation)(nil),         // 4: player.RuleExplanation
+	(*PlayerInputResult)(nil),       // 5: player.PlayerInputResult
+	(*GameConfig)(nil),              // 6: game_config.GameConfig
+	(*GeneratedConfigInfo)(nil),     // 7: game_config.GeneratedConfigInfo
+	(*WiresInput)(nil),              // 8: modules.WiresInput
+	(*PasswordInput)(nil),           // 9: modules.PasswordInput
+	(*BigButtonInput)(nil),          // 10: modules.BigButtonInput
+	(*SimonInput)(nil),              // 11: modules.SimonInput
+	(*KeypadInput)(nil),             // 12: modules.KeypadInput
+	(*WhosOnFirstInput)(nil),        // 13: modules.WhosOnFirstInput
+	(*MemoryInput)(nil),             // 14: modules.MemoryInput
+	(*MorseInput)(nil),              // 15: modules.MorseInput
+	(*NeedyVentGasInput)(nil),       // 16: modules.NeedyVentGasInput
+	(*NeedyKnobInput)(nil),          // 17: modules.NeedyKnobInput
+	(*MazeInput)(nil),               // 18: modules.MazeInput
+	(BombState)(0),                  // 19: bomb.BombState
+	(SessionState)(0),               // 20: session.SessionState
+	(*BigButtonInputResult)(nil),    // 21: modules.BigButtonInputResult
+	(*SimonInputResult)(nil),        // 22: modules.SimonInputResult
+	(*PasswordInputResult)(nil),     // 23: modules.PasswordInputResult
+	(*KeypadInputResult)(nil),       // 24: modules.KeypadInputResult
+	(*WhosOnFirstInputResult)(nil),  // 25: modules.WhosOnFirstInputResult
+	(*MemoryInputResult)(nil),       // 26: modules.MemoryInputResult
+	(*MorseInputResult)(nil),        // 27: modules.MorseInputResult
+	(*NeedyVentGasInputResult)(nil), // 28: modules.NeedyVentGasInputResult
+	(*NeedyKnobInputResult)(nil),    // 29: modules.NeedyKnobInputResult
+	(*MazeInputResult)(nil),         // 30: modules.MazeInputResult
 }
 var file_proto_player_proto_depIdxs = []int32{
-	5,  // 0: player.CreateGameRequest.config:type_name -> game_config.GameConfig
-	6,  // 1: player.PlayerInput.wires_input:type_name -> modules.WiresInput
-	7,  // 2: player.PlayerInput.password_input:type_name -> modules.PasswordInput
-	8,  // 3: player.PlayerInput.big_button_input:type_name -> modules.BigButtonInput
-	9,  // 4: player.PlayerInput.simon_input:type_name -> modules.SimonInput
-	10, // 5: player.PlayerInput.keypad_input:type_name -> modules.KeypadInput
-	11, // 6: player.PlayerInput.whos_on_first_input:type_name -> modules.WhosOnFirstInput
-	12, // 7: player.PlayerInput.memory_input:type_name -> modules.MemoryInput
-	13, // 8: player.PlayerInput.morse_input:type_name -> modules.MorseInput
-	14, // 9: player.PlayerInput.needy_vent_gas_input:type_name -> modules.NeedyVentGasInput
-	15, // 10: player.PlayerInput.needy_knob_input:type_name -> modules.NeedyKnobInput
-	16, // 11: player.PlayerInput.maze_input:type_name -> modules.MazeInput
-	3,  // 12: player.PlayerInputResult.bomb_status:type_name -> player.BombStatus
-	17, // 13: player.PlayerInputResult.big_button_input_result:type_name -> modules.BigButtonInputResult
-	18, // 14: player.PlayerInputResult.simon_input_result:type_name -> modules.SimonInputResult
-	19, // 15: player.PlayerInputResult.password_input_result:type_name -> modules.PasswordInputResult
-	20, // 16: player.PlayerInputResult.keypad_input_result:type_name -> modules.KeypadInputResult
-	21, // 17: player.PlayerInputResult.whos_on_first_input_result:type_name -> modules.WhosOnFirstInputResult
-	22, // 18: player.PlayerInputResult.memory_input_result:type_name -> modules.MemoryInputResult
-	23, // 19: player.PlayerInputResult.morse_input_result:type_name -> modules.MorseInputResult
-	24, // 20: player.PlayerInputResult.needy_vent_gas_input_result:type_name -> modules.NeedyVentGasInputResult
-	25, // 21: player.PlayerInputResult.needy_knob_input_result:type_name -> modules.NeedyKnobInputResult
-	26, // 22: player.PlayerInputResult.maze_input_result:type_name -> modules.MazeInputResult
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	6,  // 0: player.CreateGameRequest.config:type_name -> game_config.GameConfig
+	7,  // 1: player.CreateGameResponse.config_info:type_name -> game_config.GeneratedConfigInfo
+	8,  // 2: player.PlayerInput.wires_input:type_name -> modules.WiresInput
+	9,  // 3: player.PlayerInput.password_input:type_name -> modules.PasswordInput
+	10, // 4: player.PlayerInput.big_button_input:type_name -> modules.BigButtonInput
+	11, // 5: player.PlayerInput.simon_input:type_name -> modules.SimonInput
+	12, // 6: player.PlayerInput.keypad_input:type_name -> modules.KeypadInput
+	13, // 7: player.PlayerInput.whos_on_first_input:type_name -> modules.WhosOnFirstInput
+	14, // 8: player.PlayerInput.memory_input:type_name -> modules.MemoryInput
+	15, // 9: player.PlayerInput.morse_input:type_name -> modules.MorseInput
+	16, // 10: player.PlayerInput.needy_vent_gas_input:type_name -> modules.NeedyVentGasInput
+	17, // 11: player.PlayerInput.needy_knob_input:type_name -> modules.NeedyKnobInput
+	18, // 12: player.PlayerInput.maze_input:type_name -> modules.MazeInput
+	19, // 13: player.BombStatus.state:type_name -> bomb.BombState
+	20, // 14: player.BombStatus.session_state:type_name -> session.SessionState
+	3,  // 15: player.PlayerInputResult.bomb_status:type_name -> player.BombStatus
+	4,  // 16: player.PlayerInputResult.rule:type_name -> player.RuleExplanation
+	21, // 17: player.PlayerInputResult.big_button_input_result:type_name -> modules.BigButtonInputResult
+	22, // 18: player.PlayerInputResult.simon_input_result:type_name -> modules.SimonInputResult
+	23, // 19: player.PlayerInputResult.password_input_result:type_name -> modules.PasswordInputResult
+	24, // 20: player.PlayerInputResult.keypad_input_result:type_name -> modules.KeypadInputResult
+	25, // 21: player.PlayerInputResult.whos_on_first_input_result:type_name -> modules.WhosOnFirstInputResult
+	26, // 22: player.PlayerInputResult.memory_input_result:type_name -> modules.MemoryInputResult
+	27, // 23: player.PlayerInputResult.morse_input_result:type_name -> modules.MorseInputResult
+	28, // 24: player.PlayerInputResult.needy_vent_gas_input_result:type_name -> modules.NeedyVentGasInputResult
+	29, // 25: player.PlayerInputResult.needy_knob_input_result:type_name -> modules.NeedyKnobInputResult
+	30, // 26: player.PlayerInputResult.maze_input_result:type_name -> modules.MazeInputResult
+	27, // [27:27] is the sub-list for method output_type
+	27, // [27:27] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_proto_player_proto_init() }
@@ -810,6 +955,8 @@ func file_proto_player_proto_init() {
 	file_proto_needy_knob_module_proto_init()
 	file_proto_maze_module_proto_init()
 	file_proto_game_config_proto_init()
+	file_proto_bomb_proto_init()
+	file_proto_session_proto_init()
 	file_proto_player_proto_msgTypes[0].OneofWrappers = []any{}
 	file_proto_player_proto_msgTypes[2].OneofWrappers = []any{
 		(*PlayerInput_WiresInput)(nil),
@@ -824,7 +971,7 @@ func file_proto_player_proto_init() {
 		(*PlayerInput_NeedyKnobInput)(nil),
 		(*PlayerInput_MazeInput)(nil),
 	}
-	file_proto_player_proto_msgTypes[4].OneofWrappers = []any{
+	file_proto_player_proto_msgTypes[5].OneofWrappers = []any{
 		(*PlayerInputResult_BigButtonInputResult)(nil),
 		(*PlayerInputResult_SimonInputResult)(nil),
 		(*PlayerInputResult_PasswordInputResult)(nil),
@@ -842,7 +989,7 @@ func file_proto_player_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_player_proto_rawDesc), len(file_proto_player_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
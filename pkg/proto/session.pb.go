@@ -21,9 +21,122 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type SessionState int32
+
+const (
+	SessionState_IN_PROGRESS SessionState = 0
+	// Every bomb was defused
+	SessionState_COMPLETED SessionState = 1
+	// A bomb exploded
+	SessionState_FAILED SessionState = 2
+	// Waiting for the game to start; no bomb is armed yet
+	SessionState_LOBBY SessionState = 3
+)
+
+// Enum value maps for SessionState.
+var (
+	SessionState_name = map[int32]string{
+		0: "IN_PROGRESS",
+		1: "COMPLETED",
+		2: "FAILED",
+		3: "LOBBY",
+	}
+	SessionState_value = map[string]int32{
+		"IN_PROGRESS": 0,
+		"COMPLETED":   1,
+		"FAILED":      2,
+		"LOBBY":       3,
+	}
+)
+
+func (x SessionState) Enum() *SessionState {
+	p := new(SessionState)
+	*p = x
+	return p
+}
+
+func (x SessionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_session_proto_enumTypes[0].Descriptor()
+}
+
+func (SessionState) Type() protoreflect.EnumType {
+	return &file_proto_session_proto_enumTypes[0]
+}
+
+func (x SessionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionState.Descriptor instead.
+func (SessionState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_session_proto_rawDescGZIP(), []int{0}
+}
+
+type PlayerRole int32
+
+const (
+	PlayerRole_ROLE_UNKNOWN PlayerRole = 0
+	// Sees the bomb and sends input
+	PlayerRole_DEFUSER PlayerRole = 1
+	// Reads the manual
+	PlayerRole_EXPERT PlayerRole = 2
+	// Watches the game without taking part. Can join after the game has started.
+	PlayerRole_SPECTATOR PlayerRole = 3
+)
+
+// Enum value maps for PlayerRole.
+var (
+	PlayerRole_name = map[int32]string{
+		0: "ROLE_UNKNOWN",
+		1: "DEFUSER",
+		2: "EXPERT",
+		3: "SPECTATOR",
+	}
+	PlayerRole_value = map[string]int32{
+		"ROLE_UNKNOWN": 0,
+		"DEFUSER":      1,
+		"EXPERT":       2,
+		"SPECTATOR":    3,
+	}
+)
+
+func (x PlayerRole) Enum() *PlayerRole {
+	p := new(PlayerRole)
+	*p = x
+	return p
+}
+
+func (x PlayerRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayerRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_session_proto_enumTypes[1].Descriptor()
+}
+
+func (PlayerRole) Type() protoreflect.EnumType {
+	return &file_proto_session_proto_enumTypes[1]
+}
+
+func (x PlayerRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayerRole.Descriptor instead.
+func (PlayerRole) EnumDescriptor() ([]byte, []int) {
+	return file_proto_session_proto_rawDescGZIP(), []int{1}
+}
+
 type GetBombsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Player asking. Spectators are turned away, and sessions with a spectator delay only
+	// answer players who joined to play.
+	PlayerId      string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -65,9 +178,25 @@ func (x *GetBombsRequest) GetSessionId() string {
 	return ""
 }
 
+func (x *GetBombsRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
 type GetBombsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Bombs         []*Bomb                `protobuf:"bytes,1,rep,name=bombs,proto3" json:"bombs,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bombs in the order they are played
+	Bombs []*Bomb      `protobuf:"bytes,1,rep,name=bombs,proto3" json:"bombs,omitempty"`
+	State SessionState `protobuf:"varint,2,opt,name=state,proto3,enum=session.SessionState" json:"state,omitempty"`
+	// Players who joined the session, in the order they joined
+	Players []*LobbyPlayer `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`
+	// Every armed bomb's clock is held while the game is paused, and modules are
+	// sent without their state
+	Paused bool `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+	// Set for competitive sessions, which can't be paused
+	PauseDisabled bool `protobuf:"varint,5,opt,name=pause_disabled,json=pauseDisabled,proto3" json:"pause_disabled,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -109,17 +238,1065 @@ func (x *GetBombsResponse) GetBombs() []*Bomb {
 	return nil
 }
 
-var File_proto_session_proto protoreflect.FileDescriptor
+func (x *GetBombsResponse) GetState() SessionState {
+	if x != nil {
+		return x.State
+	}
+	return SessionState_IN_PROGRESS
+}
 
-const file_proto_session_proto_rawDesc = "" +
-	"\n" +
-	"\x13proto/session.proto\x12\asession\x1a\x10proto/bomb.proto\"0\n" +
-	"\x0fGetBombsRequest\x12\x1d\n" +
-	"\n" +
-	"session_id\x18\x01 \x01(\tR\tsessionId\"4\n" +
-	"\x10GetBombsResponse\x12 \n" +
-	"\x05bombs\x18\x01 \x03(\v2\n" +
-	".bomb.BombR\x05bombsB\tZ\a./protob\x06proto3"
+func (x *GetBombsResponse) GetPlayers() []*LobbyPlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GetBombsResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *GetBombsResponse) GetPauseDisabled() bool {
+	if x != nil {
+		return x.PauseDisabled
+	}
+	return false
+}
+
+type LobbyPlayer struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Role     PlayerRole             `protobuf:"varint,2,opt,name=role,proto3,enum=session.PlayerRole" json:"role,omitempty"`
+	Ready    bool                   `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	// Bomb faces the defuser owns. Only the owner can send input to modules on an
+	// owned face; faces nobody owns take input from anyone.
+	Faces         []int32 `protobuf:"varint,4,rep,packed,name=faces,proto3" json:"faces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LobbyPlayer) Reset() {
+	*x = LobbyPlayer{}
+	mi := &file_proto_session_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LobbyPlayer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyPlayer) ProtoMessage() {}
+
+func (x *LobbyPlayer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_session_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyPlayer.ProtoReflect.Descriptor instead.
+func (*LobbyPlayer) Descriptor() ([]byte, []int) {
+	return file_proto_session_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LobbyPlayer) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *LobbyPlayer) GetRole() PlayerRole {
+	if x != nil {
+		return x.Role
+	}
+	return PlayerRole_ROLE_UNKNOWN
+}
+
+func (x *LobbyPlayer) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *LobbyPlayer) GetFaces() []int32 {
+	if x != nil {
+		return x.Faces
+	}
+	return nil
+}
+
+type LobbyState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Players       []*LobbyPlayer         `protobuf:"bytes,1,rep,name=players,proto3" json:"players,omitempty"`
+	Started       bool                   `protobuf:"varint,2,opt,name=started,proto3" json:"started,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LobbyState) Reset() {
+	*x = LobbyState{}
+	mi := &file_proto_session_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LobbyState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbyState) ProtoMessage() {}
+
+func (x *LobbyState) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_session_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbyState.ProtoReflect.Descriptor instead.
+func (*LobbyState) Descriptor() ([]byte, []int) {
+	return file_proto_session_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LobbyState) GetPlayers() []*LobbyPlayer {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *LobbyState) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+type JoinSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PlayerId      string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Role          PlayerRole             `protobuf:"varint,3,opt,name=role,proto3,enum=session.PlayerRole" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JoinSessionRequest) Reset() {
+	*x = JoinSessionRequest{}
+	mi := &file_proto_session_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JoinSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinSessionRequest) ProtoMessage() {}
+
+func (x *JoinSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_session_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinSessionRequest.ProtoReflect.Descriptor instead.
+func (*JoinSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_session_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *JoinSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *JoinSessionRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *JoinSessionRequest) GetRole() PlayerRole {
+	if x != nil {
+		return x.Role
+	}
+	return PlayerRole_ROLE_UNKNOWN
+}
+
+type SetReadyRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PlayerId  string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// The game starts once every player in the lobby is ready
+	Ready         bool `protobuf:"varint,3,opt,name=ready,proto3" json:"ready,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReadyRequest) Reset() {
+	*x = SetReadyRequest{}
+	mi := &file_proto_session_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReadyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReadyRequest) ProtoMessage() {}
+
+func (x *SetReadyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_session_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReadyRequest.ProtoReflect.Descriptor instead.
+func (*SetReadyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_session_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *SetReadyRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *SetReadyRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SetReadyRequest) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+// Hands bomb faces to a defuser, taking them from whoever owned them. Face
+// indices apply to every bomb in the session.
+type AssignFacesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	PlayerId  string                 `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	Faces     []int32                `protobuf:"varint,3,rep,packed,name=faces,proto3" json:"faces,omitempty"`
+	// Player asking. Only the defuser being given the faces or the session's host,
+	// the first player who joined to play, can hand them out.
+	CallerId      string `protobuf:"bytes,4,opt,name=caller_id,json=callerId,proto3" json:"caller_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignFacesRequest) Reset() {
+	*x = AssignFacesRequest{}
+	mi := &file_proto_session_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignFacesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignFacesRequest) ProtoMessage() {}
+
+func (x *AssignFacesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_session_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignFacesRequest.ProtoReflect.Descriptor instead.
+func (*AssignFacesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_session_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AssignFacesRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *AssignFacesRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *AssignFacesRequest) GetFaces() []int32 {
+	if x != nil {
+		return x.Faces
+	}
+	return nil
+}
+
+func (x *AssignFacesRequest) GetCallerId() string {
+	if x != nil {
+		return x.CallerId
+	}
+	return ""
+}
+
+// Shows a bomb's edgework in a game played with the EDGEWORK_ON_REQUEST modifier
+type RevealEdgeworkRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	BombId    string                 `protobuf:"bytes,2,opt,name=bomb_id,json=bombId,proto3" json:"bomb_id,omitempty"`
+	// Player asking. Spectators are turned away, and sessions with a spectator delay only
+	// answer players who joined to play.
+	PlayerId      string `protobuf:"bytes,3,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevealEdgeworkRequest) Reset() {
+	*x = RevealEdgeworkRequest{}
+	mi := &file_proto_session_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevealEdgeworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevealEdgeworkRequest) ProtoMessage() {}
+
+func (x *RevealEdgeworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_session_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevealEdgeworkRequest.ProtoReflect.Descriptor instead.
+func (*RevealEdgeworkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_session_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RevealEdgeworkRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *RevealEdgeworkRequest) GetBombId() string {
+	if x != nil {
+		return x.BombId
+	}
+	return ""
+}
+
+func (x *RevealEdgeworkRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type StartGameRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartGameRequest) Reset() {
+	*x = StartGameRequest{}
+	mi := &file_proto_session_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGameRequest) ProtoMessage() {}
+
+func (x *StartGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_session_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGameRequest.ProtoReflect.Descriptor instead.
+func (*StartGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_session_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StartGameRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type PauseGameRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Player asking. Spectators are turned away, and sessions with a spectator delay only
+	// answer players who joined to play.
+	PlayerId      string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseGameRequest) Reset() {
+	*x = PauseGameRequest{}
+	mi := &file_proto_session_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseGameRequest) ProtoMessage() {}
+
+func (x *PauseGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_session_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseGameRequest.ProtoReflect.Descriptor instead.
+func (*PauseGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_session_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PauseGameRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *PauseGameRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type ResumeGameRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Player asking. Spectators are turned away, and sessions with a spectator delay only
+	// answer players who joined to play.
+	PlayerId      string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeGameRequest) Reset() {
+	*x = ResumeGameRequest{}
+	mi := &file_proto_session_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeGameRequest) ProtoMessage() {}
+
+func (x *ResumeGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_session_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeGameRequest.ProtoReflect.Descriptor instead.
+func (*ResumeGameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_session_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ResumeGameRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ResumeGameRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GetGameReportRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Player asking. Spectators are turned away, and sessions with a spectator delay only
+	// answer players who joined to play.
+	PlayerId      string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetGameReportRequest) Reset() {
+	*x = GetGameReportRequest{}
+	mi := &file_proto_session_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetGameReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameReportRequest) ProtoMessage() {}
+
+func (x *GetGameReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_session_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameReportRequest.ProtoReflect.Descriptor instead.
+func (*GetGameReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_session_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetGameReportRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GetGameReportRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type GameReport struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	State     SessionState           `protobuf:"varint,2,opt,name=state,proto3,enum=session.SessionState" json:"state,omitempty"`
+	// Level for level games, section for missions and 0 for custom and practice games
+	Difficulty int32 `protobuf:"varint,3,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	// Sum of every bomb's score
+	Score int32 `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	// Bombs in the order they are played
+	Bombs []*BombReport `protobuf:"bytes,5,rep,name=bombs,proto3" json:"bombs,omitempty"`
+	// Every defuser in the order they joined
+	Defusers      []*DefuserReport `protobuf:"bytes,6,rep,name=defusers,proto3" json:"defusers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameReport) Reset() {
+	*x = GameReport{}
+	mi := &file_proto_session_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameReport) ProtoMessage() {}
+
+func (x *GameReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_session_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameReport.ProtoReflect.Descriptor instead.
+func (*GameReport) Descriptor() ([]byte, []int) {
+	return file_proto_session_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GameReport) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *GameReport) GetState() SessionState {
+	if x != nil {
+		return x.State
+	}
+	return SessionState_IN_PROGRESS
+}
+
+func (x *GameReport) GetDifficulty() int32 {
+	if x != nil {
+		return x.Difficulty
+	}
+	return 0
+}
+
+func (x *GameReport) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *GameReport) GetBombs() []*BombReport {
+	if x != nil {
+		return x.Bombs
+	}
+	return nil
+}
+
+func (x *GameReport) GetDefusers() []*DefuserReport {
+	if x != nil {
+		return x.Defusers
+	}
+	return nil
+}
+
+type DefuserReport struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PlayerId string                 `protobuf:"bytes,1,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	// Strikes caused by input the defuser sent
+	Strikes       int32 `protobuf:"varint,2,opt,name=strikes,proto3" json:"strikes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DefuserReport) Reset() {
+	*x = DefuserReport{}
+	mi := &file_proto_session_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefuserReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefuserReport) ProtoMessage() {}
+
+func (x *DefuserReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_session_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefuserReport.ProtoReflect.Descriptor instead.
+func (*DefuserReport) Descriptor() ([]byte, []int) {
+	return file_proto_session_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DefuserReport) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *DefuserReport) GetStrikes() int32 {
+	if x != nil {
+		return x.Strikes
+	}
+	return 0
+}
+
+type BombReport struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BombId          string                 `protobuf:"bytes,1,opt,name=bomb_id,json=bombId,proto3" json:"bomb_id,omitempty"`
+	State           BombState              `protobuf:"varint,2,opt,name=state,proto3,enum=bomb.BombState" json:"state,omitempty"`
+	TimeRemainingMs int64                  `protobuf:"varint,3,opt,name=time_remaining_ms,json=timeRemainingMs,proto3" json:"time_remaining_ms,omitempty"`
+	Strikes         int32                  `protobuf:"varint,4,opt,name=strikes,proto3" json:"strikes,omitempty"`
+	ModulesSolved   int32                  `protobuf:"varint,5,opt,name=modules_solved,json=modulesSolved,proto3" json:"modules_solved,omitempty"`
+	ModulesTotal    int32                  `protobuf:"varint,6,opt,name=modules_total,json=modulesTotal,proto3" json:"modules_total,omitempty"`
+	// Strikes given by needy modules
+	NeedyFailures int32 `protobuf:"varint,7,opt,name=needy_failures,json=needyFailures,proto3" json:"needy_failures,omitempty"`
+	Score         int32 `protobuf:"varint,8,opt,name=score,proto3" json:"score,omitempty"`
+	// Every module except the clock, ordered by face, row and column
+	Modules       []*ModuleReport `protobuf:"bytes,9,rep,name=modules,proto3" json:"modules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BombReport) Reset() {
+	*x = BombReport{}
+	mi := &file_proto_session_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BombReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BombReport) ProtoMessage() {}
+
+func (x *BombReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_session_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BombReport.ProtoReflect.Descriptor instead.
+func (*BombReport) Descriptor() ([]byte, []int) {
+	return file_proto_session_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BombReport) GetBombId() string {
+	if x != nil {
+		return x.BombId
+	}
+	return ""
+}
+
+func (x *BombReport) GetState() BombState {
+	if x != nil {
+		return x.State
+	}
+	return BombState_WAITING
+}
+
+func (x *BombReport) GetTimeRemainingMs() int64 {
+	if x != nil {
+		return x.TimeRemainingMs
+	}
+	return 0
+}
+
+func (x *BombReport) GetStrikes() int32 {
+	if x != nil {
+		return x.Strikes
+	}
+	return 0
+}
+
+func (x *BombReport) GetModulesSolved() int32 {
+	if x != nil {
+		return x.ModulesSolved
+	}
+	return 0
+}
+
+func (x *BombReport) GetModulesTotal() int32 {
+	if x != nil {
+		return x.ModulesTotal
+	}
+	return 0
+}
+
+func (x *BombReport) GetNeedyFailures() int32 {
+	if x != nil {
+		return x.NeedyFailures
+	}
+	return 0
+}
+
+func (x *BombReport) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *BombReport) GetModules() []*ModuleReport {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+// Times are measured from when the bomb was armed
+type ModuleReport struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	ModuleId string                 `protobuf:"bytes,1,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"`
+	Type     Module_ModuleType      `protobuf:"varint,2,opt,name=type,proto3,enum=modules.Module_ModuleType" json:"type,omitempty"`
+	Position *ModulePosition        `protobuf:"bytes,3,opt,name=position,proto3" json:"position,omitempty"`
+	// Unset when the module was never touched
+	FirstInteractionMs *int64 `protobuf:"varint,4,opt,name=first_interaction_ms,json=firstInteractionMs,proto3,oneof" json:"first_interaction_ms,omitempty"`
+	// Unset when the module wasn't solved
+	SolvedAtMs    *int64 `protobuf:"varint,5,opt,name=solved_at_ms,json=solvedAtMs,proto3,oneof" json:"solved_at_ms,omitempty"`
+	Strikes       int32  `protobuf:"varint,6,opt,name=strikes,proto3" json:"strikes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModuleReport) Reset() {
+	*x = ModuleReport{}
+	mi := &file_proto_session_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModuleReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleReport) ProtoMessage() {}
+
+func (x *ModuleReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_session_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModuleReport.ProtoReflect.Descriptor instead.
+func (*ModuleReport) Descriptor() ([]byte, []int) {
+	return file_proto_session_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ModuleReport) GetModuleId() string {
+	if x != nil {
+		return x.ModuleId
+	}
+	return ""
+}
+
+func (x *ModuleReport) GetType() Module_ModuleType {
+	if x != nil {
+		return x.Type
+	}
+	return Module_UNKNOWN
+}
+
+func (x *ModuleReport) GetPosition() *ModulePosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *ModuleReport) GetFirstInteractionMs() int64 {
+	if x != nil && x.FirstInteractionMs != nil {
+		return *x.FirstInteractionMs
+	}
+	return 0
+}
+
+func (x *ModuleReport) GetSolvedAtMs() int64 {
+	if x != nil && x.SolvedAtMs != nil {
+		return *x.SolvedAtMs
+	}
+	return 0
+}
+
+func (x *ModuleReport) GetStrikes() int32 {
+	if x != nil {
+		return x.Strikes
+	}
+	return 0
+}
+
+var File_proto_session_proto protoreflect.FileDescriptor
+
+const file_proto_session_proto_rawDesc = "" +
+	"\n" +
+	"\x13proto/session.proto\x12\asession\x1a\x10proto/bomb.proto\x1a\x13proto/modules.proto\"M\n" +
+	"\x0fGetBombsRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"\xd0\x01\n" +
+	"\x10GetBombsResponse\x12 \n" +
+	"\x05bombs\x18\x01 \x03(\v2\n" +
+	".bomb.BombR\x05bombs\x12+\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x15.session.SessionStateR\x05state\x12.\n" +
+	"\aplayers\x18\x03 \x03(\v2\x14.session.LobbyPlayerR\aplayers\x12\x16\n" +
+	"\x06paused\x18\x04 \x01(\bR\x06paused\x12%\n" +
+	"\x0epause_disabled\x18\x05 \x01(\bR\rpauseDisabled\"\x7f\n" +
+	"\vLobbyPlayer\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12'\n" +
+	"\x04role\x18\x02 \x01(\x0e2\x13.session.PlayerRoleR\x04role\x12\x14\n" +
+	"\x05ready\x18\x03 \x01(\bR\x05ready\x12\x14\n" +
+	"\x05faces\x18\x04 \x03(\x05R\x05faces\"V\n" +
+	"\n" +
+	"LobbyState\x12.\n" +
+	"\aplayers\x18\x01 \x03(\v2\x14.session.LobbyPlayerR\aplayers\x12\x18\n" +
+	"\astarted\x18\x02 \x01(\bR\astarted\"y\n" +
+	"\x12JoinSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12'\n" +
+	"\x04role\x18\x03 \x01(\x0e2\x13.session.PlayerRoleR\x04role\"c\n" +
+	"\x0fSetReadyRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x14\n" +
+	"\x05ready\x18\x03 \x01(\bR\x05ready\"\x83\x01\n" +
+	"\x12AssignFacesRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\x12\x14\n" +
+	"\x05faces\x18\x03 \x03(\x05R\x05faces\x12\x1b\n" +
+	"\tcaller_id\x18\x04 \x01(\tR\bcallerId\"l\n" +
+	"\x15RevealEdgeworkRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x17\n" +
+	"\abomb_id\x18\x02 \x01(\tR\x06bombId\x12\x1b\n" +
+	"\tplayer_id\x18\x03 \x01(\tR\bplayerId\"1\n" +
+	"\x10StartGameRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\"N\n" +
+	"\x10PauseGameRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"O\n" +
+	"\x11ResumeGameRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"R\n" +
+	"\x14GetGameReportRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"\xed\x01\n" +
+	"\n" +
+	"GameReport\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12+\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x15.session.SessionStateR\x05state\x12\x1e\n" +
+	"\n" +
+	"difficulty\x18\x03 \x01(\x05R\n" +
+	"difficulty\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x05R\x05score\x12)\n" +
+	"\x05bombs\x18\x05 \x03(\v2\x13.session.BombReportR\x05bombs\x122\n" +
+	"\bdefusers\x18\x06 \x03(\v2\x16.session.DefuserReportR\bdefusers\"F\n" +
+	"\rDefuserReport\x12\x1b\n" +
+	"\tplayer_id\x18\x01 \x01(\tR\bplayerId\x12\x18\n" +
+	"\astrikes\x18\x02 \x01(\x05R\astrikes\"\xcc\x02\n" +
+	"\n" +
+	"BombReport\x12\x17\n" +
+	"\abomb_id\x18\x01 \x01(\tR\x06bombId\x12%\n" +
+	"\x05state\x18\x02 \x01(\x0e2\x0f.bomb.BombStateR\x05state\x12*\n" +
+	"\x11time_remaining_ms\x18\x03 \x01(\x03R\x0ftimeRemainingMs\x12\x18\n" +
+	"\astrikes\x18\x04 \x01(\x05R\astrikes\x12%\n" +
+	"\x0emodules_solved\x18\x05 \x01(\x05R\rmodulesSolved\x12#\n" +
+	"\rmodules_total\x18\x06 \x01(\x05R\fmodulesTotal\x12%\n" +
+	"\x0eneedy_failures\x18\a \x01(\x05R\rneedyFailures\x12\x14\n" +
+	"\x05score\x18\b \x01(\x05R\x05score\x12/\n" +
+	"\amodules\x18\t \x03(\v2\x15.session.ModuleReportR\amodules\"\xb2\x02\n" +
+	"\fModuleReport\x12\x1b\n" +
+	"\tmodule_id\x18\x01 \x01(\tR\bmoduleId\x12.\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1a.modules.Module.ModuleTypeR\x04type\x123\n" +
+	"\bposition\x18\x03 \x01(\v2\x17.modules.ModulePositionR\bposition\x125\n" +
+	"\x14first_interaction_ms\x18\x04 \x01(\x03H\x00R\x12firstInteractionMs\x88\x01\x01\x12%\n" +
+	"\fsolved_at_ms\x18\x05 \x01(\x03H\x01R\n" +
+	"solvedAtMs\x88\x01\x01\x12\x18\n" +
+	"\astrikes\x18\x06 \x01(\x05R\astrikesB\x17\n" +
+	"\x15_first_interaction_msB\x0f\n" +
+	"\r_solved_at_ms*E\n" +
+	"\fSessionState\x12\x0f\n" +
+	"\vIN_PROGRESS\x10\x00\x12\r\n" +
+	"\tCOMPLETED\x10\x01\x12\n" +
+	"\n" +
+	"\x06FAILED\x10\x02\x12\t\n" +
+	"\x05LOBBY\x10\x03*F\n" +
+	"\n" +
+	"PlayerRole\x12\x10\n" +
+	"\fROLE_UNKNOWN\x10\x00\x12\v\n" +
+	"\aDEFUSER\x10\x01\x12\n" +
+	"\n" +
+	"\x06EXPERT\x10\x02\x12\r\n" +
+	"\tSPECTATOR\x10\x03B\tZ\a./protob\x06proto3"
 
 var (
 	file_proto_session_proto_rawDescOnce sync.Once
@@ -133,19 +1310,51 @@ func file_proto_session_proto_rawDescGZIP() []byte {
 	return file_proto_session_proto_rawDescData
 }
 
-var file_proto_session_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_session_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_proto_session_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_proto_session_proto_goTypes = []any{
-	(*GetBombsRequest)(nil),  // 0: session.GetBombsRequest
-	(*GetBombsResponse)(nil), // 1: session.GetBombsResponse
-	(*Bomb)(nil),             // 2: bomb.Bomb
+	(SessionState)(0),             // 0: session.SessionState
+	(PlayerRole)(0),               // 1: session.PlayerRole
+	(*GetBombsRequest)(nil),       // 2: session.GetBombsRequest
+	(*GetBombsResponse)(nil),      // 3: session.GetBombsResponse
+	(*LobbyPlayer)(nil),           // 4: session.LobbyPlayer
+	(*LobbyState)(nil),            // 5: session.LobbyState
+	(*JoinSessionRequest)(nil),    // 6: session.JoinSessionRequest
+	(*SetReadyRequest)(nil),       // 7: session.SetReadyRequest
+	(*AssignFacesRequest)(nil),    // 8: session.AssignFacesRequest
+	(*RevealEdgeworkRequest)(nil), // 9: session.RevealEdgeworkRequest
+	(*StartGameRequest)(nil),      // 10: session.StartGameRequest
+	(*PauseGameRequest)(nil),      // 11: session.PauseGameRequest
+	(*ResumeGameRequest)(nil),     // 12: session.ResumeGameRequest
+	(*GetGameReportRequest)(nil),  // 13: session.GetGameReportRequest
+	(*GameReport)(nil),            // 14: session.GameReport
+	(*DefuserReport)(nil),         // 15: session.DefuserReport
+	(*BombReport)(nil),            // 16: session.BombReport
+	(*ModuleReport)(nil),          // 17: session.ModuleReport
+	(*Bomb)(nil),                  // 18: bomb.Bomb
+	(BombState)(0),                // 19: bomb.BombState
+	(Module_ModuleType)(0),        // 20: modules.Module.ModuleType
+	(*ModulePosition)(nil),        // 21: modules.ModulePosition
 }
 var file_proto_session_proto_depIdxs = []int32{
-	2, // 0: session.GetBombsResponse.bombs:type_name -> bomb.Bomb
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	18, // 0: session.GetBombsResponse.bombs:type_name -> bomb.Bomb
+	0,  // 1: session.GetBombsResponse.state:type_name -> session.SessionState
+	4,  // 2: session.GetBombsResponse.players:type_name -> session.LobbyPlayer
+	1,  // 3: session.LobbyPlayer.role:type_name -> session.PlayerRole
+	4,  // 4: session.LobbyState.players:type_name -> session.LobbyPlayer
+	1,  // 5: session.JoinSessionRequest.role:type_name -> session.PlayerRole
+	0,  // 6: session.GameReport.state:type_name -> session.SessionState
+	16, // 7: session.GameReport.bombs:type_name -> session.BombReport
+	15, // 8: session.GameReport.defusers:type_name -> session.DefuserReport
+	19, // 9: session.BombReport.state:type_name -> bomb.BombState
+	17, // 10: session.BombReport.modules:type_name -> session.ModuleReport
+	20, // 11: session.ModuleReport.type:type_name -> modules.Module.ModuleType
+	21, // 12: session.ModuleReport.position:type_name -> modules.ModulePosition
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_proto_session_proto_init() }
@@ -154,18 +1363,21 @@ func file_proto_session_proto_init() {
 		return
 	}
 	file_proto_bomb_proto_init()
+	file_proto_modules_proto_init()
+	file_proto_session_proto_msgTypes[15].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_session_proto_rawDesc), len(file_proto_session_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      2,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_proto_session_proto_goTypes,
 		DependencyIndexes: file_proto_session_proto_depIdxs,
+		EnumInfos:         file_proto_session_proto_enumTypes,
 		MessageInfos:      file_proto_session_proto_msgTypes,
 	}.Build()
 	File_proto_session_proto = out.File
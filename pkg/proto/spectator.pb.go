@@ -0,0 +1,368 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: proto/spectator.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SessionEvent_EventType int32
+
+const (
+	SessionEvent_UNKNOWN SessionEvent_EventType = 0
+	// First event of every watch, carrying the session as it was then
+	SessionEvent_WATCHING      SessionEvent_EventType = 1
+	SessionEvent_PLAYER_JOINED SessionEvent_EventType = 2
+	SessionEvent_PLAYER_READY  SessionEvent_EventType = 3
+	SessionEvent_GAME_STARTED  SessionEvent_EventType = 4
+	SessionEvent_GAME_PAUSED   SessionEvent_EventType = 5
+	SessionEvent_GAME_RESUMED  SessionEvent_EventType = 6
+	// A module took an input
+	SessionEvent_MODULE_INPUT SessionEvent_EventType = 7
+	// A defuser was handed bomb faces
+	SessionEvent_FACES_ASSIGNED SessionEvent_EventType = 8
+	// A bomb's edgework was shown to the players
+	SessionEvent_EDGEWORK_REVEALED SessionEvent_EventType = 9
+)
+
+// Enum value maps for SessionEvent_EventType.
+var (
+	SessionEvent_EventType_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "WATCHING",
+		2: "PLAYER_JOINED",
+		3: "PLAYER_READY",
+		4: "GAME_STARTED",
+		5: "GAME_PAUSED",
+		6: "GAME_RESUMED",
+		7: "MODULE_INPUT",
+		8: "FACES_ASSIGNED",
+		9: "EDGEWORK_REVEALED",
+	}
+	SessionEvent_EventType_value = map[string]int32{
+		"UNKNOWN":           0,
+		"WATCHING":          1,
+		"PLAYER_JOINED":     2,
+		"PLAYER_READY":      3,
+		"GAME_STARTED":      4,
+		"GAME_PAUSED":       5,
+		"GAME_RESUMED":      6,
+		"MODULE_INPUT":      7,
+		"FACES_ASSIGNED":    8,
+		"EDGEWORK_REVEALED": 9,
+	}
+)
+
+func (x SessionEvent_EventType) Enum() *SessionEvent_EventType {
+	p := new(SessionEvent_EventType)
+	*p = x
+	return p
+}
+
+func (x SessionEvent_EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SessionEvent_EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_spectator_proto_enumTypes[0].Descriptor()
+}
+
+func (SessionEvent_EventType) Type() protoreflect.EnumType {
+	return &file_proto_spectator_proto_enumTypes[0]
+}
+
+func (x SessionEvent_EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SessionEvent_EventType.Descriptor instead.
+func (SessionEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_spectator_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type WatchSessionRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId string                 `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	// Must have joined the session as a spectator
+	PlayerId      string `protobuf:"bytes,2,opt,name=player_id,json=playerId,proto3" json:"player_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchSessionRequest) Reset() {
+	*x = WatchSessionRequest{}
+	mi := &file_proto_spectator_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchSessionRequest) ProtoMessage() {}
+
+func (x *WatchSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_spectator_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchSessionRequest.ProtoReflect.Descriptor instead.
+func (*WatchSessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_spectator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *WatchSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *WatchSessionRequest) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+// Something that happened in a session, delivered the session's spectator delay
+// after it happened
+type SessionEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Counts up for every event the session publishes. A gap means the spectator
+	// fell behind and missed events.
+	Sequence uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type     SessionEvent_EventType `protobuf:"varint,2,opt,name=type,proto3,enum=spectator.SessionEvent_EventType" json:"type,omitempty"`
+	// Unix time in milliseconds the event happened at
+	AtMs int64 `protobuf:"varint,3,opt,name=at_ms,json=atMs,proto3" json:"at_ms,omitempty"`
+	// Set for lobby events, and for module input sent by a defuser
+	Player *LobbyPlayer `protobuf:"bytes,4,opt,name=player,proto3" json:"player,omitempty"`
+	// Set for module input, and for the bomb whose edgework was revealed
+	BombId   string `protobuf:"bytes,5,opt,name=bomb_id,json=bombId,proto3" json:"bomb_id,omitempty"`
+	ModuleId string `protobuf:"bytes,6,opt,name=module_id,json=moduleId,proto3" json:"module_id,omitempty"`
+	Strike   bool   `protobuf:"varint,7,opt,name=strike,proto3" json:"strike,omitempty"`
+	Solved   bool   `protobuf:"varint,8,opt,name=solved,proto3" json:"solved,omitempty"`
+	// The manual rule that decided the input, as the expert would read it
+	Rule *RuleExplanation `protobuf:"bytes,9,opt,name=rule,proto3" json:"rule,omitempty"`
+	// The whole session straight after the event
+	Session       *GetBombsResponse `protobuf:"bytes,10,opt,name=session,proto3" json:"session,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SessionEvent) Reset() {
+	*x = SessionEvent{}
+	mi := &file_proto_spectator_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SessionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionEvent) ProtoMessage() {}
+
+func (x *SessionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_spectator_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionEvent.ProtoReflect.Descriptor instead.
+func (*SessionEvent) Descriptor() ([]byte, []int) {
+	return file_proto_spectator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SessionEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *SessionEvent) GetType() SessionEvent_EventType {
+	if x != nil {
+		return x.Type
+	}
+	return SessionEvent_UNKNOWN
+}
+
+func (x *SessionEvent) GetAtMs() int64 {
+	if x != nil {
+		return x.AtMs
+	}
+	return 0
+}
+
+func (x *SessionEvent) GetPlayer() *LobbyPlayer {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetBombId() string {
+	if x != nil {
+		return x.BombId
+	}
+	return ""
+}
+
+func (x *SessionEvent) GetModuleId() string {
+	if x != nil {
+		return x.ModuleId
+	}
+	return ""
+}
+
+func (x *SessionEvent) GetStrike() bool {
+	if x != nil {
+		return x.Strike
+	}
+	return false
+}
+
+func (x *SessionEvent) GetSolved() bool {
+	if x != nil {
+		return x.Solved
+	}
+	return false
+}
+
+func (x *SessionEvent) GetRule() *RuleExplanation {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+func (x *SessionEvent) GetSession() *GetBombsResponse {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+var File_proto_spectator_proto protoreflect.FileDescriptor
+
+const file_proto_spectator_proto_rawDesc = "" +
+	"\n" +
+	"\x15proto/spectator.proto\x12\tspectator\x1a\x12proto/player.proto\x1a\x13proto/session.proto\"Q\n" +
+	"\x13WatchSessionRequest\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x01 \x01(\tR\tsessionId\x12\x1b\n" +
+	"\tplayer_id\x18\x02 \x01(\tR\bplayerId\"\xac\x04\n" +
+	"\fSessionEvent\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x04R\bsequence\x125\n" +
+	"\x04type\x18\x02 \x01(\x0e2!.spectator.SessionEvent.EventTypeR\x04type\x12\x13\n" +
+	"\x05at_ms\x18\x03 \x01(\x03R\x04atMs\x12,\n" +
+	"\x06player\x18\x04 \x01(\v2\x14.session.LobbyPlayerR\x06player\x12\x17\n" +
+	"\abomb_id\x18\x05 \x01(\tR\x06bombId\x12\x1b\n" +
+	"\tmodule_id\x18\x06 \x01(\tR\bmoduleId\x12\x16\n" +
+	"\x06strike\x18\a \x01(\bR\x06strike\x12\x16\n" +
+	"\x06solved\x18\b \x01(\bR\x06solved\x12+\n" +
+	"\x04rule\x18\t \x01(\v2\x17.player.RuleExplanationR\x04rule\x123\n" +
+	"\asession\x18\n" +
+	" \x01(\v2\x19.session.GetBombsResponseR\asession\"\xbd\x01\n" +
+	"\tEventType\x12\v\n" +
+	"\aUNKNOWN\x10\x00\x12\f\n" +
+	"\bWATCHING\x10\x01\x12\x11\n" +
+	"\rPLAYER_JOINED\x10\x02\x12\x10\n" +
+	"\fPLAYER_READY\x10\x03\x12\x10\n" +
+	"\fGAME_STARTED\x10\x04\x12\x0f\n" +
+	"\vGAME_PAUSED\x10\x05\x12\x10\n" +
+	"\fGAME_RESUMED\x10\x06\x12\x10\n" +
+	"\fMODULE_INPUT\x10\a\x12\x12\n" +
+	"\x0eFACES_ASSIGNED\x10\b\x12\x15\n" +
+	"\x11EDGEWORK_REVEALED\x10\tB\tZ\a./protob\x06proto3"
+
+var (
+	file_proto_spectator_proto_rawDescOnce sync.Once
+	file_proto_spectator_proto_rawDescData []byte
+)
+
+func file_proto_spectator_proto_rawDescGZIP() []byte {
+	file_proto_spectator_proto_rawDescOnce.Do(func() {
+		file_proto_spectator_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_spectator_proto_rawDesc), len(file_proto_spectator_proto_rawDesc)))
+	})
+	return file_proto_spectator_proto_rawDescData
+}
+
+var file_proto_spectator_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_spectator_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_spectator_proto_goTypes = []any{
+	(SessionEvent_EventType)(0), // 0: spectator.SessionEvent.EventType
+	(*WatchSessionRequest)(nil), // 1: spectator.WatchSessionRequest
+	(*SessionEvent)(nil),        // 2: spectator.SessionEvent
+	(*LobbyPlayer)(nil),         // 3: session.LobbyPlayer
+	(*RuleExplanation)(nil),     // 4: player.RuleExplanation
+	(*GetBombsResponse)(nil),    // 5: session.GetBombsResponse
+}
+var file_proto_spectator_proto_depIdxs = []int32{
+	0, // 0: spectator.SessionEvent.type:type_name -> spectator.SessionEvent.EventType
+	3, // 1: spectator.SessionEvent.player:type_name -> session.LobbyPlayer
+	4, // 2: spectator.SessionEvent.rule:type_name -> player.RuleExplanation
+	5, // 3: spectator.SessionEvent.session:type_name -> session.GetBombsResponse
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_proto_spectator_proto_init() }
+func file_proto_spectator_proto_init() {
+	if File_proto_spectator_proto != nil {
+		return
+	}
+	file_proto_player_proto_init()
+	file_proto_session_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_spectator_proto_rawDesc), len(file_proto_spectator_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_proto_spectator_proto_goTypes,
+		DependencyIndexes: file_proto_spectator_proto_depIdxs,
+		EnumInfos:         file_proto_spectator_proto_enumTypes,
+		MessageInfos:      file_proto_spectator_proto_msgTypes,
+	}.Build()
+	File_proto_spectator_proto = out.File
+	file_proto_spectator_proto_goTypes = nil
+	file_proto_spectator_proto_depIdxs = nil
+}